@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/sync/semaphore"
 
@@ -16,40 +20,83 @@ import (
 	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
 	env "github.com/zepollabot/media-rating-overlay/internal/environment"
 	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/processor/file"
+	"github.com/zepollabot/media-rating-overlay/internal/processor/state"
 	ratingModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/model"
 )
 
 const defaultLogFilePath = "logs/media-rating-overlay.log"
 
+// Options holds the run options given on the command line
+type Options struct {
+	// Force processes every item, even the ones unchanged since the last run
+	Force bool
+	// Restore puts back the original posters instead of processing the items
+	Restore bool
+	// Library limits the run to the library with this name
+	Library string
+	// DryRun renders the posters into PreviewDir without touching the libraries,
+	// or with Restore lists the changes without applying them
+	DryRun bool
+	// PreviewDir is where the posters are rendered on a dry run
+	PreviewDir string
+	// Item limits the run to the items with this title or ID
+	Item string
+	// ConfigDir is where the configuration files are read from, configs/ when empty
+	ConfigDir string
+	// Environment selects the environment specific configuration, the ENV variable when empty
+	Environment string
+	// LogLevel overrides the log level of the configuration when set
+	LogLevel string
+	// Daemon keeps the application running, processing the libraries on schedule
+	Daemon bool
+}
+
 // App represents the main application with all its dependencies
 type App struct {
 	config                 *config.Config
+	options                Options
 	Logger                 *zap.Logger
 	ctx                    context.Context
 	cancel                 context.CancelFunc
 	workSemaphore          *semaphore.Weighted
 	libraryProcessor       *LibraryProcessor
+	restoreProcessor       *RestoreProcessor
 	mediaServices          []mediaModel.MediaService
 	ratingPlatformServices []ratingModel.RatingService
+	stateStore             *state.JSONStore
+	posterGenerator        PosterGenerator
+	previewFileManager     *file.PreviewFileManager
+	webhookHandler         *WebhookHandler
 	shutdownChan           chan struct{}
 	doneChan               chan struct{}
+	// runLock keeps the scheduled runs and the webhook events from processing at the same time
+	runLock sync.Mutex
 }
 
 // NewApp creates a new instance of the application
-func NewApp() (*App, error) {
-	// Get current environment
-	env := env.GetEnvironment()
+func NewApp(options Options) (*App, error) {
+	// Get current environment, unless given on the command line
+	environment := options.Environment
+	if environment == "" {
+		environment = env.GetEnvironment()
+	}
 
 	// Create context with cancellation
 	ctx, cancel := context.WithCancel(context.Background())
 
 	// Load configuration
-	appConfig, err := configService.LoadConfig(env)
+	appConfig, err := configService.LoadConfig(options.ConfigDir, environment)
 	if err != nil {
 		cancel()
 		return nil, err
 	}
 
+	if options.LogLevel != "" {
+		appConfig.Logger.LogLevel = options.LogLevel
+	}
+
 	// TODO: Validate configuration
 
 	// Logger initialization
@@ -69,14 +116,14 @@ func NewApp() (*App, error) {
 			return nil, fmt.Errorf("error initializing logger with defaults: %w", err)
 		}
 	}
-	logger.Info("Application initialization", zap.String("environment", env))
+	logger.Info("Application initialization", zap.String("environment", environment))
 
 	// System resource configuration
 	maxThreads := configService.ConfigureSystemResources(appConfig.Performance, logger)
 	workSemaphore := semaphore.NewWeighted(int64(maxThreads))
 
 	// Initialize services
-	serviceInitializer := NewServiceInitializer(logger, appConfig, ctx, workSemaphore)
+	serviceInitializer := NewServiceInitializer(logger, appConfig, ctx, workSemaphore, options)
 	if err := serviceInitializer.InitializeServices(); err != nil {
 		cancel()
 		return nil, fmt.Errorf("error initializing services: %w", err)
@@ -85,6 +132,7 @@ func NewApp() (*App, error) {
 	// Create the App instance
 	app := &App{
 		config:                 appConfig,
+		options:                options,
 		Logger:                 logger,
 		ctx:                    ctx,
 		cancel:                 cancel,
@@ -92,6 +140,11 @@ func NewApp() (*App, error) {
 		mediaServices:          serviceInitializer.GetMediaServices(),
 		ratingPlatformServices: serviceInitializer.GetRatingPlatformServices(),
 		libraryProcessor:       serviceInitializer.GetLibraryProcessor(),
+		restoreProcessor:       serviceInitializer.GetRestoreProcessor(),
+		stateStore:             serviceInitializer.GetStateStore(),
+		posterGenerator:        serviceInitializer.GetPosterGenerator(),
+		previewFileManager:     serviceInitializer.GetPreviewFileManager(),
+		webhookHandler:         serviceInitializer.GetWebhookHandler(),
 		shutdownChan:           make(chan struct{}),
 		doneChan:               make(chan struct{}),
 	}
@@ -115,15 +168,48 @@ func (a *App) Run() error {
 		a.cancel() // Just cancel the context, don't call Shutdown
 	}()
 
+	defer close(a.doneChan) // Signal completion
+
+	if a.options.Daemon {
+		return a.runDaemon()
+	}
+
 	// Create a context with timeout for the entire processing
 	ctx, cancel := context.WithTimeout(a.ctx, a.config.Performance.LibraryProcessingTimeout)
 	defer cancel()
 
+	err := a.processLibraries(ctx, func(_ string, configLibrary *config.Library) bool {
+		return a.options.Library == "" || configLibrary.Name == a.options.Library
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	a.Logger.Info("Operation completed")
+	a.Logger.Info("Execution time", zap.Duration("duration", time.Since(start)))
+
+	return nil
+}
+
+// processLibraries processes, or restores, the libraries of every media service accepted
+// by selected, which can adjust the configuration of the library for this run.
+// done, when set, is called with the outcome of each processed library
+func (a *App) processLibraries(
+	ctx context.Context,
+	selected func(mediaServiceName string, configLibrary *config.Library) bool,
+	done func(mediaServiceName string, configLibrary *config.Library, err error),
+) error {
+	// Either process the libraries or put their original posters back
+	processLibrary := a.libraryProcessor.ProcessLibrary
+	if a.options.Restore {
+		a.Logger.Info("Restoring original posters", zap.Bool("dryRun", a.options.DryRun))
+		processLibrary = a.restoreProcessor.RestoreLibrary
+	}
+
 	// Process each media service
 	for _, mediaService := range a.mediaServices {
 		// Check for context cancellation before processing each service
 		if err := ctx.Err(); err != nil {
-			close(a.doneChan) // Signal completion
 			return fmt.Errorf("context cancelled before processing media service %s: %w", mediaService.Name, err)
 		}
 
@@ -141,6 +227,7 @@ func (a *App) Run() error {
 
 		// Create service context for the current media service
 		serviceCtx := ServiceContext{
+			MediaServiceName: mediaService.Name,
 			LibrariesService: mediaService.LibraryService,
 			ItemsService:     mediaService.ItemService,
 			PostersService:   mediaService.PosterService,
@@ -148,13 +235,21 @@ func (a *App) Run() error {
 
 		// Process each library
 		for _, configLibrary := range mediaService.Libraries {
+			if !selected(mediaService.Name, &configLibrary) {
+				continue
+			}
+
 			// Check for context cancellation before processing each library
 			if err := ctx.Err(); err != nil {
-				close(a.doneChan) // Signal completion
 				return fmt.Errorf("context cancelled before processing library %s: %w", configLibrary.Name, err)
 			}
 
-			if err := a.libraryProcessor.ProcessLibrary(ctx, &configLibrary, &serviceLibraries, serviceCtx); err != nil {
+			err := processLibrary(ctx, &configLibrary, &serviceLibraries, serviceCtx)
+			a.saveState()
+			if done != nil {
+				done(mediaService.Name, &configLibrary, err)
+			}
+			if err != nil {
 				if errors.Is(err, context.DeadlineExceeded) {
 					a.Logger.Error("Error processing library: processing timed out",
 						zap.String("library", configLibrary.Name),
@@ -172,13 +267,59 @@ func (a *App) Run() error {
 		}
 	}
 
-	a.Logger.Info("Operation completed")
-	a.Logger.Info("Execution time", zap.Duration("duration", time.Since(start)))
-
-	close(a.doneChan) // Signal completion
 	return nil
 }
 
+// ListLibraries writes the libraries of each media service and whether they are
+// configured, so that the library names can be copied into the configuration
+func (a *App) ListLibraries(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MEDIA SERVICE\tLIBRARY\tTYPE\tSTATUS")
+
+	for _, mediaService := range a.mediaServices {
+		serviceLibraries, err := mediaService.LibraryService.GetLibraries(a.ctx)
+		if err != nil {
+			return fmt.Errorf("error retrieving libraries of media service %s: %w", mediaService.Name, err)
+		}
+
+		for _, library := range serviceLibraries {
+			status := "not configured"
+			if configLibrary, found := lo.Find(mediaService.Libraries, func(lib config.Library) bool {
+				return lib.Name == library.Name
+			}); found {
+				status = "disabled"
+				if configLibrary.Enabled {
+					status = "enabled"
+				}
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", mediaService.Name, library.Name, library.Type, status)
+		}
+
+		// Libraries in the configuration the media service does not know
+		for _, configLibrary := range mediaService.Libraries {
+			if !lo.ContainsBy(serviceLibraries, func(lib model.Library) bool {
+				return lib.Name == configLibrary.Name
+			}) {
+				fmt.Fprintf(tw, "%s\t%s\t\t%s\n", mediaService.Name, configLibrary.Name, "not found")
+			}
+		}
+	}
+
+	return tw.Flush()
+}
+
+// saveState writes the state of the processed items, so that a later failure
+// does not lose the progress made so far
+func (a *App) saveState() {
+	if a.stateStore == nil {
+		return
+	}
+
+	if err := a.stateStore.Save(); err != nil {
+		a.Logger.Error("error saving processing state", zap.Error(err))
+	}
+}
+
 // Shutdown performs cleanup and graceful shutdown of the application
 func (a *App) Shutdown() {
 	a.Logger.Info("Shutting down application")
@@ -0,0 +1,141 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	appmocks "github.com/zepollabot/media-rating-overlay/internal/app/mocks"
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	mediamocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/processor/file"
+)
+
+type AppTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	mockLibraryService  *mediamocks.LibraryService
+	mockPosterGenerator *appmocks.PosterGenerator
+	app                 *App
+}
+
+func (s *AppTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockLibraryService = mediamocks.NewLibraryService(s.T())
+	s.mockPosterGenerator = appmocks.NewPosterGenerator(s.T())
+	s.app = &App{
+		Logger: zap.NewNop(),
+		ctx:    s.ctx,
+		mediaServices: []mediaModel.MediaService{
+			{
+				Name:           mediaModel.MediaServicePlex,
+				LibraryService: s.mockLibraryService,
+				Libraries: []config.Library{
+					{Name: "Film", Enabled: true},
+					{Name: "Series", Enabled: false},
+					{Name: "Old Library", Enabled: true},
+				},
+			},
+		},
+		posterGenerator: s.mockPosterGenerator,
+	}
+}
+
+func TestAppTestSuite(t *testing.T) {
+	suite.Run(t, new(AppTestSuite))
+}
+
+func (s *AppTestSuite) TestListLibraries() {
+	// Arrange
+	s.mockLibraryService.On("GetLibraries", s.ctx).Return([]model.Library{
+		{ID: "1", Name: "Film", Type: "movie"},
+		{ID: "2", Name: "Series", Type: "show"},
+		{ID: "3", Name: "Music", Type: "artist"},
+	}, nil).Once()
+	var output bytes.Buffer
+
+	// Act
+	err := s.app.ListLibraries(&output)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(
+		"MEDIA SERVICE  LIBRARY      TYPE    STATUS\n"+
+			"plex           Film         movie   enabled\n"+
+			"plex           Series       show    disabled\n"+
+			"plex           Music        artist  not configured\n"+
+			"plex           Old Library          not found\n",
+		output.String(),
+	)
+}
+
+func (s *AppTestSuite) TestListLibraries_Error() {
+	// Arrange
+	s.mockLibraryService.On("GetLibraries", s.ctx).Return(nil, errors.New("unauthorized")).Once()
+
+	// Act
+	err := s.app.ListLibraries(&bytes.Buffer{})
+
+	// Assert
+	s.ErrorContains(err, "unauthorized")
+}
+
+func (s *AppTestSuite) TestRenderSample() {
+	testCases := []struct {
+		name            string
+		library         string
+		expectedLibrary string
+	}{
+		{name: "first enabled library", library: "", expectedLibrary: "Film"},
+		{name: "selected library", library: "Series", expectedLibrary: "Series"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.SetupTest()
+			previewDir := s.T().TempDir()
+			sourcePoster := filepath.Join(previewDir, "sample", "sample-original.png")
+			samplePoster := filepath.Join(previewDir, "sample", "sample-poster.png")
+			s.app.options = Options{Library: tc.library, PreviewDir: previewDir}
+			s.app.previewFileManager = file.NewPreviewFileManager(zap.NewNop(), previewDir)
+			s.mockPosterGenerator.On("ApplyLogos", s.ctx, sourcePoster, &config.Library{Name: tc.expectedLibrary, Enabled: tc.library == ""}, sampleItem).
+				Return(samplePoster, nil).Once()
+
+			// Act
+			samplePath, err := s.app.RenderSample("")
+
+			// Assert
+			s.Require().NoError(err)
+			s.Equal(samplePoster, samplePath)
+			s.FileExists(sourcePoster)
+		})
+	}
+}
+
+func (s *AppTestSuite) TestRenderSample_LibraryNotConfigured() {
+	// Arrange
+	s.app.options = Options{Library: "Anime"}
+	s.app.previewFileManager = file.NewPreviewFileManager(zap.NewNop(), s.T().TempDir())
+
+	// Act
+	_, err := s.app.RenderSample("")
+
+	// Assert
+	s.EqualError(err, "library 'Anime' not configured")
+}
+
+func (s *AppTestSuite) TestRenderSample_NotDryRun() {
+	// Act
+	_, err := s.app.RenderSample("")
+
+	// Assert
+	s.Error(err)
+}
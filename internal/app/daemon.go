@@ -0,0 +1,179 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	"github.com/zepollabot/media-rating-overlay/internal/scheduler"
+)
+
+// scheduledLibrary is a library processed by the daemon
+type scheduledLibrary struct {
+	mediaServiceName string
+	name             string
+	schedule         *scheduler.Schedule
+	next             time.Time
+	// lastSuccess is when the last successful run of the library started
+	lastSuccess time.Time
+	// disabled tells the library has no next run and is no longer processed
+	disabled bool
+}
+
+// runDaemon processes the libraries on their schedule until the application is stopped.
+// The services are initialized once and kept between runs
+func (a *App) runDaemon() error {
+	libraries, err := a.scheduledLibraries()
+	if err != nil {
+		return err
+	}
+
+	if len(libraries) == 0 {
+		return fmt.Errorf("no enabled library to schedule")
+	}
+
+	now := time.Now()
+	for _, library := range libraries {
+		library.next = library.schedule.Next(now)
+		if a.config.Scheduler.RunOnStart {
+			library.next = now
+		}
+	}
+
+	if a.webhookHandler != nil {
+		stopWebhookServer := a.startWebhookServer()
+		defer stopWebhookServer()
+	}
+
+	a.Logger.Info("Daemon started",
+		zap.Int("libraries", len(libraries)),
+		zap.Bool("incremental", a.config.Scheduler.Incremental),
+	)
+
+	for {
+		active := lo.Filter(libraries, func(library *scheduledLibrary, _ int) bool {
+			return !library.disabled
+		})
+		if len(active) == 0 {
+			return fmt.Errorf("no library left to schedule")
+		}
+
+		next := lo.MinBy(active, func(a, b *scheduledLibrary) bool {
+			return a.next.Before(b.next)
+		}).next
+
+		a.Logger.Info("Next run scheduled", zap.Time("at", next))
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-a.ctx.Done():
+			timer.Stop()
+			a.Logger.Info("Daemon stopped")
+			return nil
+		case <-timer.C:
+		}
+
+		a.runScheduled(libraries, time.Now())
+	}
+}
+
+// scheduledLibraries returns the enabled libraries with their schedule, the one of the
+// library or else the one of the scheduler
+func (a *App) scheduledLibraries() ([]*scheduledLibrary, error) {
+	var libraries []*scheduledLibrary
+	for _, mediaService := range a.mediaServices {
+		for _, configLibrary := range mediaService.Libraries {
+			if !configLibrary.Enabled || (a.options.Library != "" && configLibrary.Name != a.options.Library) {
+				continue
+			}
+
+			expression := configLibrary.Schedule
+			if expression == "" {
+				expression = a.config.Scheduler.Schedule
+			}
+
+			schedule, err := scheduler.Parse(expression)
+			if err != nil {
+				return nil, fmt.Errorf("library %s: %w", configLibrary.Name, err)
+			}
+
+			libraries = append(libraries, &scheduledLibrary{
+				mediaServiceName: mediaService.Name,
+				name:             configLibrary.Name,
+				schedule:         schedule,
+			})
+		}
+	}
+
+	return libraries, nil
+}
+
+// runScheduled processes the libraries due at now, one after the other. Their next run is
+// scheduled from the end of this one, so that runs never overlap and missed runs are skipped
+func (a *App) runScheduled(libraries []*scheduledLibrary, now time.Time) {
+	a.runLock.Lock()
+	defer a.runLock.Unlock()
+
+	// A library without a next run would be due forever, it is disabled instead
+	for _, library := range libraries {
+		if !library.disabled && library.next.IsZero() {
+			a.Logger.Error("Library has no next run, it is no longer scheduled",
+				zap.String("mediaService", library.mediaServiceName),
+				zap.String("library", library.name),
+			)
+			library.disabled = true
+		}
+	}
+
+	due := lo.Filter(libraries, func(library *scheduledLibrary, _ int) bool {
+		return !library.disabled && !library.next.After(now)
+	})
+
+	findDue := func(mediaServiceName string, name string) (*scheduledLibrary, bool) {
+		return lo.Find(due, func(library *scheduledLibrary) bool {
+			return library.mediaServiceName == mediaServiceName && library.name == name
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(a.ctx, a.config.Performance.LibraryProcessingTimeout)
+	defer cancel()
+
+	err := a.processLibraries(ctx, func(mediaServiceName string, configLibrary *config.Library) bool {
+		library, found := findDue(mediaServiceName, configLibrary.Name)
+		if !found {
+			return false
+		}
+
+		if a.config.Scheduler.Incremental && !library.lastSuccess.IsZero() {
+			// The added_at filter of the library is kept when it is later than the last successful run
+			if after, ok := media.GetAddedAfter(configLibrary.Filters.AddedAt, time.Now); !ok || library.lastSuccess.After(after) {
+				configLibrary.Filters.AddedAt = media.AddedAfterPrefix + library.lastSuccess.UTC().Format(time.RFC3339)
+			}
+			a.Logger.Info("Incremental run",
+				zap.String("library", configLibrary.Name),
+				zap.String("addedAt", configLibrary.Filters.AddedAt),
+			)
+		}
+
+		return true
+	}, func(mediaServiceName string, configLibrary *config.Library, err error) {
+		if library, found := findDue(mediaServiceName, configLibrary.Name); found && err == nil {
+			library.lastSuccess = now
+		}
+	})
+	if err != nil {
+		a.Logger.Error("Scheduled run interrupted", zap.Error(err))
+	}
+
+	ended := time.Now()
+	for _, library := range due {
+		library.next = library.schedule.Next(ended)
+	}
+
+	a.Logger.Info("Scheduled run completed", zap.Duration("duration", ended.Sub(now)))
+}
@@ -0,0 +1,238 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	appmocks "github.com/zepollabot/media-rating-overlay/internal/app/mocks"
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	mediamocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/scheduler"
+)
+
+type DaemonTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	mockLibraryService *mediamocks.LibraryService
+	mockItemService    *mediamocks.ItemService
+	app                *App
+}
+
+func (s *DaemonTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	logger := zap.NewNop()
+	s.mockLibraryService = mediamocks.NewLibraryService(s.T())
+	s.mockItemService = mediamocks.NewItemService(s.T())
+
+	appConfig := config.DefaultConfig()
+	itemProcessor := NewItemProcessor(
+		logger,
+		s.ctx,
+		appmocks.NewSemaphoreWeighted(s.T()),
+		appmocks.NewPosterGenerator(s.T()),
+		appmocks.NewItemEligibilityChecker(s.T()),
+		appmocks.NewRatingBuilder(s.T()),
+	)
+
+	s.app = &App{
+		config:           appConfig,
+		Logger:           logger,
+		ctx:              s.ctx,
+		libraryProcessor: NewLibrariesProcessor(logger, *itemProcessor, DefaultLibraryProcessorConfig()),
+		mediaServices: []mediaModel.MediaService{
+			{
+				Name:           mediaModel.MediaServicePlex,
+				LibraryService: s.mockLibraryService,
+				ItemService:    s.mockItemService,
+				PosterService:  mediamocks.NewPosterService(s.T()),
+				Libraries: []config.Library{
+					{Name: "Film", Enabled: true},
+					{Name: "Series", Enabled: true, Schedule: "@hourly"},
+					{Name: "Music", Enabled: false},
+				},
+			},
+		},
+	}
+}
+
+func TestDaemonTestSuite(t *testing.T) {
+	suite.Run(t, new(DaemonTestSuite))
+}
+
+func (s *DaemonTestSuite) TestScheduledLibraries() {
+	// Arrange
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	// Act
+	libraries, err := s.app.scheduledLibraries()
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(libraries, 2)
+	s.Equal("Film", libraries[0].name)
+	s.Equal(time.Date(2025, 1, 16, 3, 0, 0, 0, time.UTC), libraries[0].schedule.Next(now), "Film should use the scheduler schedule")
+	s.Equal("Series", libraries[1].name)
+	s.Equal(time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC), libraries[1].schedule.Next(now), "Series should use its own schedule")
+}
+
+func (s *DaemonTestSuite) TestScheduledLibraries_SelectedLibrary() {
+	// Arrange
+	s.app.options.Library = "Series"
+
+	// Act
+	libraries, err := s.app.scheduledLibraries()
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(libraries, 1)
+	s.Equal("Series", libraries[0].name)
+}
+
+func (s *DaemonTestSuite) newScheduledLibrary(name string, next time.Time) *scheduledLibrary {
+	schedule, err := scheduler.Parse("@hourly")
+	s.Require().NoError(err)
+
+	return &scheduledLibrary{
+		mediaServiceName: mediaModel.MediaServicePlex,
+		name:             name,
+		schedule:         schedule,
+		next:             next,
+	}
+}
+
+func (s *DaemonTestSuite) TestRunScheduled_OnlyDueLibraries() {
+	// Arrange
+	now := time.Now()
+	film := s.newScheduledLibrary("Film", now.Add(-time.Minute))
+	series := s.newScheduledLibrary("Series", now.Add(time.Hour))
+	serviceLibraries := []model.Library{{ID: "1", Name: "Film"}, {ID: "2", Name: "Series"}}
+
+	s.mockLibraryService.On("GetLibraries", mock.Anything).Return(serviceLibraries, nil).Once()
+	s.mockItemService.On("GetItems", mock.Anything, serviceLibraries[0], mock.MatchedBy(func(configLibrary *config.Library) bool {
+		return configLibrary.Name == "Film" && configLibrary.Filters.AddedAt == ""
+	})).Return([]model.Item{}, nil).Once()
+
+	// Act
+	s.app.runScheduled([]*scheduledLibrary{film, series}, now)
+
+	// Assert
+	s.Equal(now, film.lastSuccess)
+	s.True(film.next.After(now), "Film should be scheduled again")
+	s.Equal(now.Add(time.Hour), series.next, "Series should keep its schedule")
+}
+
+func (s *DaemonTestSuite) TestRunScheduled_Incremental() {
+	// Arrange
+	s.app.config.Scheduler.Incremental = true
+	now := time.Now()
+	lastSuccess := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	film := s.newScheduledLibrary("Film", now)
+	film.lastSuccess = lastSuccess
+	serviceLibraries := []model.Library{{ID: "1", Name: "Film"}}
+
+	s.mockLibraryService.On("GetLibraries", mock.Anything).Return(serviceLibraries, nil).Once()
+	s.mockItemService.On("GetItems", mock.Anything, serviceLibraries[0], mock.MatchedBy(func(configLibrary *config.Library) bool {
+		return configLibrary.Filters.AddedAt == "after_2025-01-15T03:00:00Z"
+	})).Return([]model.Item{}, nil).Once()
+
+	// Act
+	s.app.runScheduled([]*scheduledLibrary{film}, now)
+
+	// Assert
+	s.Equal(now, film.lastSuccess)
+	s.Empty(s.app.mediaServices[0].Libraries[0].Filters.AddedAt, "the configuration should not be changed")
+}
+
+func (s *DaemonTestSuite) TestRunScheduled_FailedRunKeepsLastSuccess() {
+	// Arrange
+	s.app.config.Scheduler.Incremental = true
+	now := time.Now()
+	lastSuccess := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	film := s.newScheduledLibrary("Film", now)
+	film.lastSuccess = lastSuccess
+	serviceLibraries := []model.Library{{ID: "1", Name: "Film"}}
+
+	s.mockLibraryService.On("GetLibraries", mock.Anything).Return(serviceLibraries, nil).Once()
+	s.mockItemService.On("GetItems", mock.Anything, serviceLibraries[0], mock.Anything).Return(nil, errors.New("timeout")).Once()
+
+	// Act
+	s.app.runScheduled([]*scheduledLibrary{film}, now)
+
+	// Assert
+	s.Equal(lastSuccess, film.lastSuccess)
+	s.True(film.next.After(now), "Film should be scheduled again")
+}
+
+func (s *DaemonTestSuite) TestRunScheduled_LibraryWithoutNextRunDisabled() {
+	// Arrange
+	now := time.Now()
+	film := s.newScheduledLibrary("Film", time.Time{})
+	series := s.newScheduledLibrary("Series", now)
+	serviceLibraries := []model.Library{{ID: "1", Name: "Film"}, {ID: "2", Name: "Series"}}
+
+	s.mockLibraryService.On("GetLibraries", mock.Anything).Return(serviceLibraries, nil).Once()
+	s.mockItemService.On("GetItems", mock.Anything, serviceLibraries[1], mock.MatchedBy(func(configLibrary *config.Library) bool {
+		return configLibrary.Name == "Series"
+	})).Return([]model.Item{}, nil).Once()
+
+	// Act
+	s.app.runScheduled([]*scheduledLibrary{film, series}, now)
+
+	// Assert
+	s.True(film.disabled, "Film should no longer be scheduled")
+	s.True(film.lastSuccess.IsZero(), "Film should not be processed")
+	s.False(series.disabled)
+	s.Equal(now, series.lastSuccess)
+}
+
+func (s *DaemonTestSuite) TestRunScheduled_IncrementalWithAddedAtFilter() {
+	testCases := []struct {
+		name            string
+		lastSuccess     time.Time
+		expectedAddedAt func(lastSuccess time.Time) string
+	}{
+		{
+			name:            "last run later than the filter",
+			lastSuccess:     time.Now().Add(-time.Hour).Truncate(time.Second),
+			expectedAddedAt: func(lastSuccess time.Time) string { return "after_" + lastSuccess.UTC().Format(time.RFC3339) },
+		},
+		{
+			name:            "filter later than the last run",
+			lastSuccess:     time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC),
+			expectedAddedAt: func(time.Time) string { return "last_30_days" },
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.SetupTest()
+			s.app.config.Scheduler.Incremental = true
+			s.app.mediaServices[0].Libraries[0].Filters.AddedAt = "last_30_days"
+			now := time.Now()
+			film := s.newScheduledLibrary("Film", now)
+			film.lastSuccess = tc.lastSuccess
+			serviceLibraries := []model.Library{{ID: "1", Name: "Film"}}
+
+			s.mockLibraryService.On("GetLibraries", mock.Anything).Return(serviceLibraries, nil).Once()
+			s.mockItemService.On("GetItems", mock.Anything, serviceLibraries[0], mock.MatchedBy(func(configLibrary *config.Library) bool {
+				return configLibrary.Filters.AddedAt == tc.expectedAddedAt(tc.lastSuccess)
+			})).Return([]model.Item{}, nil).Once()
+
+			// Act
+			s.app.runScheduled([]*scheduledLibrary{film}, now)
+
+			// Assert
+			s.Equal(now, film.lastSuccess)
+			s.Equal("last_30_days", s.app.mediaServices[0].Libraries[0].Filters.AddedAt, "the configuration should not be changed")
+		})
+	}
+}
@@ -12,9 +12,12 @@ import (
 	"github.com/zepollabot/media-rating-overlay/internal/factory"
 	mediaFactory "github.com/zepollabot/media-rating-overlay/internal/media-service/factory"
 	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	plexWebhook "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/webhook"
 	model "github.com/zepollabot/media-rating-overlay/internal/model"
 	processorFactory "github.com/zepollabot/media-rating-overlay/internal/processor/factory"
+	"github.com/zepollabot/media-rating-overlay/internal/processor/file"
 	"github.com/zepollabot/media-rating-overlay/internal/processor/rating"
+	"github.com/zepollabot/media-rating-overlay/internal/processor/state"
 	ratingFactory "github.com/zepollabot/media-rating-overlay/internal/rating-service/factory"
 	"github.com/zepollabot/media-rating-overlay/internal/rating-service/item"
 	ratingModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/model"
@@ -34,7 +37,13 @@ type ServiceInitializer struct {
 	mediaServices            []mediaModel.MediaService
 	ratingPlatformServices   []ratingModel.RatingService
 	libraryProcessor         *LibraryProcessor
+	restoreProcessor         *RestoreProcessor
 	itemProcessor            *ItemProcessor
+	posterGenerator          PosterGenerator
+	stateStore               *state.JSONStore
+	previewFileManager       *file.PreviewFileManager
+	webhookHandler           *WebhookHandler
+	options                  Options
 	ctx                      context.Context
 	workSemaphore            *semaphore.Weighted
 	mediaServiceBaseFactory  factory.MediaServiceBaseFactory
@@ -43,7 +52,7 @@ type ServiceInitializer struct {
 }
 
 // NewServiceInitializer creates a new service initializer
-func NewServiceInitializer(logger *zap.Logger, config *config.Config, ctx context.Context, workSemaphore *semaphore.Weighted) *ServiceInitializer {
+func NewServiceInitializer(logger *zap.Logger, config *config.Config, ctx context.Context, workSemaphore *semaphore.Weighted, options Options) *ServiceInitializer {
 	// Initialize core fields
 	si := &ServiceInitializer{
 		logger:                 logger,
@@ -52,12 +61,19 @@ func NewServiceInitializer(logger *zap.Logger, config *config.Config, ctx contex
 		ratingPlatformServices: make([]ratingModel.RatingService, 0),
 		ctx:                    ctx,
 		workSemaphore:          workSemaphore,
+		options:                options,
 	}
 
 	// Create and assign factories
 	clock := model.RealClock{}
 	si.mediaServiceBaseFactory = mediaFactory.NewMediaServiceBaseFactory(si.logger, clock, si.config)
-	si.MediaServiceModelFactory = factory.NewMediaServiceModelFactory(si.logger, si.mediaServiceBaseFactory)
+	mediaServiceModelFactory := factory.NewMediaServiceModelFactory(si.logger, si.mediaServiceBaseFactory)
+	if options.DryRun && !options.Restore {
+		// Posters are only rendered into the preview dir, the libraries are left untouched
+		si.previewFileManager = file.NewPreviewFileManager(si.logger, options.PreviewDir)
+		mediaServiceModelFactory.SetPosterStorage(si.previewFileManager)
+	}
+	si.MediaServiceModelFactory = mediaServiceModelFactory
 	si.RatingServiceBaseFactory = ratingFactory.NewRatingServiceBaseFactory(si.logger, si.config)
 
 	return si
@@ -75,7 +91,11 @@ func (si *ServiceInitializer) InitializeServices() error {
 		return err
 	}
 
-	si.initializeProcessors()
+	if err := si.initializeProcessors(); err != nil {
+		return err
+	}
+
+	si.initializeWebhookHandler()
 	return nil
 }
 
@@ -94,6 +114,31 @@ func (si *ServiceInitializer) GetLibraryProcessor() *LibraryProcessor {
 	return si.libraryProcessor
 }
 
+// GetStateStore returns the processing state store, nil when state is disabled
+func (si *ServiceInitializer) GetStateStore() *state.JSONStore {
+	return si.stateStore
+}
+
+// GetRestoreProcessor returns the initialized restore processor
+func (si *ServiceInitializer) GetRestoreProcessor() *RestoreProcessor {
+	return si.restoreProcessor
+}
+
+// GetPosterGenerator returns the initialized poster generator
+func (si *ServiceInitializer) GetPosterGenerator() PosterGenerator {
+	return si.posterGenerator
+}
+
+// GetPreviewFileManager returns the file manager writing to the preview dir, nil unless on a dry run
+func (si *ServiceInitializer) GetPreviewFileManager() *file.PreviewFileManager {
+	return si.previewFileManager
+}
+
+// GetWebhookHandler returns the webhook handler, nil unless the webhook is enabled in daemon mode
+func (si *ServiceInitializer) GetWebhookHandler() *WebhookHandler {
+	return si.webhookHandler
+}
+
 // GetItemProcessor returns the initialized item processor
 func (si *ServiceInitializer) GetItemProcessor() *ItemProcessor {
 	return si.itemProcessor
@@ -115,14 +160,57 @@ func (si *ServiceInitializer) buildMediaPlatformServicesArray() error {
 		si.mediaServices = append(si.mediaServices, mediaService)
 	}
 
-	// TODO: Add other media services here, like Kodi, Jellyfin, etc.
-	// if si.config.Kodi.Enabled {
-	// 	si.mediaServices = append(si.mediaServices, models.MediaService{
-	// 		Name:      media.MediaServiceKodi,
-	// 		Libraries: si.config.Kodi.Libraries,
-	// 		Client:    kodiClient,
-	// 	})
-	// }
+	if si.config.Jellyfin.Enabled {
+		si.logger.Debug("Initializing Jellyfin media service")
+
+		mediaService, err := si.MediaServiceModelFactory.Create(mediaModel.MediaServiceJellyfin)
+		if err != nil {
+			si.logger.Error("error creating media service", zap.Error(err))
+			return err
+		}
+
+		si.logger.Debug("Jellyfin media service configured", zap.Any("mediaService", mediaService))
+		si.mediaServices = append(si.mediaServices, mediaService)
+	}
+
+	if si.config.Emby.Enabled {
+		si.logger.Debug("Initializing Emby media service")
+
+		mediaService, err := si.MediaServiceModelFactory.Create(mediaModel.MediaServiceEmby)
+		if err != nil {
+			si.logger.Error("error creating media service", zap.Error(err))
+			return err
+		}
+
+		si.logger.Debug("Emby media service configured", zap.Any("mediaService", mediaService))
+		si.mediaServices = append(si.mediaServices, mediaService)
+	}
+
+	if si.config.Kodi.Enabled {
+		si.logger.Debug("Initializing Kodi media service")
+
+		mediaService, err := si.MediaServiceModelFactory.Create(mediaModel.MediaServiceKodi)
+		if err != nil {
+			si.logger.Error("error creating media service", zap.Error(err))
+			return err
+		}
+
+		si.logger.Debug("Kodi media service configured", zap.Any("mediaService", mediaService))
+		si.mediaServices = append(si.mediaServices, mediaService)
+	}
+
+	if si.config.Local.Enabled {
+		si.logger.Debug("Initializing local media service")
+
+		mediaService, err := si.MediaServiceModelFactory.Create(mediaModel.MediaServiceLocal)
+		if err != nil {
+			si.logger.Error("error creating media service", zap.Error(err))
+			return err
+		}
+
+		si.logger.Debug("Local media service configured", zap.Any("mediaService", mediaService))
+		si.mediaServices = append(si.mediaServices, mediaService)
+	}
 
 	si.logger.Info("Found configuration for the following media services",
 		zap.Strings("mediaServices", lo.Map(si.mediaServices, func(ms mediaModel.MediaService, _ int) string { return ms.Name })),
@@ -136,6 +224,19 @@ func (si *ServiceInitializer) buildRatingPlatformServicesArray() error {
 
 	ratingPlatformServiceModelFactory := factory.NewRatingPlatformServiceModelFactory(si.logger, si.RatingServiceBaseFactory, VisualDebug)
 
+	// Initialize MDBList rating service first: the ratings it aggregates are not requested
+	// again to the services of their platforms
+	si.logger.Debug("Initializing MDBList rating platform service")
+
+	mdblistRatingService, err := ratingPlatformServiceModelFactory.Create(constant.RatingServiceMDBList)
+	if err != nil {
+		si.logger.Error("error creating rating service", zap.Error(err))
+		return err
+	}
+
+	si.logger.Debug("MDBList rating platform service configured", zap.Any("ratingService", mdblistRatingService))
+	si.ratingPlatformServices = append(si.ratingPlatformServices, mdblistRatingService)
+
 	// Initialize TMDB rating service
 	si.logger.Debug("Initializing TMDB rating platform service")
 
@@ -172,6 +273,66 @@ func (si *ServiceInitializer) buildRatingPlatformServicesArray() error {
 	si.logger.Debug("IMDB rating platform service configured", zap.Any("ratingService", imdbRatingService))
 	si.ratingPlatformServices = append(si.ratingPlatformServices, imdbRatingService)
 
+	// Initialize Metacritic rating service
+	si.logger.Debug("Initializing Metacritic rating platform service")
+
+	metacriticRatingService, err := ratingPlatformServiceModelFactory.Create(constant.RatingServiceMetacritic)
+	if err != nil {
+		si.logger.Error("error creating rating service", zap.Error(err))
+		return err
+	}
+
+	si.logger.Debug("Metacritic rating platform service configured", zap.Any("ratingService", metacriticRatingService))
+	si.ratingPlatformServices = append(si.ratingPlatformServices, metacriticRatingService)
+
+	// Initialize Trakt rating service
+	si.logger.Debug("Initializing Trakt rating platform service")
+
+	traktRatingService, err := ratingPlatformServiceModelFactory.Create(constant.RatingServiceTrakt)
+	if err != nil {
+		si.logger.Error("error creating rating service", zap.Error(err))
+		return err
+	}
+
+	si.logger.Debug("Trakt rating platform service configured", zap.Any("ratingService", traktRatingService))
+	si.ratingPlatformServices = append(si.ratingPlatformServices, traktRatingService)
+
+	// Initialize Letterboxd rating service
+	si.logger.Debug("Initializing Letterboxd rating platform service")
+
+	letterboxdRatingService, err := ratingPlatformServiceModelFactory.Create(constant.RatingServiceLetterboxd)
+	if err != nil {
+		si.logger.Error("error creating rating service", zap.Error(err))
+		return err
+	}
+
+	si.logger.Debug("Letterboxd rating platform service configured", zap.Any("ratingService", letterboxdRatingService))
+	si.ratingPlatformServices = append(si.ratingPlatformServices, letterboxdRatingService)
+
+	// Initialize AniList rating service
+	si.logger.Debug("Initializing AniList rating platform service")
+
+	aniListRatingService, err := ratingPlatformServiceModelFactory.Create(constant.RatingServiceAniList)
+	if err != nil {
+		si.logger.Error("error creating rating service", zap.Error(err))
+		return err
+	}
+
+	si.logger.Debug("AniList rating platform service configured", zap.Any("ratingService", aniListRatingService))
+	si.ratingPlatformServices = append(si.ratingPlatformServices, aniListRatingService)
+
+	// Initialize MyAnimeList rating service
+	si.logger.Debug("Initializing MyAnimeList rating platform service")
+
+	myAnimeListRatingService, err := ratingPlatformServiceModelFactory.Create(constant.RatingServiceMyAnimeList)
+	if err != nil {
+		si.logger.Error("error creating rating service", zap.Error(err))
+		return err
+	}
+
+	si.logger.Debug("MyAnimeList rating platform service configured", zap.Any("ratingService", myAnimeListRatingService))
+	si.ratingPlatformServices = append(si.ratingPlatformServices, myAnimeListRatingService)
+
 	si.logger.Info("Found configuration for the following rating platform services",
 		zap.Strings("ratingPlatformServices", lo.Map(si.ratingPlatformServices, func(rs ratingModel.RatingService, _ int) string { return rs.Name })),
 	)
@@ -179,34 +340,85 @@ func (si *ServiceInitializer) buildRatingPlatformServicesArray() error {
 	return nil
 }
 
-func (si *ServiceInitializer) initializeProcessors() {
+func (si *ServiceInitializer) initializeProcessors() error {
 	si.logger.Info("Initializing processors")
 
 	// Initialize item processor
 	eligibilityService := item.NewItemEligibilityService(si.ratingPlatformServices, si.logger)
-	ratingBuilderService := rating.NewRatingBuilderService(si.ratingPlatformServices, si.logger)
+	ratingBuilderService := rating.NewRatingBuilderService(si.ratingPlatformServices, &si.config.Votes, si.logger)
 
 	// Create poster generator using factory
 	posterGeneratorFactory := processorFactory.NewPosterGeneratorFactory(si.logger, si.ratingPlatformServices, VisualDebug)
-	posterGenerator := posterGeneratorFactory.Create()
+	if si.previewFileManager != nil {
+		posterGeneratorFactory.FileManager = si.previewFileManager
+	}
+	si.posterGenerator = posterGeneratorFactory.Create()
 
 	si.itemProcessor = NewItemProcessor(
 		si.logger,
 		si.ctx,
 		si.workSemaphore,
-		posterGenerator,
+		si.posterGenerator,
 		eligibilityService,
 		ratingBuilderService,
 	)
 
+	// The library processor keeps a copy of the item processor, so the dry run,
+	// the item filter and the state store must be set before creating it
+	if si.previewFileManager != nil {
+		si.itemProcessor.SetDryRun(si.previewFileManager)
+	}
+
+	if si.options.Item != "" {
+		si.itemProcessor.SetItemFilter(si.options.Item)
+	}
+
+	if si.config.State.Enabled {
+		stateStore, err := state.NewJSONStore(si.config.State.FilePath, si.logger)
+		if err != nil {
+			si.logger.Error("error loading processing state", zap.Error(err))
+			return err
+		}
+		si.stateStore = stateStore
+		si.itemProcessor.SetStateStore(stateStore, si.options.Force)
+	}
+
 	// Initialize library processor with timeout from config
 	libraryConfig := &LibraryProcessorConfig{
 		DefaultTimeout: si.config.Performance.LibraryProcessingTimeout,
+		DryRun:         si.previewFileManager != nil,
 	}
 	si.libraryProcessor = NewLibrariesProcessor(
 		si.logger,
 		*si.itemProcessor,
 		libraryConfig,
 	)
+
+	si.restoreProcessor = NewRestoreProcessor(si.logger, file.NewFileManager(si.logger), si.options.DryRun)
+	if si.stateStore != nil {
+		si.restoreProcessor.SetStateCleaner(si.stateStore)
+	}
+
 	si.logger.Info("Processors initialized successfully")
+	return nil
+}
+
+// initializeWebhookHandler creates the handler of the media server webhooks, only received
+// in daemon mode. Plex is the only media service sending webhooks
+func (si *ServiceInitializer) initializeWebhookHandler() {
+	if !si.config.Webhook.Enabled || !si.options.Daemon {
+		return
+	}
+
+	if !si.config.Plex.Enabled {
+		si.logger.Warn("Webhook enabled but Plex is not, no webhook will be received")
+		return
+	}
+
+	si.webhookHandler = NewWebhookHandler(
+		si.logger,
+		mediaModel.MediaServicePlex,
+		plexWebhook.NewPlexWebhookParser(si.logger),
+		si.config.Webhook.Token,
+	)
 }
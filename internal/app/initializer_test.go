@@ -3,6 +3,8 @@ package core
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -74,7 +76,7 @@ func (s *ServiceInitializerSuite) SetupTest() {
 	s.ctx = context.Background()
 	s.workSemaphore = semaphore.NewWeighted(10)
 
-	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore)
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{})
 }
 
 func (s *ServiceInitializerSuite) TearDownTest() {
@@ -101,7 +103,7 @@ func (s *ServiceInitializerSuite) TestInitializeServices_Success() {
 	assert.Equal(s.T(), mediaModel.MediaServicePlex, s.initializer.GetMediaServices()[0].Name)
 
 	assert.NotNil(s.T(), s.initializer.GetRatingPlatformServices())
-	assert.Len(s.T(), s.initializer.GetRatingPlatformServices(), 3)
+	assert.Len(s.T(), s.initializer.GetRatingPlatformServices(), 9)
 
 	ratingServicesNames := make([]string, 0, len(s.initializer.GetRatingPlatformServices()))
 	for _, rs := range s.initializer.GetRatingPlatformServices() {
@@ -110,6 +112,13 @@ func (s *ServiceInitializerSuite) TestInitializeServices_Success() {
 	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceTMDB)
 	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceRottenTomatoes)
 	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceIMDB)
+	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceMetacritic)
+	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceTrakt)
+	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceLetterboxd)
+	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceAniList)
+	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceMyAnimeList)
+	assert.Contains(s.T(), ratingServicesNames, constant.RatingServiceMDBList)
+	assert.Equal(s.T(), constant.RatingServiceMDBList, ratingServicesNames[0], "MDBList should come first, before the services of the ratings it aggregates")
 
 	assert.NotNil(s.T(), s.initializer.GetLibraryProcessor())
 	assert.NotNil(s.T(), s.initializer.GetItemProcessor())
@@ -139,7 +148,7 @@ func (s *ServiceInitializerSuite) TestGetRatingPlatformServices_AfterInitializat
 	s.Require().NoError(err)
 	services := s.initializer.GetRatingPlatformServices()
 	assert.NotEmpty(s.T(), services)
-	assert.Len(s.T(), services, 3)
+	assert.Len(s.T(), services, 9)
 }
 
 func (s *ServiceInitializerSuite) TestGetLibraryProcessor_BeforeInitialization() {
@@ -169,7 +178,7 @@ func (s *ServiceInitializerSuite) TestGetItemProcessor_AfterInitialization() {
 func (s *ServiceInitializerSuite) TestInitializeServices_PlexDisabled() {
 	s.mockConfig.Plex.Enabled = false // Disable Plex
 	// Re-initialize with the modified config
-	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore)
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{})
 
 	err := s.initializer.InitializeServices()
 	assert.NoError(s.T(), err)
@@ -178,11 +187,145 @@ func (s *ServiceInitializerSuite) TestInitializeServices_PlexDisabled() {
 
 	// Rating services and processors should still be initialized
 	assert.NotNil(s.T(), s.initializer.GetRatingPlatformServices())
-	assert.Len(s.T(), s.initializer.GetRatingPlatformServices(), 3)
+	assert.Len(s.T(), s.initializer.GetRatingPlatformServices(), 9)
 	assert.NotNil(s.T(), s.initializer.GetLibraryProcessor())
 	assert.NotNil(s.T(), s.initializer.GetItemProcessor())
 }
 
+func (s *ServiceInitializerSuite) TestInitializeServices_JellyfinEnabled() {
+	s.mockConfig.Jellyfin = configmodel.Jellyfin{
+		Enabled: true,
+		Url:     "http://dummy-jellyfin-url",
+		ApiKey:  "dummy-jellyfin-apikey",
+	}
+	// Re-initialize with the modified config
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{})
+
+	err := s.initializer.InitializeServices()
+	assert.NoError(s.T(), err)
+
+	services := s.initializer.GetMediaServices()
+	assert.Len(s.T(), services, 2)
+	assert.Equal(s.T(), mediaModel.MediaServicePlex, services[0].Name)
+	assert.Equal(s.T(), mediaModel.MediaServiceJellyfin, services[1].Name)
+}
+
+func (s *ServiceInitializerSuite) TestInitializeServices_EmbyEnabled() {
+	s.mockConfig.Plex.Enabled = false
+	s.mockConfig.Emby = configmodel.Emby{
+		Enabled: true,
+		Url:     "http://dummy-emby-url",
+		ApiKey:  "dummy-emby-apikey",
+	}
+	// Re-initialize with the modified config
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{})
+
+	err := s.initializer.InitializeServices()
+	assert.NoError(s.T(), err)
+
+	services := s.initializer.GetMediaServices()
+	assert.Len(s.T(), services, 1)
+	assert.Equal(s.T(), mediaModel.MediaServiceEmby, services[0].Name)
+}
+
+func (s *ServiceInitializerSuite) TestInitializeServices_KodiEnabled() {
+	s.mockConfig.Plex.Enabled = false
+	s.mockConfig.Kodi = configmodel.Kodi{
+		Enabled: true,
+		Url:     "http://dummy-kodi-url:8080",
+	}
+	// Re-initialize with the modified config
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{})
+
+	err := s.initializer.InitializeServices()
+	assert.NoError(s.T(), err)
+
+	services := s.initializer.GetMediaServices()
+	assert.Len(s.T(), services, 1)
+	assert.Equal(s.T(), mediaModel.MediaServiceKodi, services[0].Name)
+}
+
+func (s *ServiceInitializerSuite) TestInitializeServices_LocalEnabled() {
+	s.mockConfig.Plex.Enabled = false
+	s.mockConfig.Local = configmodel.Local{
+		Enabled:   true,
+		Libraries: []configmodel.Library{{Name: "Movies", Enabled: true, Path: "/movies"}},
+	}
+	// Re-initialize with the modified config
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{})
+
+	err := s.initializer.InitializeServices()
+	assert.NoError(s.T(), err)
+
+	services := s.initializer.GetMediaServices()
+	assert.Len(s.T(), services, 1)
+	assert.Equal(s.T(), mediaModel.MediaServiceLocal, services[0].Name)
+	assert.Equal(s.T(), s.mockConfig.Local.Libraries, services[0].Libraries)
+}
+
+func (s *ServiceInitializerSuite) TestInitializeServices_StateEnabled() {
+	s.mockConfig.State = configmodel.State{
+		Enabled:  true,
+		FilePath: filepath.Join(s.T().TempDir(), "state.json"),
+	}
+	// Re-initialize with the modified config
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{Force: true})
+
+	err := s.initializer.InitializeServices()
+	assert.NoError(s.T(), err)
+
+	assert.NotNil(s.T(), s.initializer.GetStateStore())
+	assert.Equal(s.T(), s.initializer.GetStateStore(), s.initializer.GetItemProcessor().stateStore)
+	assert.True(s.T(), s.initializer.GetItemProcessor().force)
+	assert.Equal(s.T(), s.initializer.GetStateStore(), s.initializer.GetLibraryProcessor().itemProcessor.stateStore)
+}
+
+func (s *ServiceInitializerSuite) TestInitializeServices_StateDisabled() {
+	err := s.initializer.InitializeServices()
+	assert.NoError(s.T(), err)
+
+	assert.Nil(s.T(), s.initializer.GetStateStore())
+	assert.Nil(s.T(), s.initializer.GetItemProcessor().stateStore)
+}
+
+func (s *ServiceInitializerSuite) TestInitializeServices_StateFileInvalid() {
+	stateFilePath := filepath.Join(s.T().TempDir(), "state.json")
+	s.Require().NoError(os.WriteFile(stateFilePath, []byte("not json"), 0644))
+	s.mockConfig.State = configmodel.State{
+		Enabled:  true,
+		FilePath: stateFilePath,
+	}
+	// Re-initialize with the modified config
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{})
+
+	err := s.initializer.InitializeServices()
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), s.initializer.GetStateStore())
+}
+
+func (s *ServiceInitializerSuite) TestInitializeServices_DryRun() {
+	// Re-initialize with a dry run
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{DryRun: true, PreviewDir: s.T().TempDir()})
+
+	err := s.initializer.InitializeServices()
+	assert.NoError(s.T(), err)
+
+	assert.NotNil(s.T(), s.initializer.GetItemProcessor().previewResolver)
+	assert.NotNil(s.T(), s.initializer.GetLibraryProcessor().itemProcessor.previewResolver)
+	assert.True(s.T(), s.initializer.GetLibraryProcessor().dryRun)
+}
+
+func (s *ServiceInitializerSuite) TestInitializeServices_RestoreDryRun() {
+	// Re-initialize with a restore dry run, which renders no preview
+	s.initializer = NewServiceInitializer(s.logger, s.mockConfig, s.ctx, s.workSemaphore, Options{Restore: true, DryRun: true})
+
+	err := s.initializer.InitializeServices()
+	assert.NoError(s.T(), err)
+
+	assert.Nil(s.T(), s.initializer.GetItemProcessor().previewResolver)
+	assert.True(s.T(), s.initializer.GetRestoreProcessor().dryRun)
+}
+
 func (s *ServiceInitializerSuite) TestInitializeServices_MediaServiceCreationError() {
 	// Arrange
 	mockMediaServiceModelFactory := new(mocks.MediaServiceModelFactory)
@@ -213,14 +356,14 @@ func (s *ServiceInitializerSuite) TestInitializeServices_RatingServiceCreationEr
 
 	expectedError := errors.New("failed to create rating service component")
 
-	mockRatingServiceBaseFactory.On("BuildTMDBComponents").Return(ratingModel.RatingService{}, expectedError).Once()
+	mockRatingServiceBaseFactory.On("BuildMDBListComponents").Return(ratingModel.RatingService{}, expectedError).Once()
 
 	// Act
 	err := s.initializer.InitializeServices()
 
 	// Assert
 	assert.Error(s.T(), err)
-	// The error from BuildTMDBComponents is wrapped by ratingPlatformServiceModelFactory.Create
+	// The error from BuildMDBListComponents, the first rating service built, is wrapped by ratingPlatformServiceModelFactory.Create
 	// and then by buildRatingPlatformServicesArray.
 	assert.Contains(s.T(), err.Error(), expectedError.Error(), "Error message should contain the original error")
 	mockRatingServiceBaseFactory.AssertExpectations(s.T())
@@ -242,7 +385,7 @@ func (s *ServiceInitializerSuite) TestInitializeServices_MediaServiceConfigError
 		Logger:      configmodel.Logger{LogFilePath: "test.log", UseStdout: true, LogLevel: "info"},
 		Processor:   configmodel.ProcessorConfig{ItemProcessor: configmodel.ItemProcessorConfig{RatingBuilder: configmodel.RatingBuilderConfig{Timeout: 1 * time.Second}}, LibraryProcessor: configmodel.LibraryProcessorConfig{DefaultTimeout: 1 * time.Second}},
 	}
-	initializer := NewServiceInitializer(s.logger, invalidConfig, s.ctx, s.workSemaphore)
+	initializer := NewServiceInitializer(s.logger, invalidConfig, s.ctx, s.workSemaphore, Options{})
 
 	// Act
 	err := initializer.InitializeServices()
@@ -270,7 +413,7 @@ func (s *ServiceInitializerSuite) TestInitializeServices_RatingServiceConfigErro
 		Processor:   configmodel.ProcessorConfig{ItemProcessor: configmodel.ItemProcessorConfig{RatingBuilder: configmodel.RatingBuilderConfig{Timeout: 1 * time.Second}}, LibraryProcessor: configmodel.LibraryProcessorConfig{DefaultTimeout: 1 * time.Second}},
 	}
 
-	initializer := NewServiceInitializer(s.logger, invalidConfig, s.ctx, s.workSemaphore)
+	initializer := NewServiceInitializer(s.logger, invalidConfig, s.ctx, s.workSemaphore, Options{})
 
 	// Act
 	err := initializer.InitializeServices()
@@ -279,5 +422,7 @@ func (s *ServiceInitializerSuite) TestInitializeServices_RatingServiceConfigErro
 	assert.Error(s.T(), err, "Expected an error due to missing TMDB API key")
 	s.T().Logf("Received error from TMDBApiKeyMissing test: %v", err)
 	assert.Contains(s.T(), err.Error(), "tmdb.api_key is required", "Error message should indicate TMDB API key is required")
-	assert.Empty(s.T(), initializer.GetRatingPlatformServices(), "Rating platform services should be empty or only contain services initialized before the error")
+	ratingServices := initializer.GetRatingPlatformServices()
+	s.Require().Len(ratingServices, 1, "Rating platform services should only contain services initialized before the error")
+	assert.Equal(s.T(), constant.RatingServiceMDBList, ratingServices[0].Name)
 }
@@ -3,6 +3,10 @@ package core
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -10,6 +14,7 @@ import (
 	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
 	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
 	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/processor/state"
 )
 
 type PosterGenerator interface {
@@ -23,7 +28,18 @@ type ItemEligibilityChecker interface {
 
 // RatingBuilder fetches and builds ratings for an item
 type RatingBuilder interface {
-	BuildRatings(ctx context.Context, item *model.Item) error
+	BuildRatings(ctx context.Context, item *model.Item, libraryName string) error
+}
+
+// StateStore records the state of the processed items
+type StateStore interface {
+	Get(key string) (model.ItemState, bool)
+	Set(key string, itemState model.ItemState)
+}
+
+// PreviewPathResolver tells where a poster is read from on a dry run
+type PreviewPathResolver interface {
+	ResolvePath(filePath string) string
 }
 
 type SemaphoreWeighted interface {
@@ -41,6 +57,11 @@ type ItemProcessor struct {
 	posterGenerator    PosterGenerator
 	eligibilityChecker ItemEligibilityChecker
 	ratingBuilder      RatingBuilder
+	stateStore         StateStore
+	force              bool
+	mediaServiceName   string
+	previewResolver    PreviewPathResolver
+	itemFilter         string
 }
 
 // NewItemProcessor creates a new item processor
@@ -66,10 +87,36 @@ func (ip *ItemProcessor) SetPosterService(posterService media.PosterService) {
 	ip.posterService = posterService
 }
 
+// SetStateStore enables skipping the items whose poster inputs did not change
+// since the last run; force processes every item anyway, refreshing their state
+func (ip *ItemProcessor) SetStateStore(stateStore StateStore, force bool) {
+	ip.stateStore = stateStore
+	ip.force = force
+}
+
+// SetDryRun renders the posters without publishing them nor recording their state.
+// The source posters are read from the preview dir first, where they are saved on a dry run
+func (ip *ItemProcessor) SetDryRun(previewResolver PreviewPathResolver) {
+	ip.previewResolver = previewResolver
+}
+
+// SetItemFilter limits the processing to the items whose title or ID is itemFilter,
+// the title being compared case-insensitively
+func (ip *ItemProcessor) SetItemFilter(itemFilter string) {
+	ip.itemFilter = itemFilter
+}
+
+// SetMediaServiceName sets the media service the processed items belong to
+func (ip *ItemProcessor) SetMediaServiceName(mediaServiceName string) {
+	ip.mediaServiceName = mediaServiceName
+}
+
 // ProcessItems processes library items in parallel
 func (ip *ItemProcessor) ProcessItems(items []model.Item, configLib *config.Library) error {
 	var notProcessed int
 	var postersWithErrors int
+	var unchanged int
+	var filtered int
 
 	if err := ip.validateDependencies(); err != nil {
 		return err
@@ -91,6 +138,9 @@ func (ip *ItemProcessor) ProcessItems(items []model.Item, configLib *config.Libr
 			if result.Err != nil {
 				status = "failed"
 				postersWithErrors++
+			} else if result.Skipped {
+				status = "skipped"
+				unchanged++
 			}
 
 			ip.logger.Info("Item processed",
@@ -106,6 +156,11 @@ func (ip *ItemProcessor) ProcessItems(items []model.Item, configLib *config.Libr
 
 	// Process items in parallel
 	for i, item := range items {
+		if !ip.matchesItemFilter(item) {
+			filtered++
+			continue
+		}
+
 		if !ip.eligibilityChecker.IsEligible(&item) {
 			notProcessed++
 			continue
@@ -147,8 +202,10 @@ func (ip *ItemProcessor) ProcessItems(items []model.Item, configLib *config.Libr
 	ip.logger.Info("Processing Report",
 		zap.String("Library Name", configLib.Name),
 		zap.Int("Total Items Found", len(items)),
-		zap.Int("Processed Items", len(items)-notProcessed),
+		zap.Int("Processed Items", len(items)-notProcessed-filtered),
 		zap.Int("Ineligible Items", notProcessed),
+		zap.Int("Filtered Items", filtered),
+		zap.Int("Unchanged Items", unchanged),
 		zap.Int("Posters With Errors", postersWithErrors),
 	)
 
@@ -180,7 +237,7 @@ func (ip *ItemProcessor) ProcessItem(item model.Item, index int, posterService m
 		}
 	}
 
-	if err := ip.ratingBuilder.BuildRatings(ip.ctx, &item); err != nil {
+	if err := ip.ratingBuilder.BuildRatings(ip.ctx, &item, configLib.Name); err != nil {
 		return model.PosterResult{
 			Title: item.Title,
 			Err:   err,
@@ -209,6 +266,10 @@ func (ip *ItemProcessor) ProcessItem(item model.Item, index int, posterService m
 		}
 	}
 
+	if ip.previewResolver != nil {
+		posterDiskPosition = ip.previewResolver.ResolvePath(posterDiskPosition)
+	}
+
 	// Check for context cancellation after GetPosterDiskPosition
 	if ctxErr := ip.ctx.Err(); ctxErr != nil {
 		return model.PosterResult{
@@ -223,7 +284,26 @@ func (ip *ItemProcessor) ProcessItem(item model.Item, index int, posterService m
 		OriginalPosterDiskPosition: posterDiskPosition,
 	}
 
+	itemState, stateKey, tracked := ip.buildItemState(item, configLib, posterDiskPosition)
+	if tracked && ip.isUnchanged(stateKey, itemState) {
+		ip.logger.Debug("Item unchanged since last run, skipped",
+			zap.String("Item ID", item.ID),
+			zap.String("Item Title", item.Title),
+		)
+		result.Skipped = true
+		return result
+	}
+
 	newPosterDiskPosition, err := ip.posterGenerator.ApplyLogos(ip.ctx, posterDiskPosition, configLib, item)
+	if err != nil && err.Error() == model.NoRatingToShow {
+		// Nothing to draw, the poster is left as it is
+		ip.logger.Debug("No rating to show, item skipped",
+			zap.String("Item ID", item.ID),
+			zap.String("Item Title", item.Title),
+		)
+		result.Skipped = true
+		return result
+	}
 	if err != nil {
 		return model.PosterResult{
 			Title: item.Title,
@@ -240,8 +320,32 @@ func (ip *ItemProcessor) ProcessItem(item model.Item, index int, posterService m
 		}
 	}
 
+	if ip.previewResolver != nil {
+		ip.logger.Info("Poster preview rendered",
+			zap.String("Item ID", item.ID),
+			zap.String("Item Title", item.Title),
+			zap.String("previewPath", newPosterDiskPosition),
+		)
+		result.OverlayPosterDiskPosition = newPosterDiskPosition
+		return result
+	}
+
+	if err := posterService.PublishPoster(ip.ctx, item, configLib, newPosterDiskPosition); err != nil {
+		return model.PosterResult{
+			Title:                      item.Title,
+			OriginalPosterDiskPosition: posterDiskPosition,
+			Err:                        err,
+		}
+	}
+
 	result.OverlayPosterDiskPosition = newPosterDiskPosition
 
+	if tracked {
+		itemState.OutputPath = newPosterDiskPosition
+		itemState.UpdatedAt = time.Now()
+		ip.stateStore.Set(stateKey, itemState)
+	}
+
 	ip.logger.Debug("Poster processing completed",
 		zap.Int("Index", index),
 		zap.String("Item ID", item.ID),
@@ -250,3 +354,74 @@ func (ip *ItemProcessor) ProcessItem(item model.Item, index int, posterService m
 
 	return result
 }
+
+func (ip *ItemProcessor) matchesItemFilter(item model.Item) bool {
+	if ip.itemFilter == "" {
+		return true
+	}
+
+	return item.ID == ip.itemFilter || strings.EqualFold(item.Title, ip.itemFilter)
+}
+
+// buildItemState collects the inputs the poster of the item is generated from.
+// tracked is false when no state store is set or the inputs cannot be hashed
+func (ip *ItemProcessor) buildItemState(item model.Item, configLib *config.Library, posterDiskPosition string) (model.ItemState, string, bool) {
+	if ip.stateStore == nil || ip.previewResolver != nil {
+		return model.ItemState{}, "", false
+	}
+
+	overlayConfigHash, err := state.HashValue(configLib.Overlay)
+	if err != nil {
+		ip.logger.Warn("unable to hash overlay config, item state not tracked",
+			zap.String("Item ID", item.ID),
+			zap.Error(err),
+		)
+		return model.ItemState{}, "", false
+	}
+
+	sourcePosterHash, err := state.HashFile(posterDiskPosition)
+	if err != nil {
+		ip.logger.Warn("unable to hash source poster, item state not tracked",
+			zap.String("Item ID", item.ID),
+			zap.String("filePath", posterDiskPosition),
+			zap.Error(err),
+		)
+		return model.ItemState{}, "", false
+	}
+
+	// Only the ratings shown on the poster are kept, without their votes: a change of the others,
+	// or of a number of votes, leaves the poster unchanged
+	ratings := slices.Clone(model.SelectRatings(item.Ratings, configLib))
+	for i := range ratings {
+		ratings[i].Votes = 0
+	}
+	itemState := model.ItemState{
+		Ratings:           ratings,
+		OverlayConfigHash: overlayConfigHash,
+		SourcePosterHash:  sourcePosterHash,
+	}
+
+	return itemState, state.Key(ip.mediaServiceName, item.ID), true
+}
+
+// isUnchanged reports whether the item was already processed from the same inputs
+// and its overlay poster is still on disk
+func (ip *ItemProcessor) isUnchanged(stateKey string, itemState model.ItemState) bool {
+	if ip.force {
+		return false
+	}
+
+	previous, found := ip.stateStore.Get(stateKey)
+	if !found {
+		return false
+	}
+
+	if previous.OverlayConfigHash != itemState.OverlayConfigHash ||
+		previous.SourcePosterHash != itemState.SourcePosterHash ||
+		!slices.Equal(previous.Ratings, itemState.Ratings) {
+		return false
+	}
+
+	_, err := os.Stat(previous.OutputPath)
+	return err == nil
+}
@@ -2,7 +2,10 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -15,6 +18,7 @@ import (
 	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
 	mediamocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
 	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/processor/state"
 )
 
 type ItemProcessorTestSuite struct {
@@ -110,10 +114,11 @@ func (s *ItemProcessorTestSuite) TestProcessItem_Success() {
 	expectedPosterPath := "/path/to/poster.jpg"
 	expectedNewPosterPath := "/path/to/new_poster.jpg"
 
-	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, "Movies").Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
 	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(expectedPosterPath, nil).Once()
 	s.mockPosterGenerator.On("ApplyLogos", s.mockCtx, expectedPosterPath, configLib, item).Return(expectedNewPosterPath, nil).Once()
+	s.mockPosterService.On("PublishPoster", s.mockCtx, item, configLib, expectedNewPosterPath).Return(nil).Once()
 
 	// Act
 	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
@@ -125,6 +130,232 @@ func (s *ItemProcessorTestSuite) TestProcessItem_Success() {
 	s.Nil(result.Err)
 }
 
+func (s *ItemProcessorTestSuite) TestSetStateStore() {
+	// Arrange
+	mockStateStore := appmocks.NewStateStore(s.T())
+
+	// Act
+	s.itemProcessor.SetStateStore(mockStateStore, true)
+
+	// Assert
+	s.Equal(mockStateStore, s.itemProcessor.stateStore)
+	s.True(s.itemProcessor.force)
+}
+
+// arrangeItemState writes the source and overlay posters of an item and returns
+// the state matching them
+func (s *ItemProcessorTestSuite) arrangeItemState(item model.Item, configLib *config.Library) (string, string, model.ItemState) {
+	dir := s.T().TempDir()
+	posterPath := filepath.Join(dir, "poster.jpg")
+	newPosterPath := filepath.Join(dir, "poster.overlay.jpg")
+	s.Require().NoError(os.WriteFile(posterPath, []byte("poster"), 0644))
+	s.Require().NoError(os.WriteFile(newPosterPath, []byte("overlay poster"), 0644))
+
+	overlayConfigHash, err := state.HashValue(configLib.Overlay)
+	s.Require().NoError(err)
+	sourcePosterHash, err := state.HashFile(posterPath)
+	s.Require().NoError(err)
+
+	return posterPath, newPosterPath, model.ItemState{
+		Ratings:           item.Ratings,
+		OverlayConfigHash: overlayConfigHash,
+		SourcePosterHash:  sourcePosterHash,
+		OutputPath:        newPosterPath,
+	}
+}
+
+func (s *ItemProcessorTestSuite) TestProcessItem_Unchanged_Skipped() {
+	// Arrange
+	item := model.Item{ID: "1", Title: "Test Movie"}
+	configLib := &config.Library{Name: "Movies"}
+	posterPath, _, itemState := s.arrangeItemState(item, configLib)
+	mockStateStore := appmocks.NewStateStore(s.T())
+	s.itemProcessor.SetStateStore(mockStateStore, false)
+	s.itemProcessor.SetMediaServiceName("plex")
+
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
+	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
+	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(posterPath, nil).Once()
+	mockStateStore.On("Get", "plex/1").Return(itemState, true).Once()
+
+	// Act
+	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
+
+	// Assert
+	s.True(result.Skipped)
+	s.Equal(posterPath, result.OriginalPosterDiskPosition)
+	s.Empty(result.OverlayPosterDiskPosition)
+	s.Nil(result.Err)
+}
+
+func (s *ItemProcessorTestSuite) TestProcessItem_UnselectedRatingChanged_Skipped() {
+	// Arrange
+	item := model.Item{ID: "1", Title: "Test Movie", Ratings: []model.Rating{{Name: "TMDB", Rating: 7.5}, {Name: "IMDB", Rating: 8.1}}}
+	configLib := &config.Library{Name: "Movies", Ratings: []config.RatingSource{{Name: "TMDB"}}}
+	posterPath, _, itemState := s.arrangeItemState(item, configLib)
+	itemState.Ratings = []model.Rating{{Name: "TMDB", Rating: 7.5}}
+	mockStateStore := appmocks.NewStateStore(s.T())
+	s.itemProcessor.SetStateStore(mockStateStore, false)
+	s.itemProcessor.SetMediaServiceName("plex")
+
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
+	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
+	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(posterPath, nil).Once()
+	mockStateStore.On("Get", "plex/1").Return(itemState, true).Once()
+
+	// Act
+	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
+
+	// Assert
+	s.True(result.Skipped)
+	s.Nil(result.Err)
+}
+
+func (s *ItemProcessorTestSuite) TestProcessItem_VotesChanged_Skipped() {
+	// Arrange
+	item := model.Item{ID: "1", Title: "Test Movie", Ratings: []model.Rating{{Name: "TMDB", Rating: 7.5, Votes: 1250}}}
+	configLib := &config.Library{Name: "Movies"}
+	posterPath, _, itemState := s.arrangeItemState(item, configLib)
+	itemState.Ratings = []model.Rating{{Name: "TMDB", Rating: 7.5}}
+	mockStateStore := appmocks.NewStateStore(s.T())
+	s.itemProcessor.SetStateStore(mockStateStore, false)
+	s.itemProcessor.SetMediaServiceName("plex")
+
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
+	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
+	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(posterPath, nil).Once()
+	mockStateStore.On("Get", "plex/1").Return(itemState, true).Once()
+
+	// Act
+	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
+
+	// Assert
+	s.True(result.Skipped)
+	s.Nil(result.Err)
+}
+
+func (s *ItemProcessorTestSuite) TestProcessItem_Changed_Processed() {
+	testCases := []struct {
+		name   string
+		force  bool
+		update func(itemState *model.ItemState)
+	}{
+		{
+			name:   "ratings changed",
+			update: func(itemState *model.ItemState) { itemState.Ratings = []model.Rating{{Name: "TMDB", Rating: 6.1}} },
+		},
+		{
+			name:   "overlay config changed",
+			update: func(itemState *model.ItemState) { itemState.OverlayConfigHash = "previous" },
+		},
+		{
+			name:   "source poster changed",
+			update: func(itemState *model.ItemState) { itemState.SourcePosterHash = "previous" },
+		},
+		{
+			name:   "output poster missing",
+			update: func(itemState *model.ItemState) { itemState.OutputPath = "/missing/poster.jpg" },
+		},
+		{
+			name:   "forced",
+			force:  true,
+			update: func(itemState *model.ItemState) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.SetupTest()
+			item := model.Item{ID: "1", Title: "Test Movie", Ratings: []model.Rating{{Name: "TMDB", Rating: 7.5}}}
+			configLib := &config.Library{Name: "Movies"}
+			posterPath, newPosterPath, itemState := s.arrangeItemState(item, configLib)
+			previousState := itemState
+			tc.update(&previousState)
+			mockStateStore := appmocks.NewStateStore(s.T())
+			s.itemProcessor.SetStateStore(mockStateStore, tc.force)
+			s.itemProcessor.SetMediaServiceName("plex")
+
+			s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
+			s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
+			s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(posterPath, nil).Once()
+			if !tc.force {
+				mockStateStore.On("Get", "plex/1").Return(previousState, true).Once()
+			}
+			s.mockPosterGenerator.On("ApplyLogos", s.mockCtx, posterPath, configLib, item).Return(newPosterPath, nil).Once()
+			s.mockPosterService.On("PublishPoster", s.mockCtx, item, configLib, newPosterPath).Return(nil).Once()
+			mockStateStore.On("Set", "plex/1", mock.MatchedBy(func(saved model.ItemState) bool {
+				return saved.SourcePosterHash == itemState.SourcePosterHash &&
+					saved.OverlayConfigHash == itemState.OverlayConfigHash &&
+					saved.OutputPath == newPosterPath &&
+					!saved.UpdatedAt.IsZero()
+			})).Return().Once()
+
+			// Act
+			result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
+
+			// Assert
+			s.False(result.Skipped)
+			s.Equal(newPosterPath, result.OverlayPosterDiskPosition)
+			s.Nil(result.Err)
+			s.TearDownTest()
+		})
+	}
+}
+
+func (s *ItemProcessorTestSuite) TestProcessItem_NotInStateStore_Processed() {
+	// Arrange
+	item := model.Item{ID: "1", Title: "Test Movie"}
+	configLib := &config.Library{Name: "Movies"}
+	posterPath, newPosterPath, _ := s.arrangeItemState(item, configLib)
+	mockStateStore := appmocks.NewStateStore(s.T())
+	s.itemProcessor.SetStateStore(mockStateStore, false)
+	s.itemProcessor.SetMediaServiceName("plex")
+
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
+	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
+	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(posterPath, nil).Once()
+	mockStateStore.On("Get", "plex/1").Return(model.ItemState{}, false).Once()
+	s.mockPosterGenerator.On("ApplyLogos", s.mockCtx, posterPath, configLib, item).Return(newPosterPath, nil).Once()
+	s.mockPosterService.On("PublishPoster", s.mockCtx, item, configLib, newPosterPath).Return(nil).Once()
+	mockStateStore.On("Set", "plex/1", mock.AnythingOfType("model.ItemState")).Return().Once()
+
+	// Act
+	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
+
+	// Assert
+	s.False(result.Skipped)
+	s.Equal(newPosterPath, result.OverlayPosterDiskPosition)
+	s.Nil(result.Err)
+}
+
+func (s *ItemProcessorTestSuite) TestProcessItem_DryRun() {
+	// Arrange
+	item := model.Item{ID: "1", Title: "Test Movie"}
+	configLib := &config.Library{Name: "Movies"}
+	previewPosterPath := "/previews/path/to/poster.jpg"
+	previewNewPosterPath := "/previews/path/to/new_poster.jpg"
+	mockPreviewResolver := appmocks.NewPreviewPathResolver(s.T())
+	mockStateStore := appmocks.NewStateStore(s.T())
+	s.itemProcessor.SetDryRun(mockPreviewResolver)
+	s.itemProcessor.SetStateStore(mockStateStore, false)
+
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
+	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
+	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return("/path/to/poster.jpg", nil).Once()
+	mockPreviewResolver.On("ResolvePath", "/path/to/poster.jpg").Return(previewPosterPath).Once()
+	s.mockPosterGenerator.On("ApplyLogos", s.mockCtx, previewPosterPath, configLib, item).Return(previewNewPosterPath, nil).Once()
+
+	// Act
+	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
+
+	// Assert
+	s.Equal(previewPosterPath, result.OriginalPosterDiskPosition)
+	s.Equal(previewNewPosterPath, result.OverlayPosterDiskPosition)
+	s.Nil(result.Err)
+	s.mockPosterService.AssertNotCalled(s.T(), "PublishPoster", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 // Placeholder for ProcessItems tests
 func (s *ItemProcessorTestSuite) TestProcessItems_Success() {
 	// Arrange
@@ -141,19 +372,21 @@ func (s *ItemProcessorTestSuite) TestProcessItems_Success() {
 	// Mock semaphore for item 1
 	s.mockWorkSemaphore.On("Acquire", mock.Anything, int64(1)).Return(nil).Once()
 	s.mockEligibilityChecker.On("IsEligible", &items[0]).Return(true).Once()
-	s.mockRatingBuilder.On("BuildRatings", mock.Anything, &items[0]).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, &items[0], mock.Anything).Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", mock.Anything, items[0], configLib).Return(nil).Once()
 	s.mockPosterService.On("GetPosterDiskPosition", mock.Anything, items[0], configLib).Return(expectedPosterPath1, nil).Once()
 	s.mockPosterGenerator.On("ApplyLogos", mock.Anything, expectedPosterPath1, configLib, items[0]).Return(expectedNewPosterPath1, nil).Once()
+	s.mockPosterService.On("PublishPoster", mock.Anything, items[0], configLib, expectedNewPosterPath1).Return(nil).Once()
 	s.mockWorkSemaphore.On("Release", int64(1)).Return().Once()
 
 	// Mock semaphore for item 2
 	s.mockWorkSemaphore.On("Acquire", mock.Anything, int64(1)).Return(nil).Once()
 	s.mockEligibilityChecker.On("IsEligible", &items[1]).Return(true).Once()
-	s.mockRatingBuilder.On("BuildRatings", mock.Anything, &items[1]).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, &items[1], mock.Anything).Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", mock.Anything, items[1], configLib).Return(nil).Once()
 	s.mockPosterService.On("GetPosterDiskPosition", mock.Anything, items[1], configLib).Return(expectedPosterPath2, nil).Once()
 	s.mockPosterGenerator.On("ApplyLogos", mock.Anything, expectedPosterPath2, configLib, items[1]).Return(expectedNewPosterPath2, nil).Once()
+	s.mockPosterService.On("PublishPoster", mock.Anything, items[1], configLib, expectedNewPosterPath2).Return(nil).Once()
 	s.mockWorkSemaphore.On("Release", int64(1)).Return().Once()
 
 	// Act
@@ -163,13 +396,53 @@ func (s *ItemProcessorTestSuite) TestProcessItems_Success() {
 	s.NoError(err)
 }
 
+func (s *ItemProcessorTestSuite) TestProcessItems_ItemFilter() {
+	testCases := []struct {
+		name   string
+		filter string
+	}{
+		{name: "by title, case-insensitively", filter: "movie 2"},
+		{name: "by ID", filter: "2"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.SetupTest()
+			items := []model.Item{
+				{ID: "1", Title: "Movie 1"},
+				{ID: "2", Title: "Movie 2"},
+			}
+			configLib := &config.Library{Name: "Movies"}
+			s.itemProcessor.SetItemFilter(tc.filter)
+
+			// Only the matching item is processed
+			s.mockWorkSemaphore.On("Acquire", mock.Anything, int64(1)).Return(nil).Once()
+			s.mockEligibilityChecker.On("IsEligible", &items[1]).Return(true).Once()
+			s.mockRatingBuilder.On("BuildRatings", mock.Anything, &items[1], mock.Anything).Return(nil).Once()
+			s.mockPosterService.On("EnsurePosterExists", mock.Anything, items[1], configLib).Return(nil).Once()
+			s.mockPosterService.On("GetPosterDiskPosition", mock.Anything, items[1], configLib).Return("/path/to/poster2.jpg", nil).Once()
+			s.mockPosterGenerator.On("ApplyLogos", mock.Anything, "/path/to/poster2.jpg", configLib, items[1]).Return("/path/to/new_poster2.jpg", nil).Once()
+			s.mockPosterService.On("PublishPoster", mock.Anything, items[1], configLib, "/path/to/new_poster2.jpg").Return(nil).Once()
+			s.mockWorkSemaphore.On("Release", int64(1)).Return().Once()
+
+			// Act
+			err := s.itemProcessor.ProcessItems(items, configLib)
+
+			// Assert
+			s.NoError(err)
+			s.TearDownTest()
+		})
+	}
+}
+
 func (s *ItemProcessorTestSuite) TestProcessItem_RatingBuilderError() {
 	// Arrange
 	item := model.Item{ID: "1", Title: "Test Movie"}
 	configLib := &config.Library{Name: "Movies"}
 	expectedError := fmt.Errorf("rating builder error")
 
-	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item).Return(expectedError).Once()
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(expectedError).Once()
 
 	// Act
 	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
@@ -188,7 +461,7 @@ func (s *ItemProcessorTestSuite) TestProcessItem_EnsurePosterExistsError() {
 	configLib := &config.Library{Name: "Movies"}
 	expectedError := fmt.Errorf("ensure poster error")
 
-	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(expectedError).Once()
 
 	// Act
@@ -205,7 +478,7 @@ func (s *ItemProcessorTestSuite) TestProcessItem_GetPosterDiskPositionError() {
 	configLib := &config.Library{Name: "Movies"}
 	expectedError := fmt.Errorf("get poster disk position error")
 
-	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
 	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return("", expectedError).Once()
 
@@ -224,7 +497,7 @@ func (s *ItemProcessorTestSuite) TestProcessItem_ApplyLogosError() {
 	expectedPosterPath := "/path/to/poster.jpg"
 	expectedError := fmt.Errorf("apply logos error")
 
-	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
 	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(expectedPosterPath, nil).Once()
 	s.mockPosterGenerator.On("ApplyLogos", s.mockCtx, expectedPosterPath, configLib, item).Return("", expectedError).Once()
@@ -241,6 +514,50 @@ func (s *ItemProcessorTestSuite) TestProcessItem_ApplyLogosError() {
 	// s.Equal(expectedPosterPath, result.OriginalPosterDiskPosition)
 }
 
+func (s *ItemProcessorTestSuite) TestProcessItem_NoRatingToShow_Skipped() {
+	// Arrange
+	item := model.Item{ID: "1", Title: "Test Movie", Ratings: []model.Rating{{Name: "IMDB", Rating: 8.1}}}
+	configLib := &config.Library{Name: "Movies", Ratings: []config.RatingSource{{Name: "TMDB"}}}
+	expectedPosterPath := "/path/to/poster.jpg"
+
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
+	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
+	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(expectedPosterPath, nil).Once()
+	s.mockPosterGenerator.On("ApplyLogos", s.mockCtx, expectedPosterPath, configLib, item).Return(expectedPosterPath, errors.New(model.NoRatingToShow)).Once()
+
+	// Act
+	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
+
+	// Assert
+	s.True(result.Skipped)
+	s.Nil(result.Err)
+	s.mockPosterService.AssertNotCalled(s.T(), "PublishPoster", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ItemProcessorTestSuite) TestProcessItem_PublishPosterError() {
+	// Arrange
+	item := model.Item{ID: "1", Title: "Test Movie"}
+	configLib := &config.Library{Name: "Movies"}
+	expectedPosterPath := "/path/to/poster.jpg"
+	expectedNewPosterPath := "/path/to/new_poster.jpg"
+	expectedError := fmt.Errorf("publish poster error")
+
+	s.mockRatingBuilder.On("BuildRatings", s.mockCtx, &item, mock.Anything).Return(nil).Once()
+	s.mockPosterService.On("EnsurePosterExists", s.mockCtx, item, configLib).Return(nil).Once()
+	s.mockPosterService.On("GetPosterDiskPosition", s.mockCtx, item, configLib).Return(expectedPosterPath, nil).Once()
+	s.mockPosterGenerator.On("ApplyLogos", s.mockCtx, expectedPosterPath, configLib, item).Return(expectedNewPosterPath, nil).Once()
+	s.mockPosterService.On("PublishPoster", s.mockCtx, item, configLib, expectedNewPosterPath).Return(expectedError).Once()
+
+	// Act
+	result := s.itemProcessor.ProcessItem(item, 0, s.mockPosterService, configLib)
+
+	// Assert
+	s.Equal(item.Title, result.Title)
+	s.Equal(expectedPosterPath, result.OriginalPosterDiskPosition)
+	s.Empty(result.OverlayPosterDiskPosition)
+	s.Equal(expectedError, result.Err)
+}
+
 func (s *ItemProcessorTestSuite) TestProcessItems_NotEligible() {
 	// Arrange
 	items := []model.Item{
@@ -315,7 +632,7 @@ func (s *ItemProcessorTestSuite) TestProcessItem_ContextCancelled_AfterGetPoster
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	s.mockRatingBuilder.On("BuildRatings", ctx, &item).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", ctx, &item, mock.Anything).Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", ctx, item, configLib).Return(nil).Once()
 	s.mockPosterService.On("GetPosterDiskPosition", ctx, item, configLib).Return(expectedPosterPath, nil).Run(func(args mock.Arguments) {
 		cancel() // Cancel context after this call
@@ -342,7 +659,7 @@ func (s *ItemProcessorTestSuite) TestProcessItem_ContextCancelled_AfterApplyLogo
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	s.mockRatingBuilder.On("BuildRatings", ctx, &item).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", ctx, &item, mock.Anything).Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", ctx, item, configLib).Return(nil).Once()
 	s.mockPosterService.On("GetPosterDiskPosition", ctx, item, configLib).Return(expectedPosterPath, nil).Once()
 	s.mockPosterGenerator.On("ApplyLogos", ctx, expectedPosterPath, configLib, item).Return(expectedNewPosterPath, nil).Run(func(args mock.Arguments) {
@@ -386,17 +703,18 @@ func (s *ItemProcessorTestSuite) TestProcessItems_ItemProcessingError() {
 	// Item 1 (error) - mock semaphore
 	s.mockWorkSemaphore.On("Acquire", mock.Anything, int64(1)).Return(nil).Once()
 	s.mockEligibilityChecker.On("IsEligible", &item1).Return(true).Once()
-	s.mockRatingBuilder.On("BuildRatings", mock.Anything, &item1).Return(expectedErrorForItem1).Once()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, &item1, mock.Anything).Return(expectedErrorForItem1).Once()
 	// Release is still called in defer even if BuildRatings errors
 	s.mockWorkSemaphore.On("Release", int64(1)).Return().Once()
 
 	// Item 2 (success) - mock semaphore
 	s.mockWorkSemaphore.On("Acquire", mock.Anything, int64(1)).Return(nil).Once()
 	s.mockEligibilityChecker.On("IsEligible", &item2).Return(true).Once()
-	s.mockRatingBuilder.On("BuildRatings", mock.Anything, &item2).Return(nil).Once()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, &item2, mock.Anything).Return(nil).Once()
 	s.mockPosterService.On("EnsurePosterExists", mock.Anything, item2, configLib).Return(nil).Once()
 	s.mockPosterService.On("GetPosterDiskPosition", mock.Anything, item2, configLib).Return(expectedPosterPath2, nil).Once()
 	s.mockPosterGenerator.On("ApplyLogos", mock.Anything, expectedPosterPath2, configLib, item2).Return(expectedNewPosterPath2, nil).Once()
+	s.mockPosterService.On("PublishPoster", mock.Anything, item2, configLib, expectedNewPosterPath2).Return(nil).Once()
 	s.mockWorkSemaphore.On("Release", int64(1)).Return().Once()
 
 	// Act
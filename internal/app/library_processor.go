@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 // ServiceContext holds the services required for processing a library
 type ServiceContext struct {
+	MediaServiceName string
 	LibrariesService media.LibraryService
 	ItemsService     media.ItemService
 	PostersService   media.PosterService
@@ -26,11 +28,14 @@ type LibraryProcessor struct {
 	itemProcessor ItemProcessor
 	// Default timeout for operations
 	defaultTimeout time.Duration
+	dryRun         bool
 }
 
 // LibraryProcessorConfig holds the configuration for a LibraryProcessor
 type LibraryProcessorConfig struct {
 	DefaultTimeout time.Duration
+	// DryRun leaves the libraries untouched, they are never refreshed
+	DryRun bool
 }
 
 // DefaultLibraryProcessorConfig returns a default configuration for LibraryProcessor
@@ -54,6 +59,7 @@ func NewLibrariesProcessor(
 		logger:         logger,
 		itemProcessor:  itemProcessor,
 		defaultTimeout: config.DefaultTimeout,
+		dryRun:         config.DryRun,
 	}
 }
 
@@ -103,40 +109,34 @@ func (lp *LibraryProcessor) ProcessLibrary(
 		return fmt.Errorf("context cancelled before retrieving items: %w", err)
 	}
 
-	// Retrieve the library items
-	libraryItems, err := serviceCtx.ItemsService.GetItems(ctx, library, configLibrary)
+	// set posters service for the item processor
+	lp.itemProcessor.SetPosterService(serviceCtx.PostersService)
+	lp.itemProcessor.SetMediaServiceName(serviceCtx.MediaServiceName)
+
+	// Process the library items, page by page when the media service supports it
+	var itemsCount int
+	var err error
+	if pagedItemsService, ok := serviceCtx.ItemsService.(media.PagedItemService); ok {
+		itemsCount, err = lp.processItemPages(ctx, pagedItemsService, library, configLibrary)
+	} else {
+		itemsCount, err = lp.processItems(ctx, serviceCtx.ItemsService, library, configLibrary)
+	}
 	if err != nil {
-		return fmt.Errorf("unable to retrieve items: %w", err)
+		return err
 	}
 
-	if len(libraryItems) == 0 {
+	if itemsCount == 0 {
 		lp.logger.Info("No items found in the library", zap.String("library", library.Name))
 		return nil
 	}
 
-	lp.logger.Info("Processing items", zap.Int("count", len(libraryItems)))
-
-	// Check for context cancellation before processing items
-	if err := ctx.Err(); err != nil {
-		return fmt.Errorf("context cancelled before processing items: %w", err)
-	}
-
-	// set posters service for the item processor
-	lp.itemProcessor.SetPosterService(serviceCtx.PostersService)
-
-	// Process the library items
-	err = lp.itemProcessor.ProcessItems(libraryItems, configLibrary)
-	if err != nil {
-		return fmt.Errorf("error processing items: %w", err)
-	}
-
 	// Check for context cancellation before refreshing
 	if err := ctx.Err(); err != nil {
 		return fmt.Errorf("context cancelled before refreshing library: %w", err)
 	}
 
 	// Refresh the library if requested
-	if configLibrary.Refresh {
+	if configLibrary.Refresh && !lp.dryRun {
 		lp.logger.Info("Refreshing library...", zap.String("library", library.Name))
 		if err := serviceCtx.LibrariesService.RefreshLibrary(ctx, library.ID, true); err != nil {
 			return fmt.Errorf("unable to refresh library: %w", err)
@@ -147,6 +147,139 @@ func (lp *LibraryProcessor) ProcessLibrary(
 	return nil
 }
 
+// ProcessLibraryItem processes a single item of the library, e.g. one a webhook notified about,
+// then refreshes its metadata when the media service supports it
+func (lp *LibraryProcessor) ProcessLibraryItem(
+	ctx context.Context,
+	configLibrary *config.Library,
+	itemID string,
+	serviceCtx ServiceContext,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, lp.defaultTimeout)
+	defer cancel()
+
+	if err := lp.validateServiceContext(serviceCtx); err != nil {
+		return err
+	}
+
+	singleItemService, ok := serviceCtx.ItemsService.(media.SingleItemService)
+	if !ok {
+		return fmt.Errorf("media service %s does not support retrieving a single item", serviceCtx.MediaServiceName)
+	}
+
+	items, err := singleItemService.GetItem(ctx, itemID, configLibrary)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve item %s: %w", itemID, err)
+	}
+
+	lp.itemProcessor.SetPosterService(serviceCtx.PostersService)
+	lp.itemProcessor.SetMediaServiceName(serviceCtx.MediaServiceName)
+
+	var errs []error
+	for i, item := range items {
+		if !lp.itemProcessor.eligibilityChecker.IsEligible(&item) {
+			lp.logger.Info("Item not eligible, skipped",
+				zap.String("Item ID", item.ID),
+				zap.String("Title", item.Title),
+			)
+			continue
+		}
+
+		result := lp.itemProcessor.ProcessItem(item, i, serviceCtx.PostersService, configLibrary)
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("item %s: %w", item.Title, result.Err))
+			continue
+		}
+
+		lp.logger.Info("Item processed",
+			zap.String("Title", result.Title),
+			zap.Bool("Skipped", result.Skipped),
+			zap.String("Overlay Poster Disk Position", result.OverlayPosterDiskPosition),
+		)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("error processing item %s: %w", itemID, err)
+	}
+
+	if lp.dryRun {
+		return nil
+	}
+
+	if itemRefresher, ok := serviceCtx.LibrariesService.(media.ItemRefresher); ok {
+		if err := itemRefresher.RefreshItem(ctx, itemID); err != nil {
+			return fmt.Errorf("unable to refresh item %s: %w", itemID, err)
+		}
+		lp.logger.Info("Item refreshed successfully", zap.String("Item ID", itemID))
+	}
+
+	return nil
+}
+
+// processItems retrieves all the library items, then processes them
+func (lp *LibraryProcessor) processItems(
+	ctx context.Context,
+	itemsService media.ItemService,
+	library model.Library,
+	configLibrary *config.Library,
+) (int, error) {
+	libraryItems, err := itemsService.GetItems(ctx, library, configLibrary)
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve items: %w", err)
+	}
+
+	if len(libraryItems) == 0 {
+		return 0, nil
+	}
+
+	lp.logger.Info("Processing items", zap.Int("count", len(libraryItems)))
+
+	// Check for context cancellation before processing items
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("context cancelled before processing items: %w", err)
+	}
+
+	if err := lp.itemProcessor.ProcessItems(libraryItems, configLibrary); err != nil {
+		return 0, fmt.Errorf("error processing items: %w", err)
+	}
+
+	return len(libraryItems), nil
+}
+
+// processItemPages processes each page of library items as soon as it is retrieved,
+// so large libraries are never held in memory at once
+func (lp *LibraryProcessor) processItemPages(
+	ctx context.Context,
+	itemsService media.PagedItemService,
+	library model.Library,
+	configLibrary *config.Library,
+) (int, error) {
+	var itemsCount int
+
+	err := itemsService.GetItemPages(ctx, library, configLibrary, func(items []model.Item) error {
+		itemsCount += len(items)
+		lp.logger.Info("Processing items page",
+			zap.Int("count", len(items)),
+			zap.Int("total", itemsCount),
+		)
+
+		// Check for context cancellation before processing items
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("context cancelled before processing items: %w", err)
+		}
+
+		if err := lp.itemProcessor.ProcessItems(items, configLibrary); err != nil {
+			return fmt.Errorf("error processing items: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return itemsCount, fmt.Errorf("unable to process items: %w", err)
+	}
+
+	return itemsCount, nil
+}
+
 func (lp *LibraryProcessor) validateServiceContext(serviceCtx ServiceContext) error {
 	if serviceCtx.LibrariesService == nil {
 		lp.logger.Error("Libraries service not set")
@@ -148,11 +148,12 @@ func (s *LibraryProcessorTestSuite) TestProcessLibrary_Success() {
 	//    ItemProcessor.ProcessItems will be called. For it to succeed, its dependencies must not error out in a critical way.
 	//    These are .Maybe() because their exact invocation count might vary depending on ItemProcessor logic for one item.
 	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(true).Maybe()
-	s.mockRatingBuilder.On("BuildRatings", mock.Anything, mock.AnythingOfType("*model.Item")).Return(nil).Maybe()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, mock.AnythingOfType("*model.Item"), mock.Anything).Return(nil).Maybe()
 	//    If ItemProcessor calls its internal posterService (which would be s.mockPostersService after SetPosterService)
 	s.mockPostersService.On("EnsurePosterExists", mock.Anything, mock.AnythingOfType("model.Item"), configLibrary).Return(nil).Maybe()
 	//    If ItemProcessor calls PosterGenerator
 	s.mockPosterGenerator.On("ApplyLogos", mock.Anything, mock.AnythingOfType("string"), configLibrary, mock.AnythingOfType("model.Item")).Return("new/path.jpg", nil).Maybe()
+	s.mockPostersService.On("PublishPoster", mock.Anything, mock.AnythingOfType("model.Item"), configLibrary, "new/path.jpg").Return(nil).Maybe()
 
 	// 3. LibrariesService.RefreshLibrary successfully refreshes
 	s.mockLibrariesService.On("RefreshLibrary", mock.Anything, mediaLib.ID, true).Return(nil).Once()
@@ -165,6 +166,29 @@ func (s *LibraryProcessorTestSuite) TestProcessLibrary_Success() {
 	// Mock expectations are asserted in TearDownTest
 }
 
+func (s *LibraryProcessorTestSuite) TestProcessLibrary_DryRunSkipsRefresh() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true, Refresh: true}
+	mediaLib := model.Library{ID: "lib1", Name: "Movies"}
+	serviceCtx := ServiceContext{
+		LibrariesService: s.mockLibrariesService,
+		ItemsService:     s.mockItemsService,
+		PostersService:   s.mockPostersService,
+	}
+	s.processor = NewLibrariesProcessor(s.logger, *s.realItemProcessor, &LibraryProcessorConfig{DefaultTimeout: 30 * time.Second, DryRun: true})
+
+	// Arrange: one ineligible item, so the library is processed without rendering anything
+	s.mockItemsService.On("GetItems", mock.Anything, mediaLib, configLibrary).Return([]model.Item{{ID: "item1"}}, nil).Once()
+	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(false).Once()
+
+	// Act
+	err := s.processor.ProcessLibrary(ctx, configLibrary, &[]model.Library{mediaLib}, serviceCtx)
+
+	// Assert
+	s.NoError(err)
+	s.mockLibrariesService.AssertNotCalled(s.T(), "RefreshLibrary", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (s *LibraryProcessorTestSuite) TestProcessLibrary_ContextCancelled_BeforeProcessing() {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // Cancel context immediately
@@ -325,7 +349,7 @@ func (s *LibraryProcessorTestSuite) TestProcessLibrary_NoItemsFound() {
 	s.Assert().NoError(err)
 	// ItemProcessor.ProcessItems should not be called if no items are found.
 	// We can check that by ensuring none of its core operational mocks (like RatingBuilder) were called.
-	s.mockRatingBuilder.AssertNotCalled(s.T(), "BuildRatings", mock.Anything, mock.Anything)
+	s.mockRatingBuilder.AssertNotCalled(s.T(), "BuildRatings", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (s *LibraryProcessorTestSuite) TestProcessLibrary_ProcessItemsError() {
@@ -377,9 +401,10 @@ func (s *LibraryProcessorTestSuite) TestProcessLibrary_RefreshLibraryError() {
 	s.mockItemsService.On("GetItems", mock.Anything, mediaLib, configLibrary).Return(libraryItems, nil).Once()
 
 	// Assume ItemProcessor.ProcessItems succeeds
-	s.mockRatingBuilder.On("BuildRatings", mock.Anything, mock.AnythingOfType("*model.Item")).Return(nil).Maybe()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, mock.AnythingOfType("*model.Item"), mock.Anything).Return(nil).Maybe()
 	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(true).Maybe()
 	s.mockPosterGenerator.On("ApplyLogos", mock.Anything, mock.AnythingOfType("string"), configLibrary, mock.AnythingOfType("model.Item")).Return("new/path.jpg", nil).Maybe()
+	s.mockPostersService.On("PublishPoster", mock.Anything, mock.AnythingOfType("model.Item"), configLibrary, "new/path.jpg").Return(nil).Maybe()
 	s.mockPostersService.On("EnsurePosterExists", mock.Anything, mock.AnythingOfType("model.Item"), configLibrary).Return(nil).Maybe()
 	s.mockPostersService.On("GetPosterDiskPosition", mock.Anything, mock.AnythingOfType("model.Item"), configLibrary).Return("", nil).Maybe()
 
@@ -405,9 +430,10 @@ func (s *LibraryProcessorTestSuite) TestProcessLibrary_RefreshDisabled() {
 	s.mockItemsService.On("GetItems", mock.Anything, mediaLib, configLibrary).Return(libraryItems, nil).Once()
 
 	// Assume ItemProcessor.ProcessItems succeeds
-	s.mockRatingBuilder.On("BuildRatings", mock.Anything, mock.AnythingOfType("*model.Item")).Return(nil).Maybe()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, mock.AnythingOfType("*model.Item"), mock.Anything).Return(nil).Maybe()
 	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(true).Maybe()
 	s.mockPosterGenerator.On("ApplyLogos", mock.Anything, mock.AnythingOfType("string"), configLibrary, mock.AnythingOfType("model.Item")).Return("new/path.jpg", nil).Maybe()
+	s.mockPostersService.On("PublishPoster", mock.Anything, mock.AnythingOfType("model.Item"), configLibrary, "new/path.jpg").Return(nil).Maybe()
 	s.mockPostersService.On("EnsurePosterExists", mock.Anything, mock.AnythingOfType("model.Item"), configLibrary).Return(nil).Maybe()
 	s.mockPostersService.On("GetPosterDiskPosition", mock.Anything, mock.AnythingOfType("model.Item"), configLibrary).Return("", nil).Maybe()
 
@@ -416,3 +442,217 @@ func (s *LibraryProcessorTestSuite) TestProcessLibrary_RefreshDisabled() {
 	s.Assert().NoError(err)
 	s.mockLibrariesService.AssertNotCalled(s.T(), "RefreshLibrary", mock.Anything, mock.Anything, mock.Anything)
 }
+
+// pagedItemsService combines both item service mocks, like the Plex item service
+type pagedItemsService struct {
+	*mediamocks.ItemService
+	*mediamocks.PagedItemService
+}
+
+func (s *LibraryProcessorTestSuite) TestProcessLibrary_PagedItems() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true, Refresh: true}
+	mediaLib := model.Library{ID: "lib1", Name: "Movies"}
+	mediaServiceLibraries := &[]model.Library{mediaLib}
+	mockPagedItemsService := mediamocks.NewPagedItemService(s.T())
+	serviceCtx := ServiceContext{
+		LibrariesService: s.mockLibrariesService,
+		ItemsService:     pagedItemsService{s.mockItemsService, mockPagedItemsService},
+		PostersService:   s.mockPostersService,
+	}
+	pages := [][]model.Item{
+		{{ID: "item1", Title: "Movie 1"}, {ID: "item2", Title: "Movie 2"}},
+		{{ID: "item3", Title: "Movie 3"}},
+	}
+
+	// Arrange: every page is handed to the item processor, GetItems is never called
+	mockPagedItemsService.On("GetItemPages", mock.Anything, mediaLib, configLibrary, mock.Anything).
+		Run(func(args mock.Arguments) {
+			handlePage := args.Get(3).(func([]model.Item) error)
+			for _, page := range pages {
+				s.Require().NoError(handlePage(page))
+			}
+		}).Return(nil).Once()
+	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(false).Times(3)
+	s.mockLibrariesService.On("RefreshLibrary", mock.Anything, mediaLib.ID, true).Return(nil).Once()
+
+	// Act
+	err := s.processor.ProcessLibrary(ctx, configLibrary, mediaServiceLibraries, serviceCtx)
+
+	// Assert
+	s.Assert().NoError(err)
+	s.mockItemsService.AssertNotCalled(s.T(), "GetItems", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *LibraryProcessorTestSuite) TestProcessLibrary_PagedItemsEmpty() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true, Refresh: true}
+	mediaLib := model.Library{ID: "lib1", Name: "Movies"}
+	mediaServiceLibraries := &[]model.Library{mediaLib}
+	mockPagedItemsService := mediamocks.NewPagedItemService(s.T())
+	serviceCtx := ServiceContext{
+		LibrariesService: s.mockLibrariesService,
+		ItemsService:     pagedItemsService{s.mockItemsService, mockPagedItemsService},
+		PostersService:   s.mockPostersService,
+	}
+
+	// Arrange: no page at all, so no refresh either
+	mockPagedItemsService.On("GetItemPages", mock.Anything, mediaLib, configLibrary, mock.Anything).Return(nil).Once()
+
+	// Act
+	err := s.processor.ProcessLibrary(ctx, configLibrary, mediaServiceLibraries, serviceCtx)
+
+	// Assert
+	s.Assert().NoError(err)
+}
+
+func (s *LibraryProcessorTestSuite) TestProcessLibrary_PagedItemsError() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true}
+	mediaLib := model.Library{ID: "lib1", Name: "Movies"}
+	mediaServiceLibraries := &[]model.Library{mediaLib}
+	mockPagedItemsService := mediamocks.NewPagedItemService(s.T())
+	serviceCtx := ServiceContext{
+		LibrariesService: s.mockLibrariesService,
+		ItemsService:     pagedItemsService{s.mockItemsService, mockPagedItemsService},
+		PostersService:   s.mockPostersService,
+	}
+	expectedErr := errors.New("page error")
+
+	mockPagedItemsService.On("GetItemPages", mock.Anything, mediaLib, configLibrary, mock.Anything).Return(expectedErr).Once()
+
+	// Act
+	err := s.processor.ProcessLibrary(ctx, configLibrary, mediaServiceLibraries, serviceCtx)
+
+	// Assert
+	s.Assert().ErrorIs(err, expectedErr)
+	s.Assert().Contains(err.Error(), "unable to process items")
+}
+
+// singleItemService combines both item service mocks, like the Plex item service
+type singleItemService struct {
+	*mediamocks.ItemService
+	*mediamocks.SingleItemService
+}
+
+// itemRefresherLibraryService combines both library service mocks, like the Plex library service
+type itemRefresherLibraryService struct {
+	*mediamocks.LibraryService
+	*mediamocks.ItemRefresher
+}
+
+func (s *LibraryProcessorTestSuite) TestProcessLibraryItem_Success() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true}
+	mockSingleItemService := mediamocks.NewSingleItemService(s.T())
+	mockItemRefresher := mediamocks.NewItemRefresher(s.T())
+	serviceCtx := ServiceContext{
+		MediaServiceName: "plex",
+		LibrariesService: itemRefresherLibraryService{s.mockLibrariesService, mockItemRefresher},
+		ItemsService:     singleItemService{s.mockItemsService, mockSingleItemService},
+		PostersService:   s.mockPostersService,
+	}
+	item := model.Item{ID: "1234", Title: "The Matrix"}
+
+	// Arrange: the item is processed on its own, then refreshed
+	mockSingleItemService.On("GetItem", mock.Anything, "1234", configLibrary).Return([]model.Item{item}, nil).Once()
+	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(true).Once()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, mock.AnythingOfType("*model.Item"), mock.Anything).Return(nil).Once()
+	s.mockPostersService.On("EnsurePosterExists", mock.Anything, item, configLibrary).Return(nil).Once()
+	s.mockPostersService.On("GetPosterDiskPosition", mock.Anything, item, configLibrary).Return("poster.jpg", nil).Once()
+	s.mockPosterGenerator.On("ApplyLogos", mock.Anything, "poster.jpg", configLibrary, item).Return("poster.overlay.jpg", nil).Once()
+	s.mockPostersService.On("PublishPoster", mock.Anything, item, configLibrary, "poster.overlay.jpg").Return(nil).Once()
+	mockItemRefresher.On("RefreshItem", mock.Anything, "1234").Return(nil).Once()
+
+	// Act
+	err := s.processor.ProcessLibraryItem(ctx, configLibrary, "1234", serviceCtx)
+
+	// Assert
+	s.Assert().NoError(err)
+	s.mockItemsService.AssertNotCalled(s.T(), "GetItems", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *LibraryProcessorTestSuite) TestProcessLibraryItem_NotEligible() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true}
+	mockSingleItemService := mediamocks.NewSingleItemService(s.T())
+	mockItemRefresher := mediamocks.NewItemRefresher(s.T())
+	serviceCtx := ServiceContext{
+		LibrariesService: itemRefresherLibraryService{s.mockLibrariesService, mockItemRefresher},
+		ItemsService:     singleItemService{s.mockItemsService, mockSingleItemService},
+		PostersService:   s.mockPostersService,
+	}
+
+	mockSingleItemService.On("GetItem", mock.Anything, "1234", configLibrary).Return([]model.Item{{ID: "1234"}}, nil).Once()
+	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(false).Once()
+	mockItemRefresher.On("RefreshItem", mock.Anything, "1234").Return(nil).Once()
+
+	// Act
+	err := s.processor.ProcessLibraryItem(ctx, configLibrary, "1234", serviceCtx)
+
+	// Assert
+	s.Assert().NoError(err)
+	s.mockPostersService.AssertNotCalled(s.T(), "PublishPoster", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *LibraryProcessorTestSuite) TestProcessLibraryItem_ProcessingErrorSkipsRefresh() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true}
+	mockSingleItemService := mediamocks.NewSingleItemService(s.T())
+	mockItemRefresher := mediamocks.NewItemRefresher(s.T())
+	serviceCtx := ServiceContext{
+		LibrariesService: itemRefresherLibraryService{s.mockLibrariesService, mockItemRefresher},
+		ItemsService:     singleItemService{s.mockItemsService, mockSingleItemService},
+		PostersService:   s.mockPostersService,
+	}
+	expectedErr := errors.New("rating error")
+
+	mockSingleItemService.On("GetItem", mock.Anything, "1234", configLibrary).Return([]model.Item{{ID: "1234", Title: "The Matrix"}}, nil).Once()
+	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(true).Once()
+	s.mockRatingBuilder.On("BuildRatings", mock.Anything, mock.AnythingOfType("*model.Item"), mock.Anything).Return(expectedErr).Once()
+
+	// Act
+	err := s.processor.ProcessLibraryItem(ctx, configLibrary, "1234", serviceCtx)
+
+	// Assert
+	s.Assert().ErrorIs(err, expectedErr)
+	mockItemRefresher.AssertNotCalled(s.T(), "RefreshItem", mock.Anything, mock.Anything)
+}
+
+func (s *LibraryProcessorTestSuite) TestProcessLibraryItem_GetItemError() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true}
+	mockSingleItemService := mediamocks.NewSingleItemService(s.T())
+	serviceCtx := ServiceContext{
+		LibrariesService: s.mockLibrariesService,
+		ItemsService:     singleItemService{s.mockItemsService, mockSingleItemService},
+		PostersService:   s.mockPostersService,
+	}
+	expectedErr := errors.New("item 1234 not found")
+
+	mockSingleItemService.On("GetItem", mock.Anything, "1234", configLibrary).Return(nil, expectedErr).Once()
+
+	// Act
+	err := s.processor.ProcessLibraryItem(ctx, configLibrary, "1234", serviceCtx)
+
+	// Assert
+	s.Assert().ErrorIs(err, expectedErr)
+	s.Assert().Contains(err.Error(), "unable to retrieve item 1234")
+}
+
+func (s *LibraryProcessorTestSuite) TestProcessLibraryItem_SingleItemNotSupported() {
+	ctx := context.Background()
+	configLibrary := &config.Library{Name: "Movies", Enabled: true}
+	serviceCtx := ServiceContext{
+		MediaServiceName: "kodi",
+		LibrariesService: s.mockLibrariesService,
+		ItemsService:     s.mockItemsService,
+		PostersService:   s.mockPostersService,
+	}
+
+	// Act
+	err := s.processor.ProcessLibraryItem(ctx, configLibrary, "1234", serviceCtx)
+
+	// Assert
+	s.Assert().EqualError(err, "media service kodi does not support retrieving a single item")
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package core_mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// PosterFileRestorer is an autogenerated mock type for the PosterFileRestorer type
+type PosterFileRestorer struct {
+	mock.Mock
+}
+
+type PosterFileRestorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PosterFileRestorer) EXPECT() *PosterFileRestorer_Expecter {
+	return &PosterFileRestorer_Expecter{mock: &_m.Mock}
+}
+
+// RestorePosters provides a mock function with given fields: dir, dryRun
+func (_m *PosterFileRestorer) RestorePosters(dir string, dryRun bool) ([]model.RestoreAction, error) {
+	ret := _m.Called(dir, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestorePosters")
+	}
+
+	var r0 []model.RestoreAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) ([]model.RestoreAction, error)); ok {
+		return rf(dir, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) []model.RestoreAction); ok {
+		r0 = rf(dir, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.RestoreAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(dir, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PosterFileRestorer_RestorePosters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestorePosters'
+type PosterFileRestorer_RestorePosters_Call struct {
+	*mock.Call
+}
+
+// RestorePosters is a helper method to define mock.On call
+//   - dir string
+//   - dryRun bool
+func (_e *PosterFileRestorer_Expecter) RestorePosters(dir interface{}, dryRun interface{}) *PosterFileRestorer_RestorePosters_Call {
+	return &PosterFileRestorer_RestorePosters_Call{Call: _e.mock.On("RestorePosters", dir, dryRun)}
+}
+
+func (_c *PosterFileRestorer_RestorePosters_Call) Run(run func(dir string, dryRun bool)) *PosterFileRestorer_RestorePosters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *PosterFileRestorer_RestorePosters_Call) Return(_a0 []model.RestoreAction, _a1 error) *PosterFileRestorer_RestorePosters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PosterFileRestorer_RestorePosters_Call) RunAndReturn(run func(string, bool) ([]model.RestoreAction, error)) *PosterFileRestorer_RestorePosters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPosterFileRestorer creates a new instance of PosterFileRestorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPosterFileRestorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PosterFileRestorer {
+	mock := &PosterFileRestorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
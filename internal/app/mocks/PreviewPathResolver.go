@@ -0,0 +1,78 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package core_mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// PreviewPathResolver is an autogenerated mock type for the PreviewPathResolver type
+type PreviewPathResolver struct {
+	mock.Mock
+}
+
+type PreviewPathResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PreviewPathResolver) EXPECT() *PreviewPathResolver_Expecter {
+	return &PreviewPathResolver_Expecter{mock: &_m.Mock}
+}
+
+// ResolvePath provides a mock function with given fields: filePath
+func (_m *PreviewPathResolver) ResolvePath(filePath string) string {
+	ret := _m.Called(filePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolvePath")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(filePath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PreviewPathResolver_ResolvePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolvePath'
+type PreviewPathResolver_ResolvePath_Call struct {
+	*mock.Call
+}
+
+// ResolvePath is a helper method to define mock.On call
+//   - filePath string
+func (_e *PreviewPathResolver_Expecter) ResolvePath(filePath interface{}) *PreviewPathResolver_ResolvePath_Call {
+	return &PreviewPathResolver_ResolvePath_Call{Call: _e.mock.On("ResolvePath", filePath)}
+}
+
+func (_c *PreviewPathResolver_ResolvePath_Call) Run(run func(filePath string)) *PreviewPathResolver_ResolvePath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PreviewPathResolver_ResolvePath_Call) Return(_a0 string) *PreviewPathResolver_ResolvePath_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PreviewPathResolver_ResolvePath_Call) RunAndReturn(run func(string) string) *PreviewPathResolver_ResolvePath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPreviewPathResolver creates a new instance of PreviewPathResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreviewPathResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreviewPathResolver {
+	mock := &PreviewPathResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,17 +23,17 @@ func (_m *RatingBuilder) EXPECT() *RatingBuilder_Expecter {
 	return &RatingBuilder_Expecter{mock: &_m.Mock}
 }
 
-// BuildRatings provides a mock function with given fields: ctx, item
-func (_m *RatingBuilder) BuildRatings(ctx context.Context, item *model.Item) error {
-	ret := _m.Called(ctx, item)
+// BuildRatings provides a mock function with given fields: ctx, item, libraryName
+func (_m *RatingBuilder) BuildRatings(ctx context.Context, item *model.Item, libraryName string) error {
+	ret := _m.Called(ctx, item, libraryName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BuildRatings")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Item) error); ok {
-		r0 = rf(ctx, item)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Item, string) error); ok {
+		r0 = rf(ctx, item, libraryName)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,13 +49,14 @@ type RatingBuilder_BuildRatings_Call struct {
 // BuildRatings is a helper method to define mock.On call
 //   - ctx context.Context
 //   - item *model.Item
-func (_e *RatingBuilder_Expecter) BuildRatings(ctx interface{}, item interface{}) *RatingBuilder_BuildRatings_Call {
-	return &RatingBuilder_BuildRatings_Call{Call: _e.mock.On("BuildRatings", ctx, item)}
+//   - libraryName string
+func (_e *RatingBuilder_Expecter) BuildRatings(ctx interface{}, item interface{}, libraryName interface{}) *RatingBuilder_BuildRatings_Call {
+	return &RatingBuilder_BuildRatings_Call{Call: _e.mock.On("BuildRatings", ctx, item, libraryName)}
 }
 
-func (_c *RatingBuilder_BuildRatings_Call) Run(run func(ctx context.Context, item *model.Item)) *RatingBuilder_BuildRatings_Call {
+func (_c *RatingBuilder_BuildRatings_Call) Run(run func(ctx context.Context, item *model.Item, libraryName string)) *RatingBuilder_BuildRatings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Item))
+		run(args[0].(context.Context), args[1].(*model.Item), args[2].(string))
 	})
 	return _c
 }
@@ -65,7 +66,7 @@ func (_c *RatingBuilder_BuildRatings_Call) Return(_a0 error) *RatingBuilder_Buil
 	return _c
 }
 
-func (_c *RatingBuilder_BuildRatings_Call) RunAndReturn(run func(context.Context, *model.Item) error) *RatingBuilder_BuildRatings_Call {
+func (_c *RatingBuilder_BuildRatings_Call) RunAndReturn(run func(context.Context, *model.Item, string) error) *RatingBuilder_BuildRatings_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,65 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package core_mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// StateCleaner is an autogenerated mock type for the StateCleaner type
+type StateCleaner struct {
+	mock.Mock
+}
+
+type StateCleaner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StateCleaner) EXPECT() *StateCleaner_Expecter {
+	return &StateCleaner_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: key
+func (_m *StateCleaner) Delete(key string) {
+	_m.Called(key)
+}
+
+// StateCleaner_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type StateCleaner_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - key string
+func (_e *StateCleaner_Expecter) Delete(key interface{}) *StateCleaner_Delete_Call {
+	return &StateCleaner_Delete_Call{Call: _e.mock.On("Delete", key)}
+}
+
+func (_c *StateCleaner_Delete_Call) Run(run func(key string)) *StateCleaner_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *StateCleaner_Delete_Call) Return() *StateCleaner_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *StateCleaner_Delete_Call) RunAndReturn(run func(string)) *StateCleaner_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewStateCleaner creates a new instance of StateCleaner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStateCleaner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StateCleaner {
+	mock := &StateCleaner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
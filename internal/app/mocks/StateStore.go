@@ -0,0 +1,125 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package core_mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// StateStore is an autogenerated mock type for the StateStore type
+type StateStore struct {
+	mock.Mock
+}
+
+type StateStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StateStore) EXPECT() *StateStore_Expecter {
+	return &StateStore_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: key
+func (_m *StateStore) Get(key string) (model.ItemState, bool) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.ItemState
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (model.ItemState, bool)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.ItemState); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(model.ItemState)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// StateStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type StateStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - key string
+func (_e *StateStore_Expecter) Get(key interface{}) *StateStore_Get_Call {
+	return &StateStore_Get_Call{Call: _e.mock.On("Get", key)}
+}
+
+func (_c *StateStore_Get_Call) Run(run func(key string)) *StateStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *StateStore_Get_Call) Return(_a0 model.ItemState, _a1 bool) *StateStore_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateStore_Get_Call) RunAndReturn(run func(string) (model.ItemState, bool)) *StateStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: key, itemState
+func (_m *StateStore) Set(key string, itemState model.ItemState) {
+	_m.Called(key, itemState)
+}
+
+// StateStore_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type StateStore_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - key string
+//   - itemState model.ItemState
+func (_e *StateStore_Expecter) Set(key interface{}, itemState interface{}) *StateStore_Set_Call {
+	return &StateStore_Set_Call{Call: _e.mock.On("Set", key, itemState)}
+}
+
+func (_c *StateStore_Set_Call) Run(run func(key string, itemState model.ItemState)) *StateStore_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(model.ItemState))
+	})
+	return _c
+}
+
+func (_c *StateStore_Set_Call) Return() *StateStore_Set_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *StateStore_Set_Call) RunAndReturn(run func(string, model.ItemState)) *StateStore_Set_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewStateStore creates a new instance of StateStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStateStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StateStore {
+	mock := &StateStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package core_mocks
+
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// WebhookParser is an autogenerated mock type for the WebhookParser type
+type WebhookParser struct {
+	mock.Mock
+}
+
+type WebhookParser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookParser) EXPECT() *WebhookParser_Expecter {
+	return &WebhookParser_Expecter{mock: &_m.Mock}
+}
+
+// Parse provides a mock function with given fields: r
+func (_m *WebhookParser) Parse(r *http.Request) (model.WebhookEvent, bool, error) {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Parse")
+	}
+
+	var r0 model.WebhookEvent
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*http.Request) (model.WebhookEvent, bool, error)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) model.WebhookEvent); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(model.WebhookEvent)
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) bool); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(*http.Request) error); ok {
+		r2 = rf(r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// WebhookParser_Parse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Parse'
+type WebhookParser_Parse_Call struct {
+	*mock.Call
+}
+
+// Parse is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *WebhookParser_Expecter) Parse(r interface{}) *WebhookParser_Parse_Call {
+	return &WebhookParser_Parse_Call{Call: _e.mock.On("Parse", r)}
+}
+
+func (_c *WebhookParser_Parse_Call) Run(run func(r *http.Request)) *WebhookParser_Parse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *WebhookParser_Parse_Call) Return(event model.WebhookEvent, handled bool, err error) *WebhookParser_Parse_Call {
+	_c.Call.Return(event, handled, err)
+	return _c
+}
+
+func (_c *WebhookParser_Parse_Call) RunAndReturn(run func(*http.Request) (model.WebhookEvent, bool, error)) *WebhookParser_Parse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookParser creates a new instance of WebhookParser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookParser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookParser {
+	mock := &WebhookParser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
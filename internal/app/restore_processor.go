@@ -0,0 +1,163 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/processor/state"
+)
+
+// PosterFileRestorer undoes the poster changes made in a library folder
+type PosterFileRestorer interface {
+	RestorePosters(dir string, dryRun bool) ([]model.RestoreAction, error)
+}
+
+// StateCleaner forgets the state of the processed items
+type StateCleaner interface {
+	Delete(key string)
+}
+
+// RestoreProcessor puts back the posters the library items had before being processed
+type RestoreProcessor struct {
+	logger       *zap.Logger
+	fileRestorer PosterFileRestorer
+	stateCleaner StateCleaner
+	dryRun       bool
+}
+
+// NewRestoreProcessor creates a new restore processor. On a dry run the changes are
+// only listed.
+func NewRestoreProcessor(logger *zap.Logger, fileRestorer PosterFileRestorer, dryRun bool) *RestoreProcessor {
+	return &RestoreProcessor{
+		logger:       logger,
+		fileRestorer: fileRestorer,
+		dryRun:       dryRun,
+	}
+}
+
+// SetStateCleaner makes the restored items be processed again on the next run
+func (rp *RestoreProcessor) SetStateCleaner(stateCleaner StateCleaner) {
+	rp.stateCleaner = stateCleaner
+}
+
+// RestoreLibrary restores the posters of a single library
+func (rp *RestoreProcessor) RestoreLibrary(
+	ctx context.Context,
+	configLibrary *config.Library,
+	mediaServiceLibraries *[]model.Library,
+	serviceCtx ServiceContext,
+) error {
+	if !configLibrary.Enabled {
+		rp.logger.Info("Library not active, skipped", zap.String("library", configLibrary.Name))
+		return nil
+	}
+
+	library, found := lo.Find(*mediaServiceLibraries, func(lib model.Library) bool {
+		return lib.Name == configLibrary.Name
+	})
+	if !found {
+		return fmt.Errorf("library '%s' not found or not active", configLibrary.Name)
+	}
+
+	rp.logger.Info("Restoring library posters",
+		zap.String("library", library.Name),
+		zap.Bool("dryRun", rp.dryRun),
+	)
+
+	if err := rp.restoreItems(ctx, library, configLibrary, serviceCtx); err != nil {
+		return err
+	}
+
+	if configLibrary.Path != "" {
+		actions, err := rp.fileRestorer.RestorePosters(configLibrary.Path, rp.dryRun)
+		if err != nil {
+			return fmt.Errorf("unable to restore poster files: %w", err)
+		}
+		for _, action := range actions {
+			rp.logAction(action)
+		}
+	}
+
+	if rp.dryRun {
+		return nil
+	}
+
+	// Check for context cancellation before refreshing
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context cancelled before refreshing library: %w", err)
+	}
+
+	if configLibrary.Refresh {
+		rp.logger.Info("Refreshing library...", zap.String("library", library.Name))
+		if err := serviceCtx.LibrariesService.RefreshLibrary(ctx, library.ID, true); err != nil {
+			return fmt.Errorf("unable to refresh library: %w", err)
+		}
+		rp.logger.Info("Library refreshed successfully", zap.String("library", library.Name))
+	}
+
+	return nil
+}
+
+// restoreItems publishes the original posters again when the media service changed them
+// through its API, and forgets the state of the items. The items are only retrieved when
+// one of the two is needed.
+func (rp *RestoreProcessor) restoreItems(
+	ctx context.Context,
+	library model.Library,
+	configLibrary *config.Library,
+	serviceCtx ServiceContext,
+) error {
+	posterRestorer, canRestore := serviceCtx.PostersService.(media.PosterRestorer)
+	if !canRestore && rp.stateCleaner == nil {
+		return nil
+	}
+
+	items, err := serviceCtx.ItemsService.GetItems(ctx, library, configLibrary)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve items: %w", err)
+	}
+
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("context cancelled while restoring items: %w", err)
+		}
+
+		if canRestore {
+			restored, err := posterRestorer.RestorePoster(ctx, item, configLibrary, rp.dryRun)
+			if err != nil {
+				rp.logger.Error("unable to restore poster",
+					zap.String("Item ID", item.ID),
+					zap.String("Item Title", item.Title),
+					zap.Error(err),
+				)
+			} else if restored {
+				rp.logAction(model.RestoreAction{Type: model.RestoreActionPublish, Path: item.Title})
+			}
+		}
+
+		if rp.stateCleaner != nil && !rp.dryRun {
+			rp.stateCleaner.Delete(state.Key(serviceCtx.MediaServiceName, item.ID))
+		}
+	}
+
+	return nil
+}
+
+func (rp *RestoreProcessor) logAction(action model.RestoreAction) {
+	message := "Poster restored"
+	if rp.dryRun {
+		message = "Poster would be restored"
+	}
+
+	rp.logger.Info(message,
+		zap.String("action", action.Type),
+		zap.String("path", action.Path),
+		zap.String("target", action.Target),
+	)
+}
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	coremocks "github.com/zepollabot/media-rating-overlay/internal/app/mocks"
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	mediamocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// restorablePostersService combines both poster service mocks, like the MediaBrowser poster service of Emby and Jellyfin
+type restorablePostersService struct {
+	*mediamocks.PosterService
+	*mediamocks.PosterRestorer
+}
+
+type RestoreProcessorTestSuite struct {
+	suite.Suite
+	logger               *zap.Logger
+	mockFileRestorer     *coremocks.PosterFileRestorer
+	mockStateCleaner     *coremocks.StateCleaner
+	mockLibrariesService *mediamocks.LibraryService
+	mockItemsService     *mediamocks.ItemService
+	mockPostersService   *mediamocks.PosterService
+	mockPosterRestorer   *mediamocks.PosterRestorer
+	configLibrary        *config.Library
+	libraries            []model.Library
+	ctx                  context.Context
+}
+
+func (s *RestoreProcessorTestSuite) SetupTest() {
+	s.logger = zap.NewNop()
+	s.mockFileRestorer = coremocks.NewPosterFileRestorer(s.T())
+	s.mockStateCleaner = coremocks.NewStateCleaner(s.T())
+	s.mockLibrariesService = mediamocks.NewLibraryService(s.T())
+	s.mockItemsService = mediamocks.NewItemService(s.T())
+	s.mockPostersService = mediamocks.NewPosterService(s.T())
+	s.mockPosterRestorer = mediamocks.NewPosterRestorer(s.T())
+	s.configLibrary = &config.Library{Name: "Movies", Enabled: true, Path: "/mnt/movies", Refresh: true}
+	s.libraries = []model.Library{{ID: "1", Name: "Movies"}}
+	s.ctx = context.Background()
+}
+
+func (s *RestoreProcessorTestSuite) TearDownTest() {
+	s.mockFileRestorer.AssertExpectations(s.T())
+	s.mockStateCleaner.AssertExpectations(s.T())
+	s.mockLibrariesService.AssertExpectations(s.T())
+	s.mockItemsService.AssertExpectations(s.T())
+	s.mockPostersService.AssertExpectations(s.T())
+	s.mockPosterRestorer.AssertExpectations(s.T())
+}
+
+func TestRestoreProcessorTestSuite(t *testing.T) {
+	suite.Run(t, new(RestoreProcessorTestSuite))
+}
+
+func (s *RestoreProcessorTestSuite) serviceContext(postersService any) ServiceContext {
+	serviceCtx := ServiceContext{
+		MediaServiceName: "plex",
+		LibrariesService: s.mockLibrariesService,
+		ItemsService:     s.mockItemsService,
+		PostersService:   s.mockPostersService,
+	}
+	if restorable, ok := postersService.(restorablePostersService); ok {
+		serviceCtx.PostersService = restorable
+	}
+	return serviceCtx
+}
+
+func (s *RestoreProcessorTestSuite) TestRestoreLibrary_FilesOnly() {
+	// Arrange
+	processor := NewRestoreProcessor(s.logger, s.mockFileRestorer, false)
+	actions := []model.RestoreAction{{Type: model.RestoreActionDelete, Path: "/mnt/movies/Movie-poster.png"}}
+	s.mockFileRestorer.On("RestorePosters", "/mnt/movies", false).Return(actions, nil).Once()
+	s.mockLibrariesService.On("RefreshLibrary", mock.Anything, "1", true).Return(nil).Once()
+
+	// Act
+	err := processor.RestoreLibrary(s.ctx, s.configLibrary, &s.libraries, s.serviceContext(nil))
+
+	// Assert
+	s.NoError(err)
+	s.mockItemsService.AssertNotCalled(s.T(), "GetItems", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *RestoreProcessorTestSuite) TestRestoreLibrary_RestoresItemsAndCleansState() {
+	// Arrange
+	processor := NewRestoreProcessor(s.logger, s.mockFileRestorer, false)
+	processor.SetStateCleaner(s.mockStateCleaner)
+	items := []model.Item{{ID: "10", Title: "Movie 1"}, {ID: "11", Title: "Movie 2"}}
+
+	s.mockItemsService.On("GetItems", mock.Anything, s.libraries[0], s.configLibrary).Return(items, nil).Once()
+	s.mockPosterRestorer.On("RestorePoster", mock.Anything, items[0], s.configLibrary, false).Return(true, nil).Once()
+	s.mockPosterRestorer.On("RestorePoster", mock.Anything, items[1], s.configLibrary, false).Return(false, errors.New("upload error")).Once()
+	s.mockStateCleaner.On("Delete", "plex/10").Return().Once()
+	s.mockStateCleaner.On("Delete", "plex/11").Return().Once()
+	s.mockFileRestorer.On("RestorePosters", "/mnt/movies", false).Return(nil, nil).Once()
+	s.mockLibrariesService.On("RefreshLibrary", mock.Anything, "1", true).Return(nil).Once()
+
+	// Act
+	err := processor.RestoreLibrary(s.ctx, s.configLibrary, &s.libraries, s.serviceContext(restorablePostersService{s.mockPostersService, s.mockPosterRestorer}))
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *RestoreProcessorTestSuite) TestRestoreLibrary_DryRun() {
+	// Arrange
+	processor := NewRestoreProcessor(s.logger, s.mockFileRestorer, true)
+	processor.SetStateCleaner(s.mockStateCleaner)
+	items := []model.Item{{ID: "10", Title: "Movie 1"}}
+
+	s.mockItemsService.On("GetItems", mock.Anything, s.libraries[0], s.configLibrary).Return(items, nil).Once()
+	s.mockPosterRestorer.On("RestorePoster", mock.Anything, items[0], s.configLibrary, true).Return(true, nil).Once()
+	s.mockFileRestorer.On("RestorePosters", "/mnt/movies", true).Return(nil, nil).Once()
+
+	// Act
+	err := processor.RestoreLibrary(s.ctx, s.configLibrary, &s.libraries, s.serviceContext(restorablePostersService{s.mockPostersService, s.mockPosterRestorer}))
+
+	// Assert
+	s.NoError(err)
+	s.mockStateCleaner.AssertNotCalled(s.T(), "Delete", mock.Anything)
+	s.mockLibrariesService.AssertNotCalled(s.T(), "RefreshLibrary", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *RestoreProcessorTestSuite) TestRestoreLibrary_Disabled() {
+	// Arrange
+	processor := NewRestoreProcessor(s.logger, s.mockFileRestorer, false)
+	s.configLibrary.Enabled = false
+
+	// Act
+	err := processor.RestoreLibrary(s.ctx, s.configLibrary, &s.libraries, s.serviceContext(nil))
+
+	// Assert
+	s.NoError(err)
+	s.mockFileRestorer.AssertNotCalled(s.T(), "RestorePosters", mock.Anything, mock.Anything)
+}
+
+func (s *RestoreProcessorTestSuite) TestRestoreLibrary_LibraryNotFound() {
+	// Arrange
+	processor := NewRestoreProcessor(s.logger, s.mockFileRestorer, false)
+	s.configLibrary.Name = "Shows"
+
+	// Act
+	err := processor.RestoreLibrary(s.ctx, s.configLibrary, &s.libraries, s.serviceContext(nil))
+
+	// Assert
+	s.EqualError(err, "library 'Shows' not found or not active")
+}
+
+func (s *RestoreProcessorTestSuite) TestRestoreLibrary_FileRestoreError() {
+	// Arrange
+	processor := NewRestoreProcessor(s.logger, s.mockFileRestorer, false)
+	s.mockFileRestorer.On("RestorePosters", "/mnt/movies", false).Return(nil, errors.New("permission denied")).Once()
+
+	// Act
+	err := processor.RestoreLibrary(s.ctx, s.configLibrary, &s.libraries, s.serviceContext(nil))
+
+	// Assert
+	s.EqualError(err, "unable to restore poster files: permission denied")
+}
+
+func (s *RestoreProcessorTestSuite) TestRestoreLibrary_GetItemsError() {
+	// Arrange
+	processor := NewRestoreProcessor(s.logger, s.mockFileRestorer, false)
+	processor.SetStateCleaner(s.mockStateCleaner)
+	s.mockItemsService.On("GetItems", mock.Anything, s.libraries[0], s.configLibrary).Return(nil, errors.New("timeout")).Once()
+
+	// Act
+	err := processor.RestoreLibrary(s.ctx, s.configLibrary, &s.libraries, s.serviceContext(nil))
+
+	// Assert
+	s.EqualError(err, "unable to retrieve items: timeout")
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+
+	"github.com/fogleman/gg"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const (
+	samplePosterWidth  = 1000
+	samplePosterHeight = 1500
+)
+
+// sampleItem has a rating for every rating service, so that the whole overlay is rendered
+var sampleItem = model.Item{
+	ID:    "sample",
+	Title: "Sample",
+	Type:  constant.MediaTypeMovie,
+	Year:  2025,
+	Ratings: []model.Rating{
+		{Name: constant.RatingServiceTMDB, Rating: 7.8, Type: model.RatingServiceTypeAudience},
+		{Name: constant.RatingServiceIMDB, Rating: 8.1, Type: model.RatingServiceTypeAudience},
+		{Name: constant.RatingServiceRottenTomatoes, Rating: 9.2, Type: model.RatingServiceTypeCritic, Certified: true},
+		{Name: constant.RatingServiceRottenTomatoes, Rating: 8.7, Type: model.RatingServiceTypeAudience},
+		{Name: constant.RatingServiceMetacritic, Rating: 7.4, Type: model.RatingServiceTypeCritic},
+		{Name: constant.RatingServiceTrakt, Rating: 8.2, Type: model.RatingServiceTypeAudience},
+		{Name: constant.RatingServiceLetterboxd, Rating: 4.1, Type: model.RatingServiceTypeAudience, Scale: 5},
+		{Name: constant.RatingServiceAniList, Rating: 84, Type: model.RatingServiceTypeAudience, Scale: 100},
+		{Name: constant.RatingServiceMyAnimeList, Rating: 8.16, Type: model.RatingServiceTypeAudience},
+	},
+}
+
+// RenderSample renders the overlay of the selected library, or of the first enabled one,
+// on a poster with sample ratings, returning where the rendered poster is written.
+// A blank poster is used when sourcePoster is empty
+func (a *App) RenderSample(sourcePoster string) (string, error) {
+	if a.previewFileManager == nil {
+		return "", fmt.Errorf("samples are only rendered on a dry run")
+	}
+
+	configLibrary, err := a.sampleLibrary()
+	if err != nil {
+		return "", err
+	}
+
+	if sourcePoster == "" {
+		sourcePoster = filepath.Join(a.options.PreviewDir, "sample", "sample-original.png")
+		if err := writeBlankPoster(sourcePoster); err != nil {
+			return "", fmt.Errorf("error creating sample poster: %w", err)
+		}
+	}
+
+	a.Logger.Info("Rendering sample poster",
+		zap.String("library", configLibrary.Name),
+		zap.String("sourcePoster", sourcePoster),
+	)
+
+	return a.posterGenerator.ApplyLogos(a.ctx, sourcePoster, configLibrary, sampleItem)
+}
+
+// sampleLibrary returns the library the sample overlay is taken from
+func (a *App) sampleLibrary() (*config.Library, error) {
+	for _, mediaService := range a.mediaServices {
+		for _, configLibrary := range mediaService.Libraries {
+			if a.options.Library != "" && configLibrary.Name == a.options.Library {
+				return &configLibrary, nil
+			}
+			if a.options.Library == "" && configLibrary.Enabled {
+				return &configLibrary, nil
+			}
+		}
+	}
+
+	if a.options.Library != "" {
+		return nil, fmt.Errorf("library '%s' not configured", a.options.Library)
+	}
+
+	return nil, fmt.Errorf("no enabled library configured")
+}
+
+func writeBlankPoster(filePath string) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0775); err != nil {
+		return err
+	}
+
+	dc := gg.NewContext(samplePosterWidth, samplePosterHeight)
+	gradient := gg.NewLinearGradient(0, 0, 0, samplePosterHeight)
+	gradient.AddColorStop(0, color.RGBA{R: 58, G: 80, B: 107, A: 0xFF})
+	gradient.AddColorStop(1, color.RGBA{R: 11, G: 19, B: 43, A: 0xFF})
+	dc.SetFillStyle(gradient)
+	dc.DrawRectangle(0, 0, samplePosterWidth, samplePosterHeight)
+	dc.Fill()
+
+	return dc.SavePNG(filePath)
+}
@@ -0,0 +1,205 @@
+package core
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const (
+	// webhookQueueSize is how many events can wait for processing before new ones are rejected
+	webhookQueueSize = 100
+	// webhookShutdownTimeout is how long the pending webhook requests have to complete on shutdown
+	webhookShutdownTimeout = 5 * time.Second
+)
+
+// WebhookParser reads the event a media server webhook request notifies about.
+// handled is false for the events that do not require processing
+type WebhookParser interface {
+	Parse(r *http.Request) (event model.WebhookEvent, handled bool, err error)
+}
+
+// WebhookHandler receives the webhooks of a media service and queues the events to process,
+// so that the media server is answered without waiting for the processing
+type WebhookHandler struct {
+	logger           *zap.Logger
+	mediaServiceName string
+	parser           WebhookParser
+	token            string
+	events           chan model.WebhookEvent
+}
+
+// NewWebhookHandler creates a new webhook handler. When token is set, the requests must
+// pass it as the token query parameter
+func NewWebhookHandler(logger *zap.Logger, mediaServiceName string, parser WebhookParser, token string) *WebhookHandler {
+	return &WebhookHandler{
+		logger:           logger,
+		mediaServiceName: mediaServiceName,
+		parser:           parser,
+		token:            token,
+		events:           make(chan model.WebhookEvent, webhookQueueSize),
+	}
+}
+
+// Path returns the path the handler is served on
+func (h *WebhookHandler) Path() string {
+	return "/webhooks/" + h.mediaServiceName
+}
+
+// Events returns the queued events
+func (h *WebhookHandler) Events() <-chan model.WebhookEvent {
+	return h.events
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.token != "" && subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(h.token)) != 1 {
+		h.logger.Warn("Webhook rejected, invalid token", zap.String("remoteAddr", r.RemoteAddr))
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	event, handled, err := h.parser.Parse(r)
+	if err != nil {
+		h.logger.Warn("Webhook rejected, invalid payload", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !handled {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	select {
+	case h.events <- event:
+		h.logger.Info("Webhook event queued",
+			zap.String("event", event.Event),
+			zap.String("library", event.LibraryName),
+			zap.String("itemID", event.ItemID),
+			zap.String("title", event.Title),
+		)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		h.logger.Warn("Webhook event dropped, too many events pending",
+			zap.String("itemID", event.ItemID),
+			zap.String("title", event.Title),
+		)
+		http.Error(w, "too many events pending", http.StatusServiceUnavailable)
+	}
+}
+
+// startWebhookServer serves the webhook handler and processes its events until the
+// returned function is called
+func (a *App) startWebhookServer() func() {
+	mux := http.NewServeMux()
+	mux.Handle(a.webhookHandler.Path(), a.webhookHandler)
+
+	server := &http.Server{
+		Addr:              a.config.Webhook.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		a.Logger.Info("Webhook listener started",
+			zap.String("address", a.config.Webhook.Address),
+			zap.String("path", a.webhookHandler.Path()),
+		)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.Logger.Error("Webhook listener stopped", zap.Error(err))
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(a.ctx)
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		a.processWebhookEvents(ctx)
+	}()
+
+	return func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), webhookShutdownTimeout)
+		defer shutdownCancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			a.Logger.Warn("error stopping webhook listener", zap.Error(err))
+		}
+
+		cancel()
+		<-workerDone
+	}
+}
+
+// processWebhookEvents processes the queued webhook events one after the other until ctx is done
+func (a *App) processWebhookEvents(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-a.webhookHandler.Events():
+			if err := a.processWebhookEvent(ctx, event); err != nil {
+				a.Logger.Error("Error processing webhook event",
+					zap.String("itemID", event.ItemID),
+					zap.String("title", event.Title),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// processWebhookEvent processes the item of the event, provided its library is enabled.
+// It waits for the scheduled run in progress, if any, to complete
+func (a *App) processWebhookEvent(ctx context.Context, event model.WebhookEvent) error {
+	mediaService, found := lo.Find(a.mediaServices, func(mediaService mediaModel.MediaService) bool {
+		return mediaService.Name == a.webhookHandler.mediaServiceName
+	})
+	if !found {
+		return fmt.Errorf("media service %s not configured", a.webhookHandler.mediaServiceName)
+	}
+
+	configLibrary, found := lo.Find(mediaService.Libraries, func(library config.Library) bool {
+		return library.Name == event.LibraryName
+	})
+	if !found || !configLibrary.Enabled || (a.options.Library != "" && configLibrary.Name != a.options.Library) {
+		a.Logger.Info("Webhook event skipped, library not enabled",
+			zap.String("library", event.LibraryName),
+			zap.String("title", event.Title),
+		)
+		return nil
+	}
+
+	a.runLock.Lock()
+	defer a.runLock.Unlock()
+
+	a.Logger.Info("Processing webhook event",
+		zap.String("library", configLibrary.Name),
+		zap.String("itemID", event.ItemID),
+		zap.String("title", event.Title),
+	)
+
+	err := a.libraryProcessor.ProcessLibraryItem(ctx, &configLibrary, event.ItemID, ServiceContext{
+		MediaServiceName: mediaService.Name,
+		LibrariesService: mediaService.LibraryService,
+		ItemsService:     mediaService.ItemService,
+		PostersService:   mediaService.PosterService,
+	})
+	a.saveState()
+
+	return err
+}
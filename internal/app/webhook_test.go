@@ -0,0 +1,246 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	appmocks "github.com/zepollabot/media-rating-overlay/internal/app/mocks"
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	mediamocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type WebhookTestSuite struct {
+	suite.Suite
+	ctx                    context.Context
+	mockParser             *appmocks.WebhookParser
+	mockEligibilityChecker *appmocks.ItemEligibilityChecker
+	mockSingleItemService  *mediamocks.SingleItemService
+	mockItemRefresher      *mediamocks.ItemRefresher
+	handler                *WebhookHandler
+	app                    *App
+}
+
+func (s *WebhookTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	logger := zap.NewNop()
+	s.mockParser = appmocks.NewWebhookParser(s.T())
+	s.mockEligibilityChecker = appmocks.NewItemEligibilityChecker(s.T())
+	s.mockSingleItemService = mediamocks.NewSingleItemService(s.T())
+	s.mockItemRefresher = mediamocks.NewItemRefresher(s.T())
+	s.handler = NewWebhookHandler(logger, mediaModel.MediaServicePlex, s.mockParser, "secret")
+
+	itemProcessor := NewItemProcessor(
+		logger,
+		s.ctx,
+		appmocks.NewSemaphoreWeighted(s.T()),
+		appmocks.NewPosterGenerator(s.T()),
+		s.mockEligibilityChecker,
+		appmocks.NewRatingBuilder(s.T()),
+	)
+
+	s.app = &App{
+		config:           config.DefaultConfig(),
+		Logger:           logger,
+		ctx:              s.ctx,
+		libraryProcessor: NewLibrariesProcessor(logger, *itemProcessor, DefaultLibraryProcessorConfig()),
+		webhookHandler:   s.handler,
+		mediaServices: []mediaModel.MediaService{
+			{
+				Name:           mediaModel.MediaServicePlex,
+				LibraryService: itemRefresherLibraryService{mediamocks.NewLibraryService(s.T()), s.mockItemRefresher},
+				ItemService:    singleItemService{mediamocks.NewItemService(s.T()), s.mockSingleItemService},
+				PosterService:  mediamocks.NewPosterService(s.T()),
+				Libraries: []config.Library{
+					{Name: "Film", Enabled: true},
+					{Name: "Music", Enabled: false},
+				},
+			},
+		},
+	}
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
+
+func (s *WebhookTestSuite) TestServeHTTP() {
+	newMovie := model.WebhookEvent{Event: "library.new", LibraryName: "Film", ItemID: "1234", Title: "The Matrix"}
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		setupParser    func(req *http.Request)
+		expectedStatus int
+		expectedQueued bool
+	}{
+		{
+			name:   "new item queued",
+			method: http.MethodPost,
+			target: "/webhooks/plex?token=secret",
+			setupParser: func(req *http.Request) {
+				s.mockParser.On("Parse", req).Return(newMovie, true, nil).Once()
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedQueued: true,
+		},
+		{
+			name:   "other event ignored",
+			method: http.MethodPost,
+			target: "/webhooks/plex?token=secret",
+			setupParser: func(req *http.Request) {
+				s.mockParser.On("Parse", req).Return(model.WebhookEvent{}, false, nil).Once()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "invalid payload",
+			method: http.MethodPost,
+			target: "/webhooks/plex?token=secret",
+			setupParser: func(req *http.Request) {
+				s.mockParser.On("Parse", req).Return(model.WebhookEvent{}, false, errors.New("invalid payload")).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid token",
+			method:         http.MethodPost,
+			target:         "/webhooks/plex?token=wrong",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing token",
+			method:         http.MethodPost,
+			target:         "/webhooks/plex",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "not a POST",
+			method:         http.MethodGet,
+			target:         "/webhooks/plex?token=secret",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			req := httptest.NewRequest(tc.method, tc.target, nil)
+			if tc.setupParser != nil {
+				tc.setupParser(req)
+			}
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.ServeHTTP(recorder, req)
+
+			// Assert
+			s.Equal(tc.expectedStatus, recorder.Code)
+			if tc.expectedQueued {
+				s.Require().Len(s.handler.Events(), 1)
+				s.Equal(newMovie, <-s.handler.Events())
+			} else {
+				s.Empty(s.handler.Events())
+			}
+		})
+	}
+}
+
+func (s *WebhookTestSuite) TestServeHTTP_QueueFull() {
+	// Arrange
+	event := model.WebhookEvent{Event: "library.new", LibraryName: "Film", ItemID: "1234"}
+	s.mockParser.On("Parse", mock.Anything).Return(event, true, nil)
+	for range webhookQueueSize {
+		s.handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/webhooks/plex?token=secret", nil))
+	}
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhooks/plex?token=secret", nil))
+
+	// Assert
+	s.Equal(http.StatusServiceUnavailable, recorder.Code)
+	s.Len(s.handler.Events(), webhookQueueSize)
+}
+
+func (s *WebhookTestSuite) TestServeHTTP_NoToken() {
+	// Arrange
+	handler := NewWebhookHandler(zap.NewNop(), mediaModel.MediaServicePlex, s.mockParser, "")
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/plex", nil)
+	s.mockParser.On("Parse", req).Return(model.WebhookEvent{}, false, nil).Once()
+	recorder := httptest.NewRecorder()
+
+	// Act
+	handler.ServeHTTP(recorder, req)
+
+	// Assert
+	s.Equal(http.StatusNoContent, recorder.Code)
+}
+
+func (s *WebhookTestSuite) TestPath() {
+	s.Equal("/webhooks/plex", s.handler.Path())
+}
+
+func (s *WebhookTestSuite) TestProcessWebhookEvent() {
+	// Arrange: the item is not eligible, so it is only refreshed
+	event := model.WebhookEvent{Event: "library.new", LibraryName: "Film", ItemID: "1234", Title: "The Matrix"}
+	s.mockSingleItemService.On("GetItem", mock.Anything, "1234", mock.MatchedBy(func(configLibrary *config.Library) bool {
+		return configLibrary.Name == "Film"
+	})).Return([]model.Item{{ID: "1234", Title: "The Matrix"}}, nil).Once()
+	s.mockEligibilityChecker.On("IsEligible", mock.AnythingOfType("*model.Item")).Return(false).Once()
+	s.mockItemRefresher.On("RefreshItem", mock.Anything, "1234").Return(nil).Once()
+
+	// Act
+	err := s.app.processWebhookEvent(s.ctx, event)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *WebhookTestSuite) TestProcessWebhookEvent_Error() {
+	// Arrange
+	event := model.WebhookEvent{Event: "library.new", LibraryName: "Film", ItemID: "1234"}
+	s.mockSingleItemService.On("GetItem", mock.Anything, "1234", mock.Anything).Return(nil, errors.New("item 1234 not found")).Once()
+
+	// Act
+	err := s.app.processWebhookEvent(s.ctx, event)
+
+	// Assert
+	s.ErrorContains(err, "item 1234 not found")
+}
+
+func (s *WebhookTestSuite) TestProcessWebhookEvent_SkippedLibraries() {
+	testCases := []struct {
+		name            string
+		libraryName     string
+		selectedLibrary string
+	}{
+		{name: "library not configured", libraryName: "Series"},
+		{name: "library disabled", libraryName: "Music"},
+		{name: "library not selected", libraryName: "Film", selectedLibrary: "Other"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.app.options.Library = tc.selectedLibrary
+			event := model.WebhookEvent{Event: "library.new", LibraryName: tc.libraryName, ItemID: "1234"}
+
+			// Act
+			err := s.app.processWebhookEvent(s.ctx, event)
+
+			// Assert
+			s.NoError(err)
+			s.mockSingleItemService.AssertNotCalled(s.T(), "GetItem", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
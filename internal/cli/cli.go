@@ -0,0 +1,316 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	core "github.com/zepollabot/media-rating-overlay/internal/app"
+	configService "github.com/zepollabot/media-rating-overlay/internal/config"
+	service "github.com/zepollabot/media-rating-overlay/internal/config/service"
+	env "github.com/zepollabot/media-rating-overlay/internal/environment"
+	imdbDataset "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/dataset"
+)
+
+const (
+	programName       = "media-rating-overlay"
+	defaultCommand    = "run"
+	defaultPreviewDir = "previews"
+
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// command is a subcommand of the command line
+type command struct {
+	name        string
+	arguments   string
+	description string
+	// nArgs is the number of positional arguments the command takes
+	nArgs int
+	// setup registers the flags of the command and adjusts the options it runs with
+	setup   func(fs *flag.FlagSet, inv *invocation)
+	execute func(inv *invocation, stdout io.Writer) error
+}
+
+// invocation is a parsed command line
+type invocation struct {
+	command *command
+	options core.Options
+	args    []string
+	// poster is the source poster of render-sample
+	poster string
+}
+
+var commands = []*command{
+	{
+		name:        "run",
+		description: "process the configured libraries (default command)",
+		setup: func(fs *flag.FlagSet, inv *invocation) {
+			fs.BoolVar(&inv.options.Force, "force", false, "process every item, even the ones unchanged since the last run")
+			fs.BoolVar(&inv.options.DryRun, "dry-run", false, "render the posters into the preview dir without touching the libraries")
+			fs.StringVar(&inv.options.PreviewDir, "preview-dir", defaultPreviewDir, "where the posters are rendered on a dry run")
+		},
+		execute: runApp,
+	},
+	{
+		name:        "daemon",
+		description: "keep running, processing the libraries on their schedule",
+		setup: func(fs *flag.FlagSet, inv *invocation) {
+			inv.options.Daemon = true
+			fs.BoolVar(&inv.options.Force, "force", false, "process every item, even the ones unchanged since the last run")
+		},
+		execute: runApp,
+	},
+	{
+		name:        "restore",
+		description: "put back the original posters",
+		setup: func(fs *flag.FlagSet, inv *invocation) {
+			inv.options.Restore = true
+			fs.BoolVar(&inv.options.DryRun, "dry-run", false, "list the changes without applying them")
+		},
+		execute: runApp,
+	},
+	{
+		name:        "preview",
+		arguments:   "<item>",
+		description: "render the posters of the items with this title or ID into the preview dir",
+		nArgs:       1,
+		setup: func(fs *flag.FlagSet, inv *invocation) {
+			inv.options.DryRun = true
+			fs.StringVar(&inv.options.PreviewDir, "preview-dir", defaultPreviewDir, "where the posters are rendered")
+		},
+		execute: func(inv *invocation, stdout io.Writer) error {
+			inv.options.Item = inv.args[0]
+			return runApp(inv, stdout)
+		},
+	},
+	{
+		name:        "list-libraries",
+		description: "list the libraries of the media services and whether they are configured",
+		execute:     listLibraries,
+	},
+	{
+		name:        "validate-config",
+		description: "check the configuration without running",
+		execute:     validateConfig,
+	},
+	{
+		name:        "render-sample",
+		description: "render the overlay on a poster with sample ratings into the preview dir",
+		setup: func(fs *flag.FlagSet, inv *invocation) {
+			inv.options.DryRun = true
+			fs.StringVar(&inv.options.PreviewDir, "preview-dir", defaultPreviewDir, "where the sample is rendered")
+			fs.StringVar(&inv.poster, "poster", "", "source poster, a blank poster when empty")
+		},
+		execute: renderSample,
+	},
+	{
+		name:        "refresh-imdb",
+		arguments:   "<dir>",
+		description: "import the IMDb ratings and basics datasets of the dir into the IMDb dataset file",
+		nArgs:       1,
+		execute:     refreshIMDb,
+	},
+}
+
+// Run parses the command line arguments, without the program name, and executes
+// the selected command, returning the exit code
+func Run(args []string, stdout, stderr io.Writer) int {
+	inv, err := parse(args, stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		// The error and the usage are already reported by parse
+		return exitUsage
+	}
+
+	if err := inv.command.execute(inv, stdout); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+// parse reads the global flags, then the command with its own flags. The global flags
+// are accepted after the command as well. The run command is used when none is given
+func parse(args []string, stderr io.Writer) (*invocation, error) {
+	inv := &invocation{
+		options: core.Options{
+			ConfigDir: service.DefaultEnvConfigDir,
+		},
+	}
+
+	globalFlags := flag.NewFlagSet(programName, flag.ContinueOnError)
+	globalFlags.SetOutput(stderr)
+	globalFlags.Usage = func() { printUsage(stderr) }
+	addGlobalFlags(globalFlags, inv)
+	if err := globalFlags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	args = globalFlags.Args()
+	name := defaultCommand
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == name {
+			inv.command = cmd
+		}
+	}
+	if inv.command == nil {
+		return nil, usageError(stderr, globalFlags.Usage, "unknown command %q", name)
+	}
+
+	commandFlags := flag.NewFlagSet(programName+" "+name, flag.ContinueOnError)
+	commandFlags.SetOutput(stderr)
+	commandFlags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s %s [flags] %s\n\n%s\n\nFlags:\n", programName, name, inv.command.arguments, inv.command.description)
+		commandFlags.PrintDefaults()
+	}
+	// Registered again so that they can follow the command, keeping the values already parsed
+	addGlobalFlags(commandFlags, inv)
+	if inv.command.setup != nil {
+		inv.command.setup(commandFlags, inv)
+	}
+	if err := commandFlags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	inv.args = commandFlags.Args()
+	if len(inv.args) != inv.command.nArgs {
+		return nil, usageError(stderr, commandFlags.Usage, "%s takes %d argument(s), %d given", name, inv.command.nArgs, len(inv.args))
+	}
+
+	if inv.options.LogLevel != "" {
+		if _, err := zapcore.ParseLevel(inv.options.LogLevel); err != nil {
+			return nil, usageError(stderr, commandFlags.Usage, "invalid log level %q", inv.options.LogLevel)
+		}
+	}
+
+	return inv, nil
+}
+
+// usageError reports an invalid command line the way the flag package does
+func usageError(stderr io.Writer, usage func(), format string, args ...any) error {
+	err := fmt.Errorf(format, args...)
+	fmt.Fprintln(stderr, err)
+	usage()
+	return err
+}
+
+// addGlobalFlags registers the flags every command accepts, their defaults being the current options
+func addGlobalFlags(fs *flag.FlagSet, inv *invocation) {
+	fs.StringVar(&inv.options.ConfigDir, "config-dir", inv.options.ConfigDir, "directory of the configuration files")
+	fs.StringVar(&inv.options.Environment, "env", inv.options.Environment, "environment of the configuration, the ENV variable when empty")
+	fs.StringVar(&inv.options.LogLevel, "log-level", inv.options.LogLevel, "log level overriding the configuration (debug, info, warn, error)")
+	fs.StringVar(&inv.options.Library, "library", inv.options.Library, "only handle the library with this name")
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s [global flags] <command> [flags] [arguments]\n\nCommands:\n", programName)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-24s %s\n", cmd.name+" "+cmd.arguments, cmd.description)
+	}
+
+	fmt.Fprintln(w, "\nGlobal flags:")
+	globalFlags := flag.NewFlagSet(programName, flag.ContinueOnError)
+	globalFlags.SetOutput(w)
+	addGlobalFlags(globalFlags, &invocation{options: core.Options{ConfigDir: service.DefaultEnvConfigDir}})
+	globalFlags.PrintDefaults()
+
+	fmt.Fprintf(w, "\nRun '%s <command> -h' for the flags of a command.\n", programName)
+}
+
+func runApp(inv *invocation, _ io.Writer) error {
+	app, err := core.NewApp(inv.options)
+	if err != nil {
+		return fmt.Errorf("error initializing application: %w", err)
+	}
+
+	defer app.Logger.Sync()
+
+	if err := app.Run(); err != nil {
+		app.Logger.Error("Error during execution", zap.Error(err))
+		return err
+	}
+
+	// Shutdown gracefully
+	app.Shutdown()
+	return nil
+}
+
+func listLibraries(inv *invocation, stdout io.Writer) error {
+	app, err := core.NewApp(inv.options)
+	if err != nil {
+		return fmt.Errorf("error initializing application: %w", err)
+	}
+
+	defer app.Logger.Sync()
+
+	return app.ListLibraries(stdout)
+}
+
+func validateConfig(inv *invocation, stdout io.Writer) error {
+	environment := inv.options.Environment
+	if environment == "" {
+		environment = env.GetEnvironment()
+	}
+
+	if _, err := configService.LoadConfig(inv.options.ConfigDir, environment); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Configuration in %s is valid for environment %s\n", inv.options.ConfigDir, environment)
+	return nil
+}
+
+func renderSample(inv *invocation, stdout io.Writer) error {
+	app, err := core.NewApp(inv.options)
+	if err != nil {
+		return fmt.Errorf("error initializing application: %w", err)
+	}
+
+	defer app.Logger.Sync()
+
+	samplePath, err := app.RenderSample(inv.poster)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Sample poster rendered to %s\n", samplePath)
+	return nil
+}
+
+func refreshIMDb(inv *invocation, stdout io.Writer) error {
+	environment := inv.options.Environment
+	if environment == "" {
+		environment = env.GetEnvironment()
+	}
+
+	config, err := configService.LoadConfig(inv.options.ConfigDir, environment)
+	if err != nil {
+		return err
+	}
+
+	store, err := imdbDataset.Import(inv.args[0])
+	if err != nil {
+		return fmt.Errorf("error importing the IMDb datasets: %w", err)
+	}
+
+	if err := store.Save(config.IMDB.DatasetPath); err != nil {
+		return fmt.Errorf("error saving the IMDb dataset file: %w", err)
+	}
+
+	fmt.Fprintf(stdout, "Imported %d IMDb titles into %s\n", store.Len(), config.IMDB.DatasetPath)
+	return nil
+}
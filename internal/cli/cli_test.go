@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	core "github.com/zepollabot/media-rating-overlay/internal/app"
+	imdbDataset "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/dataset"
+)
+
+type CLITestSuite struct {
+	suite.Suite
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+}
+
+func (s *CLITestSuite) SetupTest() {
+	s.stdout = &bytes.Buffer{}
+	s.stderr = &bytes.Buffer{}
+}
+
+func TestCLITestSuite(t *testing.T) {
+	suite.Run(t, new(CLITestSuite))
+}
+
+func (s *CLITestSuite) TestParse() {
+	testCases := []struct {
+		name            string
+		args            []string
+		expectedCommand string
+		expectedOptions core.Options
+	}{
+		{
+			name:            "run by default",
+			args:            []string{},
+			expectedCommand: "run",
+			expectedOptions: core.Options{ConfigDir: "configs", PreviewDir: "previews"},
+		},
+		{
+			name:            "global flags before the command",
+			args:            []string{"--config-dir", "/config", "--env", "PROD", "--log-level", "debug", "--library", "Film", "run", "--force"},
+			expectedCommand: "run",
+			expectedOptions: core.Options{ConfigDir: "/config", Environment: "PROD", LogLevel: "debug", Library: "Film", Force: true, PreviewDir: "previews"},
+		},
+		{
+			name:            "global flags after the command",
+			args:            []string{"--env", "PROD", "run", "--library", "Film", "--dry-run", "--preview-dir", "/previews"},
+			expectedCommand: "run",
+			expectedOptions: core.Options{ConfigDir: "configs", Environment: "PROD", Library: "Film", DryRun: true, PreviewDir: "/previews"},
+		},
+		{
+			name:            "daemon",
+			args:            []string{"daemon", "--library", "Film"},
+			expectedCommand: "daemon",
+			expectedOptions: core.Options{ConfigDir: "configs", Library: "Film", Daemon: true},
+		},
+		{
+			name:            "restore",
+			args:            []string{"restore", "--dry-run"},
+			expectedCommand: "restore",
+			expectedOptions: core.Options{ConfigDir: "configs", Restore: true, DryRun: true},
+		},
+		{
+			name:            "preview",
+			args:            []string{"preview", "The Matrix"},
+			expectedCommand: "preview",
+			expectedOptions: core.Options{ConfigDir: "configs", DryRun: true, PreviewDir: "previews"},
+		},
+		{
+			name:            "render-sample",
+			args:            []string{"--library", "Film", "render-sample"},
+			expectedCommand: "render-sample",
+			expectedOptions: core.Options{ConfigDir: "configs", Library: "Film", DryRun: true, PreviewDir: "previews"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Act
+			inv, err := parse(tc.args, s.stderr)
+
+			// Assert
+			s.Require().NoError(err)
+			s.Equal(tc.expectedCommand, inv.command.name)
+			s.Equal(tc.expectedOptions, inv.options)
+		})
+	}
+}
+
+func (s *CLITestSuite) TestParse_Invalid() {
+	testCases := []struct {
+		name string
+		args []string
+	}{
+		{name: "unknown command", args: []string{"process"}},
+		{name: "unknown flag", args: []string{"run", "--restore"}},
+		{name: "missing item", args: []string{"preview"}},
+		{name: "missing datasets dir", args: []string{"refresh-imdb"}},
+		{name: "unexpected argument", args: []string{"list-libraries", "Film"}},
+		{name: "invalid log level", args: []string{"--log-level", "verbose", "validate-config"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Act
+			exitCode := Run(tc.args, s.stdout, s.stderr)
+
+			// Assert
+			s.Equal(exitUsage, exitCode)
+			s.Contains(s.stderr.String(), "Usage:")
+		})
+	}
+}
+
+func (s *CLITestSuite) TestRun_ValidateConfig() {
+	// Arrange
+	configDir := s.T().TempDir()
+	s.Require().NoError(os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(
+		"logger:\n  log_level: info\n  log_file_path: logs/test.log\n",
+	), 0664))
+
+	// Act
+	exitCode := Run([]string{"--config-dir", configDir, "--env", "DEV", "validate-config"}, s.stdout, s.stderr)
+
+	// Assert
+	s.Equal(exitOK, exitCode)
+	s.Contains(s.stdout.String(), "is valid for environment DEV")
+}
+
+func (s *CLITestSuite) TestRun_ValidateConfig_Invalid() {
+	// Arrange
+	configDir := s.T().TempDir()
+	s.Require().NoError(os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(
+		"logger:\n  log_level: debug\n  log_file_path: logs/test.log\n",
+	), 0664))
+
+	// Act
+	exitCode := Run([]string{"validate-config", "--config-dir", configDir, "--env", "PROD"}, s.stdout, s.stderr)
+
+	// Assert
+	s.Equal(exitError, exitCode)
+	s.Contains(s.stderr.String(), "debug logging is not allowed in production")
+}
+
+func (s *CLITestSuite) writeDataset(filePath string, content string) {
+	file, err := os.Create(filePath)
+	s.Require().NoError(err)
+	defer file.Close()
+
+	writer := gzip.NewWriter(file)
+	_, err = writer.Write([]byte(content))
+	s.Require().NoError(err)
+	s.Require().NoError(writer.Close())
+}
+
+func (s *CLITestSuite) TestRun_RefreshIMDb() {
+	// Arrange
+	configDir := s.T().TempDir()
+	datasetPath := filepath.Join(s.T().TempDir(), "imdb.gob.gz")
+	s.Require().NoError(os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(
+		"logger:\n  log_level: info\n  log_file_path: logs/test.log\nimdb:\n  enabled: true\n  dataset_path: "+datasetPath+"\n",
+	), 0664))
+
+	datasetsDir := s.T().TempDir()
+	s.writeDataset(filepath.Join(datasetsDir, imdbDataset.RatingsFileName), "tconst\taverageRating\tnumVotes\ntt0133093\t8.7\t2100000\n")
+	s.writeDataset(filepath.Join(datasetsDir, imdbDataset.BasicsFileName), "tconst\ttitleType\tprimaryTitle\toriginalTitle\tisAdult\tstartYear\tendYear\truntimeMinutes\tgenres\n"+
+		"tt0133093\tmovie\tThe Matrix\tThe Matrix\t0\t1999\t\\N\t136\tAction,Sci-Fi\n")
+
+	// Act
+	exitCode := Run([]string{"--config-dir", configDir, "--env", "DEV", "refresh-imdb", datasetsDir}, s.stdout, s.stderr)
+
+	// Assert
+	s.Equal(exitOK, exitCode, s.stderr.String())
+	s.Contains(s.stdout.String(), "Imported 1 IMDb titles into "+datasetPath)
+	store, err := imdbDataset.LoadStore(datasetPath)
+	s.Require().NoError(err)
+	title, found := store.FindByID("tt0133093")
+	s.True(found)
+	s.Equal(float32(8.7), title.Rating)
+}
+
+func (s *CLITestSuite) TestRun_RefreshIMDb_MissingDatasets() {
+	// Arrange
+	configDir := s.T().TempDir()
+	s.Require().NoError(os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(
+		"logger:\n  log_level: info\n  log_file_path: logs/test.log\n",
+	), 0664))
+
+	// Act
+	exitCode := Run([]string{"--config-dir", configDir, "--env", "DEV", "refresh-imdb", s.T().TempDir()}, s.stdout, s.stderr)
+
+	// Assert
+	s.Equal(exitError, exitCode)
+	s.Contains(s.stderr.String(), "error importing the IMDb datasets")
+}
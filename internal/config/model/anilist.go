@@ -0,0 +1,14 @@
+package config
+
+// AniList configures the AniList GraphQL API, providing the AniList average score of the anime
+type AniList struct {
+	Enabled bool `yaml:"enabled"`
+	// Libraries restricts the AniList ratings to the named libraries, e.g. the anime ones. Every library when empty
+	Libraries []string `yaml:"libraries"`
+}
+
+func DefaultAniList() *AniList {
+	return &AniList{
+		Enabled: false,
+	}
+}
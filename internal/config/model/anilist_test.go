@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type AniListTestSuite struct {
+	suite.Suite
+}
+
+func TestAniListTestSuite(t *testing.T) {
+	suite.Run(t, new(AniListTestSuite))
+}
+
+func (s *AniListTestSuite) TestDefaultAniList() {
+	cfg := DefaultAniList()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+}
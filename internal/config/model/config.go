@@ -6,23 +6,55 @@ import (
 
 // Config holds the application configuration
 type Config struct {
-	Plex        Plex            `yaml:"plex"`
-	TMDB        TMDB            `yaml:"tmdb"`
-	Performance Performance     `yaml:"performance"`
-	HTTPClient  HTTPClient      `yaml:"http_client"`
-	Logger      Logger          `yaml:"logger"`
-	Processor   ProcessorConfig `yaml:"processor"`
+	Plex           Plex            `yaml:"plex"`
+	Jellyfin       Jellyfin        `yaml:"jellyfin"`
+	Emby           Emby            `yaml:"emby"`
+	Kodi           Kodi            `yaml:"kodi"`
+	Local          Local           `yaml:"local"`
+	TMDB           TMDB            `yaml:"tmdb"`
+	OMDb           OMDb            `yaml:"omdb"`
+	IMDB           IMDB            `yaml:"imdb"`
+	Trakt          Trakt           `yaml:"trakt"`
+	Letterboxd     Letterboxd      `yaml:"letterboxd"`
+	RottenTomatoes RottenTomatoes  `yaml:"rotten_tomatoes"`
+	AniList        AniList         `yaml:"anilist"`
+	MyAnimeList    MyAnimeList     `yaml:"myanimelist"`
+	MDBList        MDBList         `yaml:"mdblist"`
+	Votes          Votes           `yaml:"votes"`
+	Performance    Performance     `yaml:"performance"`
+	HTTPClient     HTTPClient      `yaml:"http_client"`
+	Logger         Logger          `yaml:"logger"`
+	Processor      ProcessorConfig `yaml:"processor"`
+	State          State           `yaml:"state"`
+	Scheduler      Scheduler       `yaml:"scheduler"`
+	Webhook        Webhook         `yaml:"webhook"`
 }
 
 // DefaultConfig returns a default configuration
 func DefaultConfig() *Config {
 	config := &Config{}
 	config.Plex = *DefaultPlex()
+	config.Jellyfin = *DefaultJellyfin()
+	config.Emby = *DefaultEmby()
+	config.Kodi = *DefaultKodi()
+	config.Local = *DefaultLocal()
 	config.TMDB = *DefaultTMDB()
+	config.OMDb = *DefaultOMDb()
+	config.IMDB = *DefaultIMDB()
+	config.Trakt = *DefaultTrakt()
+	config.Letterboxd = *DefaultLetterboxd()
+	config.RottenTomatoes = *DefaultRottenTomatoes()
+	config.MDBList = *DefaultMDBList()
+	config.AniList = *DefaultAniList()
+	config.MyAnimeList = *DefaultMyAnimeList()
+	config.Votes = *DefaultVotes()
 	config.Performance = *DefaultPerformance()
 	config.HTTPClient = *DefaultHTTPClient()
 	config.Logger = *DefaultLogger()
 	config.Processor = *DefaultProcessorConfig()
+	config.State = *DefaultState()
+	config.Scheduler = *DefaultScheduler()
+	config.Webhook = *DefaultWebhook()
 	return config
 }
 
@@ -31,9 +63,39 @@ func (c *Config) Validate() error {
 	if err := c.Plex.Validate(); err != nil {
 		return fmt.Errorf("plex config: %w", err)
 	}
+	if err := c.Jellyfin.Validate(); err != nil {
+		return fmt.Errorf("jellyfin config: %w", err)
+	}
+	if err := c.Emby.Validate(); err != nil {
+		return fmt.Errorf("emby config: %w", err)
+	}
+	if err := c.Kodi.Validate(); err != nil {
+		return fmt.Errorf("kodi config: %w", err)
+	}
+	if err := c.Local.Validate(); err != nil {
+		return fmt.Errorf("local config: %w", err)
+	}
 	if err := c.TMDB.Validate(); err != nil {
 		return fmt.Errorf("tmdb config: %w", err)
 	}
+	if err := c.OMDb.Validate(); err != nil {
+		return fmt.Errorf("omdb config: %w", err)
+	}
+	if err := c.IMDB.Validate(); err != nil {
+		return fmt.Errorf("imdb config: %w", err)
+	}
+	if err := c.Trakt.Validate(); err != nil {
+		return fmt.Errorf("trakt config: %w", err)
+	}
+	if err := c.MDBList.Validate(); err != nil {
+		return fmt.Errorf("mdblist config: %w", err)
+	}
+	if err := c.MyAnimeList.Validate(); err != nil {
+		return fmt.Errorf("myanimelist config: %w", err)
+	}
+	if err := c.Votes.Validate(); err != nil {
+		return fmt.Errorf("votes config: %w", err)
+	}
 	if err := c.Performance.Validate(); err != nil {
 		return fmt.Errorf("performance config: %w", err)
 	}
@@ -46,5 +108,33 @@ func (c *Config) Validate() error {
 	if err := c.Processor.Validate(); err != nil {
 		return fmt.Errorf("processor config: %w", err)
 	}
+	if err := c.State.Validate(); err != nil {
+		return fmt.Errorf("state config: %w", err)
+	}
+	if err := c.Scheduler.Validate(); err != nil {
+		return fmt.Errorf("scheduler config: %w", err)
+	}
+	if err := c.Webhook.Validate(); err != nil {
+		return fmt.Errorf("webhook config: %w", err)
+	}
+	for _, library := range c.Libraries() {
+		if err := library.Validate(); err != nil {
+			return fmt.Errorf("library config: %w", err)
+		}
+		if err := c.Scheduler.ValidateLibrary(library); err != nil {
+			return fmt.Errorf("library config: %w", err)
+		}
+	}
 	return nil
 }
+
+// Libraries returns the libraries of every media service
+func (c *Config) Libraries() []Library {
+	var libraries []Library
+	libraries = append(libraries, c.Plex.Libraries...)
+	libraries = append(libraries, c.Jellyfin.Libraries...)
+	libraries = append(libraries, c.Emby.Libraries...)
+	libraries = append(libraries, c.Kodi.Libraries...)
+	libraries = append(libraries, c.Local.Libraries...)
+	return libraries
+}
@@ -26,10 +26,61 @@ func (s *ConfigTestSuite) TestDefaultConfig() {
 		assert.Equal(t, DefaultPlex(), &cfg.Plex)
 	})
 
+	s.T().Run("Jellyfin should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultJellyfin(), &cfg.Jellyfin)
+	})
+
+	s.T().Run("Emby should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultEmby(), &cfg.Emby)
+	})
+
+	s.T().Run("Kodi should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultKodi(), &cfg.Kodi)
+	})
+
+	s.T().Run("Local should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultLocal(), &cfg.Local)
+	})
+
 	s.T().Run("TMDB should be default", func(t *testing.T) {
 		assert.Equal(t, DefaultTMDB(), &cfg.TMDB)
 	})
 
+	s.T().Run("OMDb should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultOMDb(), &cfg.OMDb)
+	})
+
+	s.T().Run("IMDB should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultIMDB(), &cfg.IMDB)
+	})
+
+	s.T().Run("Trakt should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultTrakt(), &cfg.Trakt)
+	})
+
+	s.T().Run("Letterboxd should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultLetterboxd(), &cfg.Letterboxd)
+	})
+
+	s.T().Run("RottenTomatoes should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultRottenTomatoes(), &cfg.RottenTomatoes)
+	})
+
+	s.T().Run("AniList should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultAniList(), &cfg.AniList)
+	})
+
+	s.T().Run("MyAnimeList should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultMyAnimeList(), &cfg.MyAnimeList)
+	})
+
+	s.T().Run("MDBList should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultMDBList(), &cfg.MDBList)
+	})
+	s.T().Run("Votes should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultVotes(), &cfg.Votes)
+	})
+
 	s.T().Run("Performance should be default", func(t *testing.T) {
 		assert.Equal(t, DefaultPerformance(), &cfg.Performance)
 	})
@@ -42,6 +93,18 @@ func (s *ConfigTestSuite) TestDefaultConfig() {
 		assert.Equal(t, DefaultLogger(), &cfg.Logger)
 	})
 
+	s.T().Run("State should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultState(), &cfg.State)
+	})
+
+	s.T().Run("Scheduler should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultScheduler(), &cfg.Scheduler)
+	})
+
+	s.T().Run("Webhook should be default", func(t *testing.T) {
+		assert.Equal(t, DefaultWebhook(), &cfg.Webhook)
+	})
+
 	s.T().Run("Processor should be default", func(t *testing.T) {
 		assert.Equal(t, DefaultProcessorConfig(), &cfg.Processor)
 	})
@@ -63,6 +126,93 @@ func (s *ConfigTestSuite) TestConfig_Validate() {
 		assert.Contains(t, err.Error(), "plex config: plex.url is required when plex is enabled")
 	})
 
+	s.T().Run("Invalid Jellyfin config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Jellyfin.Enabled = true
+		cfg.Jellyfin.Url = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "jellyfin config: jellyfin.url is required when jellyfin is enabled")
+	})
+
+	s.T().Run("Invalid Emby config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Emby.Enabled = true
+		cfg.Emby.Url = "http://localhost:8096"
+		cfg.Emby.ApiKey = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "emby config: emby.api_key is required when emby is enabled")
+	})
+
+	s.T().Run("Invalid Kodi config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Kodi.Enabled = true
+		cfg.Kodi.Url = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "kodi config: kodi.url is required when kodi is enabled")
+	})
+
+	s.T().Run("Invalid Local config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Local.Enabled = true
+		cfg.Local.Libraries = []Library{{Name: "Movies"}} // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "local config: local.libraries[0].path is required when local is enabled")
+	})
+
+	s.T().Run("Invalid State config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.State.Enabled = true
+		cfg.State.FilePath = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "state config: state.file_path is required when state is enabled")
+	})
+
+	s.T().Run("Invalid Scheduler config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Scheduler.Schedule = "daily"
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "scheduler config: scheduler.schedule: invalid cron expression")
+	})
+
+	s.T().Run("Invalid Webhook config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Webhook.Enabled = true
+		cfg.Webhook.Address = ""
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "webhook config: webhook.address is required when webhook is enabled")
+	})
+
+	s.T().Run("Invalid library schedule should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Plex.Libraries = []Library{{Name: "Film", Schedule: "daily"}}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "library config: library Film schedule: invalid cron expression")
+	})
+
+	s.T().Run("Invalid library rating source should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Plex.Libraries = []Library{{Name: "Anime", Ratings: []RatingSource{{Name: "MyAnimeList"}, {Name: "Crunchyroll"}}}}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `library config: library Anime ratings: unknown rating source "Crunchyroll"`)
+	})
+
+	s.T().Run("Negative library max ratings should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Plex.Libraries = []Library{{Name: "Film", MaxRatings: -1}}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "library config: library Film max_ratings must not be negative")
+	})
+
 	s.T().Run("Invalid TMDB config should fail", func(t *testing.T) {
 		cfg := DefaultConfig()
 		cfg.TMDB.Enabled = true
@@ -72,6 +222,59 @@ func (s *ConfigTestSuite) TestConfig_Validate() {
 		assert.Contains(t, err.Error(), "tmdb config: tmdb.api_key is required when tmdb is enabled")
 	})
 
+	s.T().Run("Invalid OMDb config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.OMDb.Enabled = true
+		cfg.OMDb.ApiKey = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "omdb config: omdb.api_key is required when omdb is enabled")
+	})
+
+	s.T().Run("Invalid IMDB config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.IMDB.Enabled = true
+		cfg.IMDB.DatasetPath = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "imdb config: imdb.dataset_path is required when imdb is enabled")
+	})
+
+	s.T().Run("Invalid Trakt config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Trakt.Enabled = true
+		cfg.Trakt.ClientID = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "trakt config: trakt.client_id is required when trakt is enabled")
+	})
+
+	s.T().Run("Invalid Votes config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Votes.LowConfidence = "blur" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "votes config: votes.low_confidence must be hide or dim")
+	})
+
+	s.T().Run("Invalid MDBList config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.MDBList.Enabled = true
+		cfg.MDBList.ApiKey = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "mdblist config: mdblist.api_key is required when mdblist is enabled")
+	})
+
+	s.T().Run("Invalid MyAnimeList config should fail", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.MyAnimeList.Enabled = true
+		cfg.MyAnimeList.ClientID = "" // Invalid state
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "myanimelist config: myanimelist.client_id is required when myanimelist is enabled")
+	})
+
 	s.T().Run("Invalid Performance config should fail (MaxThreads)", func(t *testing.T) {
 		cfg := DefaultConfig()
 		cfg.Performance.MaxThreads = -1 // Invalid state
@@ -0,0 +1,30 @@
+package config
+
+import "fmt"
+
+type Emby struct {
+	Url       string    `yaml:"url"`
+	ApiKey    string    `yaml:"api_key"`
+	Enabled   bool      `yaml:"enabled"`
+	Libraries []Library `yaml:"libraries"`
+}
+
+func DefaultEmby() *Emby {
+	return &Emby{
+		Enabled:   false,
+		Libraries: []Library{},
+	}
+}
+
+// Validate validates the Emby configuration
+func (c *Emby) Validate() error {
+	if c.Enabled {
+		if c.Url == "" {
+			return fmt.Errorf("emby.url is required when emby is enabled")
+		}
+		if c.ApiKey == "" {
+			return fmt.Errorf("emby.api_key is required when emby is enabled")
+		}
+	}
+	return nil
+}
@@ -1,4 +1,25 @@
 package config
 
+import "fmt"
+
+// IMDB configures the IMDb ratings read offline from the IMDb datasets, imported into
+// the dataset file by the refresh-imdb command
 type IMDB struct {
+	Enabled     bool   `yaml:"enabled"`
+	DatasetPath string `yaml:"dataset_path"`
+}
+
+func DefaultIMDB() *IMDB {
+	return &IMDB{
+		Enabled:     false,
+		DatasetPath: "state/imdb-ratings.gob.gz",
+	}
+}
+
+// Validate validates the IMDB configuration
+func (c *IMDB) Validate() error {
+	if c.Enabled && c.DatasetPath == "" {
+		return fmt.Errorf("imdb.dataset_path is required when imdb is enabled")
+	}
+	return nil
 }
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type IMDBTestSuite struct {
+	suite.Suite
+}
+
+func TestIMDBTestSuite(t *testing.T) {
+	suite.Run(t, new(IMDBTestSuite))
+}
+
+func (s *IMDBTestSuite) TestDefaultIMDB() {
+	cfg := DefaultIMDB()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+	s.T().Run("DatasetPath should be set by default", func(t *testing.T) {
+		assert.Equal(t, "state/imdb-ratings.gob.gz", cfg.DatasetPath)
+	})
+}
+
+func (s *IMDBTestSuite) TestIMDB_Validate() {
+	s.T().Run("Valid default config (disabled) should pass", func(t *testing.T) {
+		cfg := DefaultIMDB()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with empty dataset path should fail", func(t *testing.T) {
+		cfg := DefaultIMDB()
+		cfg.Enabled = true
+		cfg.DatasetPath = ""
+		assert.EqualError(t, cfg.Validate(), "imdb.dataset_path is required when imdb is enabled")
+	})
+
+	s.T().Run("Enabled with dataset path should pass", func(t *testing.T) {
+		cfg := DefaultIMDB()
+		cfg.Enabled = true
+		assert.NoError(t, cfg.Validate())
+	})
+}
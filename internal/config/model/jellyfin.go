@@ -0,0 +1,30 @@
+package config
+
+import "fmt"
+
+type Jellyfin struct {
+	Url       string    `yaml:"url"`
+	ApiKey    string    `yaml:"api_key"`
+	Enabled   bool      `yaml:"enabled"`
+	Libraries []Library `yaml:"libraries"`
+}
+
+func DefaultJellyfin() *Jellyfin {
+	return &Jellyfin{
+		Enabled:   false,
+		Libraries: []Library{},
+	}
+}
+
+// Validate validates the Jellyfin configuration
+func (c *Jellyfin) Validate() error {
+	if c.Enabled {
+		if c.Url == "" {
+			return fmt.Errorf("jellyfin.url is required when jellyfin is enabled")
+		}
+		if c.ApiKey == "" {
+			return fmt.Errorf("jellyfin.api_key is required when jellyfin is enabled")
+		}
+	}
+	return nil
+}
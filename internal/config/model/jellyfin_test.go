@@ -0,0 +1,84 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type JellyfinTestSuite struct {
+	suite.Suite
+}
+
+func TestJellyfinTestSuite(t *testing.T) {
+	suite.Run(t, new(JellyfinTestSuite))
+}
+
+func (s *JellyfinTestSuite) TestDefaultJellyfin() {
+	cfg := DefaultJellyfin()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+	s.T().Run("Libraries should be empty by default", func(t *testing.T) {
+		assert.Empty(t, cfg.Libraries)
+	})
+	s.T().Run("URL should be empty by default", func(t *testing.T) {
+		assert.Empty(t, cfg.Url)
+	})
+	s.T().Run("ApiKey should be empty by default", func(t *testing.T) {
+		assert.Empty(t, cfg.ApiKey)
+	})
+}
+
+func (s *JellyfinTestSuite) TestJellyfin_Validate() {
+	defaultCfg := DefaultJellyfin()
+
+	s.T().Run("Valid default config (disabled) should pass", func(t *testing.T) {
+		cfg := DefaultJellyfin()
+		err := cfg.Validate()
+		assert.NoError(t, err)
+	})
+
+	s.T().Run("Enabled with empty URL should fail", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		cfg.Url = ""
+		cfg.ApiKey = "an-api-key"
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "jellyfin.url is required when jellyfin is enabled")
+	})
+
+	s.T().Run("Enabled with empty ApiKey should fail", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		cfg.Url = "http://localhost"
+		cfg.ApiKey = ""
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "jellyfin.api_key is required when jellyfin is enabled")
+	})
+
+	s.T().Run("Enabled with URL and ApiKey should pass", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		cfg.Url = "http://localhost"
+		cfg.ApiKey = "an-api-key"
+		err := cfg.Validate()
+		assert.NoError(t, err)
+	})
+
+	s.T().Run("Disabled with URL and ApiKey should pass", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = false
+		cfg.Url = "http://localhost"
+		cfg.ApiKey = "an-api-key"
+		err := cfg.Validate()
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,34 @@
+package config
+
+import "fmt"
+
+type Kodi struct {
+	Url      string `yaml:"url"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Enabled  bool   `yaml:"enabled"`
+	// UpdateArt sets the generated poster as the movie art through JSON-RPC,
+	// otherwise Kodi picks up the poster file on the next scan
+	UpdateArt bool      `yaml:"update_art"`
+	Libraries []Library `yaml:"libraries"`
+}
+
+func DefaultKodi() *Kodi {
+	return &Kodi{
+		Enabled:   false,
+		Libraries: []Library{},
+	}
+}
+
+// Validate validates the Kodi configuration
+func (c *Kodi) Validate() error {
+	if c.Enabled {
+		if c.Url == "" {
+			return fmt.Errorf("kodi.url is required when kodi is enabled")
+		}
+		if c.Password != "" && c.Username == "" {
+			return fmt.Errorf("kodi.username is required when kodi.password is set")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type KodiTestSuite struct {
+	suite.Suite
+}
+
+func TestKodiTestSuite(t *testing.T) {
+	suite.Run(t, new(KodiTestSuite))
+}
+
+func (s *KodiTestSuite) TestDefaultKodi() {
+	cfg := DefaultKodi()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+	s.T().Run("UpdateArt should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.UpdateArt)
+	})
+	s.T().Run("Libraries should be empty by default", func(t *testing.T) {
+		assert.Empty(t, cfg.Libraries)
+	})
+}
+
+func (s *KodiTestSuite) TestKodi_Validate() {
+	defaultCfg := DefaultKodi()
+
+	s.T().Run("Valid default config (disabled) should pass", func(t *testing.T) {
+		cfg := DefaultKodi()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with empty URL should fail", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		err := cfg.Validate()
+		assert.EqualError(t, err, "kodi.url is required when kodi is enabled")
+	})
+
+	s.T().Run("Enabled with password and no username should fail", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		cfg.Url = "http://localhost:8080"
+		cfg.Password = "secret"
+		err := cfg.Validate()
+		assert.EqualError(t, err, "kodi.username is required when kodi.password is set")
+	})
+
+	s.T().Run("Enabled without credentials should pass", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		cfg.Url = "http://localhost:8080"
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with credentials should pass", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		cfg.Url = "http://localhost:8080"
+		cfg.Username = "kodi"
+		cfg.Password = "secret"
+		assert.NoError(t, cfg.Validate())
+	})
+}
@@ -0,0 +1,12 @@
+package config
+
+// Letterboxd configures the Letterboxd average rating of the films, read from their public pages
+type Letterboxd struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+func DefaultLetterboxd() *Letterboxd {
+	return &Letterboxd{
+		Enabled: false,
+	}
+}
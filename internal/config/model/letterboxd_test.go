@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LetterboxdTestSuite struct {
+	suite.Suite
+}
+
+func TestLetterboxdTestSuite(t *testing.T) {
+	suite.Run(t, new(LetterboxdTestSuite))
+}
+
+func (s *LetterboxdTestSuite) TestDefaultLetterboxd() {
+	cfg := DefaultLetterboxd()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+}
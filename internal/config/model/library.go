@@ -1,10 +1,45 @@
 package config
 
+import (
+	"fmt"
+
+	"github.com/zepollabot/media-rating-overlay/internal/scheduler"
+)
+
 type Library struct {
-	Name    string  `yaml:"name"`
-	Enabled bool    `yaml:"enabled"`
-	Refresh bool    `yaml:"refresh"`
-	Path    string  `yaml:"path"`
-	Filters Filter  `yaml:"filters"`
-	Overlay Overlay `yaml:"overlay"`
+	Name    string `yaml:"name"`
+	Enabled bool   `yaml:"enabled"`
+	Refresh bool   `yaml:"refresh"`
+	Path    string `yaml:"path"`
+	// Seasons also processes the season posters of TV show libraries
+	Seasons bool `yaml:"seasons"`
+	// PageSize is the number of items retrieved per request, where the media service supports paging
+	PageSize int `yaml:"page_size"`
+	// Schedule overrides the schedule of the scheduler for this library in daemon mode
+	Schedule string  `yaml:"schedule"`
+	Filters  Filter  `yaml:"filters"`
+	Overlay  Overlay `yaml:"overlay"`
+	// Ratings selects the ratings shown on the posters, in display order. Every rating is shown,
+	// in the order the rating services found them, when empty
+	Ratings []RatingSource `yaml:"ratings"`
+	// MaxRatings is the maximum number of ratings shown on a poster, zero for no maximum
+	MaxRatings int `yaml:"max_ratings"`
+}
+
+// Validate validates the Library configuration
+func (c *Library) Validate() error {
+	if c.Schedule != "" {
+		if _, err := scheduler.Parse(c.Schedule); err != nil {
+			return fmt.Errorf("library %s schedule: %w", c.Name, err)
+		}
+	}
+	for _, source := range c.Ratings {
+		if err := source.Validate(); err != nil {
+			return fmt.Errorf("library %s ratings: %w", c.Name, err)
+		}
+	}
+	if c.MaxRatings < 0 {
+		return fmt.Errorf("library %s max_ratings must not be negative", c.Name)
+	}
+	return nil
 }
@@ -0,0 +1,28 @@
+package config
+
+import "fmt"
+
+// Local describes libraries read directly from the filesystem, without a media server
+type Local struct {
+	Enabled   bool      `yaml:"enabled"`
+	Libraries []Library `yaml:"libraries"`
+}
+
+func DefaultLocal() *Local {
+	return &Local{
+		Enabled:   false,
+		Libraries: []Library{},
+	}
+}
+
+// Validate validates the Local configuration
+func (c *Local) Validate() error {
+	if c.Enabled {
+		for i, library := range c.Libraries {
+			if library.Path == "" {
+				return fmt.Errorf("local.libraries[%d].path is required when local is enabled", i)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocalTestSuite struct {
+	suite.Suite
+}
+
+func TestLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalTestSuite))
+}
+
+func (s *LocalTestSuite) TestDefaultLocal() {
+	cfg := DefaultLocal()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+	s.T().Run("Libraries should be empty by default", func(t *testing.T) {
+		assert.Empty(t, cfg.Libraries)
+	})
+}
+
+func (s *LocalTestSuite) TestLocal_Validate() {
+	s.T().Run("Valid default config (disabled) should pass", func(t *testing.T) {
+		cfg := DefaultLocal()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Disabled with library without path should pass", func(t *testing.T) {
+		cfg := DefaultLocal()
+		cfg.Libraries = []Library{{Name: "Movies"}}
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with library without path should fail", func(t *testing.T) {
+		cfg := DefaultLocal()
+		cfg.Enabled = true
+		cfg.Libraries = []Library{{Name: "Movies", Path: "/movies"}, {Name: "Docs"}}
+		err := cfg.Validate()
+		assert.EqualError(t, err, "local.libraries[1].path is required when local is enabled")
+	})
+
+	s.T().Run("Enabled with library paths should pass", func(t *testing.T) {
+		cfg := DefaultLocal()
+		cfg.Enabled = true
+		cfg.Libraries = []Library{{Name: "Movies", Path: "/movies"}}
+		assert.NoError(t, cfg.Validate())
+	})
+}
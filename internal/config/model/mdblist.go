@@ -0,0 +1,25 @@
+package config
+
+import "fmt"
+
+// MDBList configures the MDBList API, aggregating the ratings of several platforms
+type MDBList struct {
+	Enabled bool   `yaml:"enabled"`
+	ApiKey  string `yaml:"api_key"`
+}
+
+func DefaultMDBList() *MDBList {
+	return &MDBList{
+		Enabled: false,
+	}
+}
+
+// Validate validates the MDBList configuration
+func (c *MDBList) Validate() error {
+	if c.Enabled {
+		if c.ApiKey == "" {
+			return fmt.Errorf("mdblist.api_key is required when mdblist is enabled")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MDBListTestSuite struct {
+	suite.Suite
+}
+
+func TestMDBListTestSuite(t *testing.T) {
+	suite.Run(t, new(MDBListTestSuite))
+}
+
+func (s *MDBListTestSuite) TestDefaultMDBList() {
+	cfg := DefaultMDBList()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+}
+
+func (s *MDBListTestSuite) TestMDBList_Validate() {
+	s.T().Run("Valid default config should pass", func(t *testing.T) {
+		cfg := DefaultMDBList()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with API key should pass", func(t *testing.T) {
+		cfg := MDBList{Enabled: true, ApiKey: "mdblist-api-key"}
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled without API key should fail", func(t *testing.T) {
+		cfg := MDBList{Enabled: true}
+		assert.EqualError(t, cfg.Validate(), "mdblist.api_key is required when mdblist is enabled")
+	})
+}
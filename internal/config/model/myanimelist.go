@@ -0,0 +1,27 @@
+package config
+
+import "fmt"
+
+// MyAnimeList configures the MyAnimeList API, providing the MyAnimeList mean score of the anime
+type MyAnimeList struct {
+	Enabled  bool   `yaml:"enabled"`
+	ClientID string `yaml:"client_id"`
+	// Libraries restricts the MyAnimeList ratings to the named libraries, e.g. the anime ones. Every library when empty
+	Libraries []string `yaml:"libraries"`
+}
+
+func DefaultMyAnimeList() *MyAnimeList {
+	return &MyAnimeList{
+		Enabled: false,
+	}
+}
+
+// Validate validates the MyAnimeList configuration
+func (c *MyAnimeList) Validate() error {
+	if c.Enabled {
+		if c.ClientID == "" {
+			return fmt.Errorf("myanimelist.client_id is required when myanimelist is enabled")
+		}
+	}
+	return nil
+}
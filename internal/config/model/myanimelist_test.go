@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MyAnimeListTestSuite struct {
+	suite.Suite
+}
+
+func TestMyAnimeListTestSuite(t *testing.T) {
+	suite.Run(t, new(MyAnimeListTestSuite))
+}
+
+func (s *MyAnimeListTestSuite) TestDefaultMyAnimeList() {
+	cfg := DefaultMyAnimeList()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+}
+
+func (s *MyAnimeListTestSuite) TestMyAnimeList_Validate() {
+	s.T().Run("Valid default config should pass", func(t *testing.T) {
+		cfg := DefaultMyAnimeList()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with client ID should pass", func(t *testing.T) {
+		cfg := MyAnimeList{Enabled: true, ClientID: "mal-client-id"}
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled without client ID should fail", func(t *testing.T) {
+		cfg := MyAnimeList{Enabled: true}
+		assert.EqualError(t, cfg.Validate(), "myanimelist.client_id is required when myanimelist is enabled")
+	})
+}
@@ -0,0 +1,25 @@
+package config
+
+import "fmt"
+
+// OMDb configures the OMDb API, providing the IMDb, Rotten Tomatoes critic and Metacritic ratings
+type OMDb struct {
+	Enabled bool   `yaml:"enabled"`
+	ApiKey  string `yaml:"api_key"`
+}
+
+func DefaultOMDb() *OMDb {
+	return &OMDb{
+		Enabled: false,
+	}
+}
+
+// Validate validates the OMDb configuration
+func (c *OMDb) Validate() error {
+	if c.Enabled {
+		if c.ApiKey == "" {
+			return fmt.Errorf("omdb.api_key is required when omdb is enabled")
+		}
+	}
+	return nil
+}
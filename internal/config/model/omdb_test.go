@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type OMDbTestSuite struct {
+	suite.Suite
+}
+
+func TestOMDbTestSuite(t *testing.T) {
+	suite.Run(t, new(OMDbTestSuite))
+}
+
+func (s *OMDbTestSuite) TestDefaultOMDb() {
+	cfg := DefaultOMDb()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+}
+
+func (s *OMDbTestSuite) TestOMDb_Validate() {
+	s.T().Run("Valid default config should pass", func(t *testing.T) {
+		cfg := DefaultOMDb()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with API key should pass", func(t *testing.T) {
+		cfg := OMDb{Enabled: true, ApiKey: "omdb-key"}
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled without API key should fail", func(t *testing.T) {
+		cfg := OMDb{Enabled: true}
+		assert.EqualError(t, cfg.Validate(), "omdb.api_key is required when omdb is enabled")
+	})
+}
@@ -3,10 +3,19 @@ package config
 import "fmt"
 
 type Plex struct {
-	Url       string    `yaml:"url"`
-	Token     string    `yaml:"token"`
-	Enabled   bool      `yaml:"enabled"`
-	Libraries []Library `yaml:"libraries"`
+	Url       string     `yaml:"url"`
+	Token     string     `yaml:"token"`
+	Enabled   bool       `yaml:"enabled"`
+	Upload    PlexUpload `yaml:"upload"`
+	Libraries []Library  `yaml:"libraries"`
+}
+
+// PlexUpload sends the generated posters through the Plex API instead of
+// writing them next to the media files, for libraries on read-only storage
+type PlexUpload struct {
+	Enabled bool `yaml:"enabled"`
+	// PosterDir keeps the original and generated posters while uploading
+	PosterDir string `yaml:"poster_dir"`
 }
 
 func DefaultPlex() *Plex {
@@ -25,6 +34,9 @@ func (c *Plex) Validate() error {
 		if c.Token == "" {
 			return fmt.Errorf("plex.token is required when plex is enabled")
 		}
+		if c.Upload.Enabled && c.Upload.PosterDir == "" {
+			return fmt.Errorf("plex.upload.poster_dir is required when plex.upload is enabled")
+		}
 	}
 	return nil
 }
@@ -33,6 +33,9 @@ func (s *PlexTestSuite) TestDefaultPlex() {
 	s.T().Run("Token should be empty by default", func(t *testing.T) {
 		assert.Empty(t, cfg.Token)
 	})
+	s.T().Run("Upload should be disabled by default", func(t *testing.T) {
+		assert.False(t, cfg.Upload.Enabled)
+	})
 }
 
 func (s *PlexTestSuite) TestPlex_Validate() {
@@ -73,6 +76,27 @@ func (s *PlexTestSuite) TestPlex_Validate() {
 		assert.NoError(t, err)
 	})
 
+	s.T().Run("Upload enabled without poster dir should fail", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		cfg.Url = "http://localhost"
+		cfg.Token = "a-token"
+		cfg.Upload = PlexUpload{Enabled: true}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "plex.upload.poster_dir is required when plex.upload is enabled")
+	})
+
+	s.T().Run("Upload enabled with poster dir should pass", func(t *testing.T) {
+		cfg := *defaultCfg
+		cfg.Enabled = true
+		cfg.Url = "http://localhost"
+		cfg.Token = "a-token"
+		cfg.Upload = PlexUpload{Enabled: true, PosterDir: "/data/posters"}
+		err := cfg.Validate()
+		assert.NoError(t, err)
+	})
+
 	s.T().Run("Disabled with URL and Token should pass", func(t *testing.T) {
 		cfg := *defaultCfg
 		cfg.Enabled = false
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+)
+
+// ratingSourceNames are the names of the ratings drawn on the posters. MDBList is not one of them,
+// its ratings being named after their platforms
+var ratingSourceNames = []string{
+	constant.RatingServiceTMDB,
+	constant.RatingServiceIMDB,
+	constant.RatingServiceRottenTomatoes,
+	constant.RatingServiceMetacritic,
+	constant.RatingServiceTrakt,
+	constant.RatingServiceLetterboxd,
+	constant.RatingServiceAniList,
+	constant.RatingServiceMyAnimeList,
+}
+
+// RatingSource selects the ratings of a rating service shown on the posters of a library
+type RatingSource struct {
+	// Name is the name of the rating service, e.g. IMDB or Rotten Tomatoes, case insensitive
+	Name string `yaml:"name"`
+	// Type restricts the source to its critic or audience rating, both are shown when empty
+	Type string `yaml:"type"`
+}
+
+// Matches tells whether the rating of the given name and type comes from the source
+func (c *RatingSource) Matches(name string, ratingType string) bool {
+	return strings.EqualFold(c.Name, name) && (c.Type == "" || c.Type == ratingType)
+}
+
+// Validate validates the RatingSource configuration
+func (c *RatingSource) Validate() error {
+	if !slices.ContainsFunc(ratingSourceNames, func(name string) bool { return strings.EqualFold(name, c.Name) }) {
+		return fmt.Errorf("unknown rating source %q, expected one of %s", c.Name, strings.Join(ratingSourceNames, ", "))
+	}
+	if c.Type != "" && c.Type != constant.RatingServiceTypeCritic && c.Type != constant.RatingServiceTypeAudience {
+		return fmt.Errorf("rating source %s type must be %s or %s", c.Name, constant.RatingServiceTypeCritic, constant.RatingServiceTypeAudience)
+	}
+	return nil
+}
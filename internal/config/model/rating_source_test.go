@@ -0,0 +1,56 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RatingSourceTestSuite struct {
+	suite.Suite
+}
+
+func TestRatingSourceTestSuite(t *testing.T) {
+	suite.Run(t, new(RatingSourceTestSuite))
+}
+
+func (s *RatingSourceTestSuite) TestMatches() {
+	s.T().Run("Source without type should match every type", func(t *testing.T) {
+		source := RatingSource{Name: "rotten tomatoes"}
+		assert.True(t, source.Matches("Rotten Tomatoes", "critic"))
+		assert.True(t, source.Matches("Rotten Tomatoes", "audience"))
+	})
+	s.T().Run("Source with type should match its type only", func(t *testing.T) {
+		source := RatingSource{Name: "Rotten Tomatoes", Type: "critic"}
+		assert.True(t, source.Matches("Rotten Tomatoes", "critic"))
+		assert.False(t, source.Matches("Rotten Tomatoes", "audience"))
+	})
+	s.T().Run("Source should not match other names", func(t *testing.T) {
+		source := RatingSource{Name: "IMDB"}
+		assert.False(t, source.Matches("TMDB", "audience"))
+	})
+}
+
+func (s *RatingSourceTestSuite) TestValidate() {
+	s.T().Run("Known source should pass", func(t *testing.T) {
+		source := RatingSource{Name: "imdb"}
+		assert.NoError(t, source.Validate())
+	})
+	s.T().Run("Known source with type should pass", func(t *testing.T) {
+		source := RatingSource{Name: "Rotten Tomatoes", Type: "audience"}
+		assert.NoError(t, source.Validate())
+	})
+	s.T().Run("Unknown source should fail", func(t *testing.T) {
+		source := RatingSource{Name: "MDBList"}
+		err := source.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown rating source "MDBList"`)
+	})
+	s.T().Run("Unknown type should fail", func(t *testing.T) {
+		source := RatingSource{Name: "IMDB", Type: "user"}
+		err := source.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rating source IMDB type must be critic or audience")
+	})
+}
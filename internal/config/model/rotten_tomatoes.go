@@ -1,4 +1,12 @@
 package config
 
+// RottenTomatoes configures the Tomatometer and audience score, read from the public Rotten Tomatoes pages
 type RottenTomatoes struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+func DefaultRottenTomatoes() *RottenTomatoes {
+	return &RottenTomatoes{
+		Enabled: false,
+	}
 }
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/zepollabot/media-rating-overlay/internal/scheduler"
+)
+
+// boundedAddedAtRegex matches the added_at filters bounding the items by a point in time, relative
+// (e.g. last_30_days) or absolute (e.g. after_2025-01-02T03:04:05Z), which an incremental run can narrow
+var boundedAddedAtRegex = regexp.MustCompile(`^(last_\d+_(days|months|years)|after_\S+)$`)
+
+// Scheduler configures the daemon mode, which processes the libraries on a cron schedule.
+// Libraries can override the schedule with their own
+type Scheduler struct {
+	Schedule string `yaml:"schedule"`
+	// RunOnStart processes the libraries as soon as the daemon starts, then on schedule
+	RunOnStart bool `yaml:"run_on_start"`
+	// Incremental limits the runs after the first one to the items added since
+	// the last successful run of the library
+	Incremental bool `yaml:"incremental"`
+}
+
+func DefaultScheduler() *Scheduler {
+	return &Scheduler{
+		Schedule:    "0 3 * * *",
+		RunOnStart:  false,
+		Incremental: false,
+	}
+}
+
+// Validate validates the Scheduler configuration
+func (c *Scheduler) Validate() error {
+	if c.Schedule == "" {
+		return fmt.Errorf("scheduler.schedule is required")
+	}
+	if _, err := scheduler.Parse(c.Schedule); err != nil {
+		return fmt.Errorf("scheduler.schedule: %w", err)
+	}
+	return nil
+}
+
+// ValidateLibrary validates the library against the Scheduler configuration: an incremental run
+// keeps the later of the added_at filter of the library and its last successful run, so the filter
+// must be a point in time
+func (c *Scheduler) ValidateLibrary(library Library) error {
+	if c.Incremental && library.Filters.AddedAt != "" && !boundedAddedAtRegex.MatchString(library.Filters.AddedAt) {
+		return fmt.Errorf("library %s added_at %q cannot be combined with scheduler.incremental", library.Name, library.Filters.AddedAt)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SchedulerTestSuite struct {
+	suite.Suite
+}
+
+func TestSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerTestSuite))
+}
+
+func (s *SchedulerTestSuite) TestDefaultScheduler() {
+	cfg := DefaultScheduler()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Schedule should be daily at 3 by default", func(t *testing.T) {
+		assert.Equal(t, "0 3 * * *", cfg.Schedule)
+	})
+	s.T().Run("RunOnStart and Incremental should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.RunOnStart)
+		assert.False(t, cfg.Incremental)
+	})
+}
+
+func (s *SchedulerTestSuite) TestScheduler_Validate() {
+	s.T().Run("Valid default config should pass", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Empty schedule should fail", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		cfg.Schedule = ""
+		assert.EqualError(t, cfg.Validate(), "scheduler.schedule is required")
+	})
+
+	s.T().Run("Invalid schedule should fail", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		cfg.Schedule = "every day"
+		assert.ErrorContains(t, cfg.Validate(), "scheduler.schedule: invalid cron expression")
+	})
+
+	s.T().Run("Never matching schedule should fail", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		cfg.Schedule = "0 0 31 2 *"
+		assert.ErrorContains(t, cfg.Validate(), "it never matches")
+	})
+}
+
+func (s *SchedulerTestSuite) TestLibrary_Validate() {
+	s.T().Run("No schedule should pass", func(t *testing.T) {
+		cfg := Library{Name: "Film"}
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Valid schedule should pass", func(t *testing.T) {
+		cfg := Library{Name: "Film", Schedule: "@hourly"}
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Invalid schedule should fail", func(t *testing.T) {
+		cfg := Library{Name: "Film", Schedule: "0 25 * * *"}
+		assert.ErrorContains(t, cfg.Validate(), "library Film schedule: invalid cron expression")
+	})
+}
+
+func (s *SchedulerTestSuite) TestScheduler_ValidateLibrary() {
+	s.T().Run("Any added_at should pass when not incremental", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		assert.NoError(t, cfg.ValidateLibrary(Library{Name: "Film", Filters: Filter{AddedAt: "recently"}}))
+	})
+
+	s.T().Run("No added_at should pass when incremental", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		cfg.Incremental = true
+		assert.NoError(t, cfg.ValidateLibrary(Library{Name: "Film"}))
+	})
+
+	s.T().Run("Relative added_at should pass when incremental", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		cfg.Incremental = true
+		assert.NoError(t, cfg.ValidateLibrary(Library{Name: "Film", Filters: Filter{AddedAt: "last_30_days"}}))
+	})
+
+	s.T().Run("Absolute added_at should pass when incremental", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		cfg.Incremental = true
+		assert.NoError(t, cfg.ValidateLibrary(Library{Name: "Film", Filters: Filter{AddedAt: "after_2025-01-02T03:04:05Z"}}))
+	})
+
+	s.T().Run("Unknown added_at should fail when incremental", func(t *testing.T) {
+		cfg := DefaultScheduler()
+		cfg.Incremental = true
+		assert.EqualError(t, cfg.ValidateLibrary(Library{Name: "Film", Filters: Filter{AddedAt: "recently"}}),
+			`library Film added_at "recently" cannot be combined with scheduler.incremental`)
+	})
+}
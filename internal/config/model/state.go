@@ -0,0 +1,25 @@
+package config
+
+import "fmt"
+
+// State configures the store remembering how each poster was generated,
+// so that items whose inputs did not change are skipped on the next run
+type State struct {
+	Enabled  bool   `yaml:"enabled"`
+	FilePath string `yaml:"file_path"`
+}
+
+func DefaultState() *State {
+	return &State{
+		Enabled:  false,
+		FilePath: "state/media-rating-overlay.json",
+	}
+}
+
+// Validate validates the State configuration
+func (c *State) Validate() error {
+	if c.Enabled && c.FilePath == "" {
+		return fmt.Errorf("state.file_path is required when state is enabled")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type StateTestSuite struct {
+	suite.Suite
+}
+
+func TestStateTestSuite(t *testing.T) {
+	suite.Run(t, new(StateTestSuite))
+}
+
+func (s *StateTestSuite) TestDefaultState() {
+	cfg := DefaultState()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+	s.T().Run("FilePath should be set by default", func(t *testing.T) {
+		assert.Equal(t, "state/media-rating-overlay.json", cfg.FilePath)
+	})
+}
+
+func (s *StateTestSuite) TestState_Validate() {
+	s.T().Run("Valid default config (disabled) should pass", func(t *testing.T) {
+		cfg := DefaultState()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with empty file path should fail", func(t *testing.T) {
+		cfg := DefaultState()
+		cfg.Enabled = true
+		cfg.FilePath = ""
+		err := cfg.Validate()
+		assert.EqualError(t, err, "state.file_path is required when state is enabled")
+	})
+
+	s.T().Run("Enabled with file path should pass", func(t *testing.T) {
+		cfg := DefaultState()
+		cfg.Enabled = true
+		assert.NoError(t, cfg.Validate())
+	})
+}
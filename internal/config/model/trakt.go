@@ -0,0 +1,25 @@
+package config
+
+import "fmt"
+
+// Trakt configures the Trakt API, providing the Trakt community rating
+type Trakt struct {
+	Enabled  bool   `yaml:"enabled"`
+	ClientID string `yaml:"client_id"`
+}
+
+func DefaultTrakt() *Trakt {
+	return &Trakt{
+		Enabled: false,
+	}
+}
+
+// Validate validates the Trakt configuration
+func (c *Trakt) Validate() error {
+	if c.Enabled {
+		if c.ClientID == "" {
+			return fmt.Errorf("trakt.client_id is required when trakt is enabled")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TraktTestSuite struct {
+	suite.Suite
+}
+
+func TestTraktTestSuite(t *testing.T) {
+	suite.Run(t, new(TraktTestSuite))
+}
+
+func (s *TraktTestSuite) TestDefaultTrakt() {
+	cfg := DefaultTrakt()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+}
+
+func (s *TraktTestSuite) TestTrakt_Validate() {
+	s.T().Run("Valid default config should pass", func(t *testing.T) {
+		cfg := DefaultTrakt()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with client ID should pass", func(t *testing.T) {
+		cfg := Trakt{Enabled: true, ClientID: "trakt-client-id"}
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled without client ID should fail", func(t *testing.T) {
+		cfg := Trakt{Enabled: true}
+		assert.EqualError(t, cfg.Validate(), "trakt.client_id is required when trakt is enabled")
+	})
+}
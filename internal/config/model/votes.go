@@ -0,0 +1,55 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Low-confidence rating modes
+const (
+	LowConfidenceHide = "hide"
+	LowConfidenceDim  = "dim"
+)
+
+// Votes configures the minimum number of votes a rating needs to be trusted
+type Votes struct {
+	// MinVotes is the minimum number of votes of the ratings, by rating service name, case insensitive.
+	// Ratings with fewer votes are low-confidence, ratings with an unknown number of votes never are,
+	// such as the ratings read from the media server
+	MinVotes map[string]int `yaml:"min_votes"`
+	// LowConfidence tells whether the low-confidence ratings are hidden, or drawn dimmed
+	LowConfidence string `yaml:"low_confidence"`
+}
+
+func DefaultVotes() *Votes {
+	return &Votes{
+		LowConfidence: LowConfidenceHide,
+	}
+}
+
+// MinVotesOf returns the minimum number of votes of the ratings of the given service, zero when not set
+func (c *Votes) MinVotesOf(name string) int {
+	for source, minVotes := range c.MinVotes {
+		if strings.EqualFold(source, name) {
+			return minVotes
+		}
+	}
+	return 0
+}
+
+// Validate validates the Votes configuration
+func (c *Votes) Validate() error {
+	for source, minVotes := range c.MinVotes {
+		if !slices.ContainsFunc(ratingSourceNames, func(name string) bool { return strings.EqualFold(name, source) }) {
+			return fmt.Errorf("unknown rating source %q in votes.min_votes, expected one of %s", source, strings.Join(ratingSourceNames, ", "))
+		}
+		if minVotes < 0 {
+			return fmt.Errorf("votes.min_votes of %s must not be negative", source)
+		}
+	}
+	if c.LowConfidence != LowConfidenceHide && c.LowConfidence != LowConfidenceDim {
+		return fmt.Errorf("votes.low_confidence must be %s or %s", LowConfidenceHide, LowConfidenceDim)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type VotesTestSuite struct {
+	suite.Suite
+}
+
+func TestVotesTestSuite(t *testing.T) {
+	suite.Run(t, new(VotesTestSuite))
+}
+
+func (s *VotesTestSuite) TestDefaultVotes() {
+	cfg := DefaultVotes()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("MinVotes should be empty by default", func(t *testing.T) {
+		assert.Empty(t, cfg.MinVotes)
+	})
+	s.T().Run("LowConfidence should be hide by default", func(t *testing.T) {
+		assert.Equal(t, LowConfidenceHide, cfg.LowConfidence)
+	})
+}
+
+func (s *VotesTestSuite) TestMinVotesOf() {
+	cfg := &Votes{MinVotes: map[string]int{"tmdb": 50, "IMDB": 1000}}
+
+	s.T().Run("Should match the name case insensitively", func(t *testing.T) {
+		assert.Equal(t, 50, cfg.MinVotesOf("TMDB"))
+		assert.Equal(t, 1000, cfg.MinVotesOf("IMDB"))
+	})
+	s.T().Run("Should be zero when not set", func(t *testing.T) {
+		assert.Zero(t, cfg.MinVotesOf("Trakt"))
+	})
+}
+
+func (s *VotesTestSuite) TestValidate() {
+	s.T().Run("Default config should pass", func(t *testing.T) {
+		assert.NoError(t, DefaultVotes().Validate())
+	})
+	s.T().Run("Valid config should pass", func(t *testing.T) {
+		cfg := &Votes{MinVotes: map[string]int{"TMDB": 50, "myanimelist": 100}, LowConfidence: LowConfidenceDim}
+		assert.NoError(t, cfg.Validate())
+	})
+	s.T().Run("Unknown source should fail", func(t *testing.T) {
+		cfg := &Votes{MinVotes: map[string]int{"MDBList": 50}, LowConfidence: LowConfidenceHide}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown rating source "MDBList" in votes.min_votes`)
+	})
+	s.T().Run("Negative minimum should fail", func(t *testing.T) {
+		cfg := &Votes{MinVotes: map[string]int{"TMDB": -1}, LowConfidence: LowConfidenceHide}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "votes.min_votes of TMDB must not be negative")
+	})
+	s.T().Run("Unknown mode should fail", func(t *testing.T) {
+		cfg := &Votes{LowConfidence: "blur"}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "votes.low_confidence must be hide or dim")
+	})
+}
@@ -0,0 +1,28 @@
+package config
+
+import "fmt"
+
+// Webhook configures the HTTP listener receiving the media server webhooks in daemon mode,
+// so that newly added items are processed right away instead of on the next scheduled run
+type Webhook struct {
+	Enabled bool   `yaml:"enabled"`
+	Address string `yaml:"address"`
+	// Token, when set, must be passed as the token query parameter of the webhook URL
+	Token string `yaml:"token"`
+}
+
+func DefaultWebhook() *Webhook {
+	return &Webhook{
+		Enabled: false,
+		Address: ":8085",
+		Token:   "",
+	}
+}
+
+// Validate validates the Webhook configuration
+func (c *Webhook) Validate() error {
+	if c.Enabled && c.Address == "" {
+		return fmt.Errorf("webhook.address is required when webhook is enabled")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookTestSuite struct {
+	suite.Suite
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
+
+func (s *WebhookTestSuite) TestDefaultWebhook() {
+	cfg := DefaultWebhook()
+
+	s.T().Run("Should return non-nil config", func(t *testing.T) {
+		assert.NotNil(t, cfg)
+	})
+	s.T().Run("Enabled should be false by default", func(t *testing.T) {
+		assert.False(t, cfg.Enabled)
+	})
+	s.T().Run("Address should listen on port 8085 by default", func(t *testing.T) {
+		assert.Equal(t, ":8085", cfg.Address)
+	})
+}
+
+func (s *WebhookTestSuite) TestWebhook_Validate() {
+	s.T().Run("Valid default config should pass", func(t *testing.T) {
+		cfg := DefaultWebhook()
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled with address should pass", func(t *testing.T) {
+		cfg := DefaultWebhook()
+		cfg.Enabled = true
+		assert.NoError(t, cfg.Validate())
+	})
+
+	s.T().Run("Enabled without address should fail", func(t *testing.T) {
+		cfg := DefaultWebhook()
+		cfg.Enabled = true
+		cfg.Address = ""
+		assert.EqualError(t, cfg.Validate(), "webhook.address is required when webhook is enabled")
+	})
+
+	s.T().Run("Disabled without address should pass", func(t *testing.T) {
+		cfg := Webhook{Enabled: false}
+		assert.NoError(t, cfg.Validate())
+	})
+}
@@ -23,15 +23,39 @@ func (b *ConfigBuilder) WithDefaults() *ConfigBuilder {
 	b.config.Plex = config.Plex{
 		Enabled: false,
 	}
+	b.config.Jellyfin = config.Jellyfin{
+		Enabled: false,
+	}
+	b.config.Emby = config.Emby{
+		Enabled: false,
+	}
+	b.config.Kodi = config.Kodi{
+		Enabled: false,
+	}
+	b.config.Local = config.Local{
+		Enabled: false,
+	}
 	b.config.TMDB = config.TMDB{
 		Enabled:  false,
 		Language: "en-US",
 		Region:   "US",
 	}
+	b.config.OMDb = *config.DefaultOMDb()
+	b.config.Trakt = *config.DefaultTrakt()
+	b.config.Letterboxd = *config.DefaultLetterboxd()
+	b.config.RottenTomatoes = *config.DefaultRottenTomatoes()
+	b.config.MDBList = *config.DefaultMDBList()
+	b.config.Votes = *config.DefaultVotes()
+	b.config.AniList = *config.DefaultAniList()
+	b.config.MyAnimeList = *config.DefaultMyAnimeList()
+	b.config.IMDB = *config.DefaultIMDB()
 	b.config.Performance = *config.DefaultPerformance()
 	b.config.HTTPClient = *config.DefaultHTTPClient()
 	b.config.Logger = *config.DefaultLogger()
 	b.config.Processor = *config.DefaultProcessorConfig()
+	b.config.State = *config.DefaultState()
+	b.config.Scheduler = *config.DefaultScheduler()
+	b.config.Webhook = *config.DefaultWebhook()
 	return b
 }
 
@@ -41,6 +65,84 @@ func (b *ConfigBuilder) WithPlex(plex config.Plex) *ConfigBuilder {
 	return b
 }
 
+// WithJellyfin sets Jellyfin configuration
+func (b *ConfigBuilder) WithJellyfin(jellyfin config.Jellyfin) *ConfigBuilder {
+	b.config.Jellyfin = jellyfin
+	return b
+}
+
+// WithEmby sets Emby configuration
+func (b *ConfigBuilder) WithEmby(emby config.Emby) *ConfigBuilder {
+	b.config.Emby = emby
+	return b
+}
+
+// WithKodi sets Kodi configuration
+func (b *ConfigBuilder) WithKodi(kodi config.Kodi) *ConfigBuilder {
+	b.config.Kodi = kodi
+	return b
+}
+
+// WithLocal sets local folder configuration
+func (b *ConfigBuilder) WithLocal(local config.Local) *ConfigBuilder {
+	b.config.Local = local
+	return b
+}
+
+// WithOMDb sets OMDb configuration
+func (b *ConfigBuilder) WithOMDb(omdb config.OMDb) *ConfigBuilder {
+	b.config.OMDb = omdb
+	return b
+}
+
+// WithIMDB sets the IMDb dataset configuration
+func (b *ConfigBuilder) WithIMDB(imdb config.IMDB) *ConfigBuilder {
+	b.config.IMDB = imdb
+	return b
+}
+
+// WithTrakt sets Trakt configuration
+func (b *ConfigBuilder) WithTrakt(trakt config.Trakt) *ConfigBuilder {
+	b.config.Trakt = trakt
+	return b
+}
+
+// WithLetterboxd sets Letterboxd configuration
+func (b *ConfigBuilder) WithLetterboxd(letterboxd config.Letterboxd) *ConfigBuilder {
+	b.config.Letterboxd = letterboxd
+	return b
+}
+
+// WithRottenTomatoes sets Rotten Tomatoes configuration
+func (b *ConfigBuilder) WithRottenTomatoes(rottenTomatoes config.RottenTomatoes) *ConfigBuilder {
+	b.config.RottenTomatoes = rottenTomatoes
+	return b
+}
+
+// WithAniList sets AniList configuration
+func (b *ConfigBuilder) WithAniList(aniList config.AniList) *ConfigBuilder {
+	b.config.AniList = aniList
+	return b
+}
+
+// WithMyAnimeList sets MyAnimeList configuration
+func (b *ConfigBuilder) WithMyAnimeList(myAnimeList config.MyAnimeList) *ConfigBuilder {
+	b.config.MyAnimeList = myAnimeList
+	return b
+}
+
+// WithVotes sets the minimum votes configuration of the ratings
+func (b *ConfigBuilder) WithVotes(votes config.Votes) *ConfigBuilder {
+	b.config.Votes = votes
+	return b
+}
+
+// WithMDBList sets MDBList configuration
+func (b *ConfigBuilder) WithMDBList(mdblist config.MDBList) *ConfigBuilder {
+	b.config.MDBList = mdblist
+	return b
+}
+
 // WithTMDB sets TMDB configuration
 func (b *ConfigBuilder) WithTMDB(tmdb config.TMDB) *ConfigBuilder {
 	b.config.TMDB = tmdb
@@ -71,6 +173,24 @@ func (b *ConfigBuilder) WithProcessor(processor config.ProcessorConfig) *ConfigB
 	return b
 }
 
+// WithState sets the processing state configuration
+func (b *ConfigBuilder) WithState(state config.State) *ConfigBuilder {
+	b.config.State = state
+	return b
+}
+
+// WithScheduler sets the daemon mode scheduler configuration
+func (b *ConfigBuilder) WithScheduler(scheduler config.Scheduler) *ConfigBuilder {
+	b.config.Scheduler = scheduler
+	return b
+}
+
+// WithWebhook sets the webhook listener configuration
+func (b *ConfigBuilder) WithWebhook(webhook config.Webhook) *ConfigBuilder {
+	b.config.Webhook = webhook
+	return b
+}
+
 // Build validates and returns the configuration
 func (b *ConfigBuilder) Build() (*config.Config, error) {
 	if err := b.validate(); err != nil {
@@ -88,6 +208,44 @@ func (b *ConfigBuilder) validate() error {
 		if b.config.Plex.Token == "" {
 			return fmt.Errorf("plex.token is required when plex is enabled")
 		}
+		if b.config.Plex.Upload.Enabled && b.config.Plex.Upload.PosterDir == "" {
+			return fmt.Errorf("plex.upload.poster_dir is required when plex.upload is enabled")
+		}
+	}
+
+	if b.config.Jellyfin.Enabled {
+		if b.config.Jellyfin.Url == "" {
+			return fmt.Errorf("jellyfin.url is required when jellyfin is enabled")
+		}
+		if b.config.Jellyfin.ApiKey == "" {
+			return fmt.Errorf("jellyfin.api_key is required when jellyfin is enabled")
+		}
+	}
+
+	if b.config.Emby.Enabled {
+		if b.config.Emby.Url == "" {
+			return fmt.Errorf("emby.url is required when emby is enabled")
+		}
+		if b.config.Emby.ApiKey == "" {
+			return fmt.Errorf("emby.api_key is required when emby is enabled")
+		}
+	}
+
+	if b.config.Kodi.Enabled {
+		if b.config.Kodi.Url == "" {
+			return fmt.Errorf("kodi.url is required when kodi is enabled")
+		}
+		if b.config.Kodi.Password != "" && b.config.Kodi.Username == "" {
+			return fmt.Errorf("kodi.username is required when kodi.password is set")
+		}
+	}
+
+	if b.config.Local.Enabled {
+		for i, library := range b.config.Local.Libraries {
+			if library.Path == "" {
+				return fmt.Errorf("local.libraries[%d].path is required when local is enabled", i)
+			}
+		}
 	}
 
 	if b.config.TMDB.Enabled {
@@ -96,6 +254,26 @@ func (b *ConfigBuilder) validate() error {
 		}
 	}
 
+	if err := b.config.OMDb.Validate(); err != nil {
+		return err
+	}
+
+	if err := b.config.IMDB.Validate(); err != nil {
+		return err
+	}
+
+	if err := b.config.Trakt.Validate(); err != nil {
+		return err
+	}
+
+	if err := b.config.MDBList.Validate(); err != nil {
+		return err
+	}
+
+	if err := b.config.MyAnimeList.Validate(); err != nil {
+		return err
+	}
+
 	if b.config.Performance.MaxThreads < 0 {
 		return fmt.Errorf("performance.max_threads must be non-negative")
 	}
@@ -117,5 +295,27 @@ func (b *ConfigBuilder) validate() error {
 		return fmt.Errorf("processor.library_processor.default_timeout must be positive")
 	}
 
+	if b.config.State.Enabled && b.config.State.FilePath == "" {
+		return fmt.Errorf("state.file_path is required when state is enabled")
+	}
+
+	if err := b.config.Scheduler.Validate(); err != nil {
+		return err
+	}
+	if err := b.config.Webhook.Validate(); err != nil {
+		return err
+	}
+	if err := b.config.Votes.Validate(); err != nil {
+		return err
+	}
+	for _, library := range b.config.Libraries() {
+		if err := library.Validate(); err != nil {
+			return err
+		}
+		if err := b.config.Scheduler.ValidateLibrary(library); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
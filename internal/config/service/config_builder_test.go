@@ -50,11 +50,44 @@ func (s *ConfigBuilderTestSuite) TestWithDefaults() {
 	// Plex defaults
 	s.False(cfg.Plex.Enabled, "Plex.Enabled should be false by default")
 
+	// Jellyfin defaults
+	s.False(cfg.Jellyfin.Enabled, "Jellyfin.Enabled should be false by default")
+
+	// Emby defaults
+	s.False(cfg.Emby.Enabled, "Emby.Enabled should be false by default")
+
 	// TMDB defaults
 	s.False(cfg.TMDB.Enabled, "TMDB.Enabled should be false by default")
 	s.Equal("en-US", cfg.TMDB.Language, "TMDB.Language should be 'en-US' by default")
 	s.Equal("US", cfg.TMDB.Region, "TMDB.Region should be 'US' by default")
 
+	// OMDb defaults
+	s.False(cfg.OMDb.Enabled, "OMDb.Enabled should be false by default")
+
+	// IMDB defaults
+	s.False(cfg.IMDB.Enabled, "IMDB.Enabled should be false by default")
+	s.Equal("state/imdb-ratings.gob.gz", cfg.IMDB.DatasetPath, "IMDB.DatasetPath should be set by default")
+
+	// Trakt defaults
+	s.False(cfg.Trakt.Enabled, "Trakt.Enabled should be false by default")
+
+	// Letterboxd defaults
+	s.False(cfg.Letterboxd.Enabled, "Letterboxd.Enabled should be false by default")
+
+	// Rotten Tomatoes defaults
+	s.False(cfg.RottenTomatoes.Enabled, "RottenTomatoes.Enabled should be false by default")
+
+	// AniList and MyAnimeList defaults
+	s.False(cfg.AniList.Enabled, "AniList.Enabled should be false by default")
+	s.False(cfg.MyAnimeList.Enabled, "MyAnimeList.Enabled should be false by default")
+
+	// MDBList defaults
+	s.False(cfg.MDBList.Enabled, "MDBList.Enabled should be false by default")
+
+	// Votes defaults
+	s.Empty(cfg.Votes.MinVotes, "Votes.MinVotes should be empty by default")
+	s.Equal(configModel.LowConfidenceHide, cfg.Votes.LowConfidence, "Votes.LowConfidence should be hide by default")
+
 	// Performance defaults
 	s.Equal(1, cfg.Performance.MaxThreads, "Performance.MaxThreads should be 1 by default (set based on CPU later)")
 	s.Equal(600*time.Second, cfg.Performance.LibraryProcessingTimeout, "Performance.LibraryProcessingTimeout should be 600s by default")
@@ -74,6 +107,18 @@ func (s *ConfigBuilderTestSuite) TestWithDefaults() {
 	s.True(cfg.Logger.UseJSON, "Logger.UseJSON should be true by default")
 	s.True(cfg.Logger.UseStdout, "Logger.UseStdout should be true by default")
 
+	// State defaults
+	s.False(cfg.State.Enabled, "State.Enabled should be false by default")
+	s.Equal("state/media-rating-overlay.json", cfg.State.FilePath, "State.FilePath should be set by default")
+
+	// Scheduler defaults
+	s.Equal("0 3 * * *", cfg.Scheduler.Schedule, "Scheduler.Schedule should be daily at 3 by default")
+	s.False(cfg.Scheduler.Incremental, "Scheduler.Incremental should be false by default")
+
+	// Webhook defaults
+	s.False(cfg.Webhook.Enabled, "Webhook.Enabled should be false by default")
+	s.Equal(":8085", cfg.Webhook.Address, "Webhook.Address should be set by default")
+
 	// Processor defaults
 	s.Equal(30*time.Second, cfg.Processor.ItemProcessor.RatingBuilder.Timeout, "Processor.ItemProcessor.RatingBuilder.Timeout should be 30s by default")
 	s.Equal(600*time.Second, cfg.Processor.LibraryProcessor.DefaultTimeout, "Processor.LibraryProcessor.DefaultTimeout should be 600s by default")
@@ -98,6 +143,170 @@ func (s *ConfigBuilderTestSuite) TestWithPlex() {
 	s.Equal(plexConfig, cfg.Plex)
 }
 
+func (s *ConfigBuilderTestSuite) TestWithJellyfin() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	jellyfinConfig := configModel.Jellyfin{
+		Enabled: true,
+		Url:     "http://localhost:8096",
+		ApiKey:  "test-api-key",
+	}
+
+	// Act
+	s.builder.WithJellyfin(jellyfinConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(jellyfinConfig, cfg.Jellyfin)
+}
+
+func (s *ConfigBuilderTestSuite) TestWithEmby() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	embyConfig := configModel.Emby{
+		Enabled: true,
+		Url:     "http://localhost:8096",
+		ApiKey:  "test-api-key",
+	}
+
+	// Act
+	s.builder.WithEmby(embyConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(embyConfig, cfg.Emby)
+}
+
+func (s *ConfigBuilderTestSuite) TestWithKodi() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	kodiConfig := configModel.Kodi{
+		Enabled:   true,
+		Url:       "http://localhost:8080",
+		Username:  "kodi",
+		Password:  "secret",
+		UpdateArt: true,
+	}
+
+	// Act
+	s.builder.WithKodi(kodiConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(kodiConfig, cfg.Kodi)
+}
+
+func (s *ConfigBuilderTestSuite) TestWithLocal() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	localConfig := configModel.Local{
+		Enabled:   true,
+		Libraries: []configModel.Library{{Name: "Movies", Enabled: true, Path: "/movies"}},
+	}
+
+	// Act
+	s.builder.WithLocal(localConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(localConfig, cfg.Local)
+}
+
+func (s *ConfigBuilderTestSuite) TestWithState() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	stateConfig := configModel.State{Enabled: true, FilePath: "/data/state.json"}
+
+	// Act
+	s.builder.WithState(stateConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(stateConfig, cfg.State)
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_StateEnabledNoFilePath() {
+	// Arrange
+	s.builder.WithDefaults().WithState(configModel.State{Enabled: true})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for state enabled with no file path")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "state.file_path is required when state is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestWithScheduler() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	schedulerConfig := configModel.Scheduler{Schedule: "*/30 * * * *", RunOnStart: true, Incremental: true}
+
+	// Act
+	s.builder.WithScheduler(schedulerConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(schedulerConfig, cfg.Scheduler)
+}
+
+func (s *ConfigBuilderTestSuite) TestWithWebhook() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	webhookConfig := configModel.Webhook{Enabled: true, Address: ":9000", Token: "secret"}
+
+	// Act
+	s.builder.WithWebhook(webhookConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(webhookConfig, cfg.Webhook)
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_WebhookEnabledNoAddress() {
+	// Arrange
+	s.builder.WithDefaults().WithWebhook(configModel.Webhook{Enabled: true})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for webhook enabled with no address")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "webhook.address is required when webhook is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_InvalidLibrarySchedule() {
+	// Arrange
+	s.builder.WithDefaults().WithLocal(configModel.Local{
+		Enabled:   true,
+		Libraries: []configModel.Library{{Name: "Film", Path: "/film", Schedule: "0 3 * *"}},
+	})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for an invalid library schedule")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "library Film schedule", "Error message mismatch")
+}
+
 func (s *ConfigBuilderTestSuite) TestWithTMDB() {
 	// Arrange
 	s.builder.WithDefaults() // Apply defaults first
@@ -244,6 +453,318 @@ func (s *ConfigBuilderTestSuite) TestBuild_PlexEnabledNoToken() {
 	s.Contains(err.Error(), "plex.token is required when plex is enabled", "Error message mismatch")
 }
 
+func (s *ConfigBuilderTestSuite) TestBuild_PlexUploadNoPosterDir() {
+	// Arrange
+	s.builder.WithDefaults().WithPlex(configModel.Plex{
+		Enabled: true,
+		Url:     "http://plex.local",
+		Token:   "some-token",
+		Upload:  configModel.PlexUpload{Enabled: true},
+	})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for Plex upload with no poster dir")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "plex.upload.poster_dir is required when plex.upload is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_JellyfinEnabledNoUrl() {
+	// Arrange
+	s.builder.WithDefaults().WithJellyfin(configModel.Jellyfin{Enabled: true, ApiKey: "some-key"})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for Jellyfin enabled with no URL")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "jellyfin.url is required when jellyfin is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_JellyfinEnabledNoApiKey() {
+	// Arrange
+	s.builder.WithDefaults().WithJellyfin(configModel.Jellyfin{Enabled: true, Url: "http://jellyfin.local"})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for Jellyfin enabled with no API key")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "jellyfin.api_key is required when jellyfin is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_EmbyEnabledNoUrl() {
+	// Arrange
+	s.builder.WithDefaults().WithEmby(configModel.Emby{Enabled: true, ApiKey: "some-key"})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for Emby enabled with no URL")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "emby.url is required when emby is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_EmbyEnabledNoApiKey() {
+	// Arrange
+	s.builder.WithDefaults().WithEmby(configModel.Emby{Enabled: true, Url: "http://emby.local"})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for Emby enabled with no API key")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "emby.api_key is required when emby is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_KodiEnabledNoUrl() {
+	// Arrange
+	s.builder.WithDefaults().WithKodi(configModel.Kodi{Enabled: true})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for Kodi enabled with no URL")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "kodi.url is required when kodi is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_LocalEnabledLibraryNoPath() {
+	// Arrange
+	s.builder.WithDefaults().WithLocal(configModel.Local{
+		Enabled:   true,
+		Libraries: []configModel.Library{{Name: "Movies"}},
+	})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for a local library with no path")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "local.libraries[0].path is required when local is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestWithOMDb() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	omdbConfig := configModel.OMDb{Enabled: true, ApiKey: "omdb-key"}
+
+	// Act
+	s.builder.WithOMDb(omdbConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(omdbConfig, cfg.OMDb)
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_OMDbEnabledNoApiKey() {
+	// Arrange
+	s.builder.WithDefaults().WithOMDb(configModel.OMDb{Enabled: true})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for OMDb enabled with no API key")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "omdb.api_key is required when omdb is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestWithIMDB() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	imdbConfig := configModel.IMDB{Enabled: true, DatasetPath: "/data/imdb.gob.gz"}
+
+	// Act
+	s.builder.WithIMDB(imdbConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(imdbConfig, cfg.IMDB)
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_IMDBEnabledNoDatasetPath() {
+	// Arrange
+	s.builder.WithDefaults().WithIMDB(configModel.IMDB{Enabled: true})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for IMDB enabled with no dataset path")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "imdb.dataset_path is required when imdb is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestWithTrakt() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	traktConfig := configModel.Trakt{Enabled: true, ClientID: "trakt-client-id"}
+
+	// Act
+	s.builder.WithTrakt(traktConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(traktConfig, cfg.Trakt)
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_TraktEnabledNoClientID() {
+	// Arrange
+	s.builder.WithDefaults().WithTrakt(configModel.Trakt{Enabled: true})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for Trakt enabled with no client ID")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "trakt.client_id is required when trakt is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestWithLetterboxd() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	letterboxdConfig := configModel.Letterboxd{Enabled: true}
+
+	// Act
+	s.builder.WithLetterboxd(letterboxdConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(letterboxdConfig, cfg.Letterboxd)
+}
+
+func (s *ConfigBuilderTestSuite) TestWithRottenTomatoes() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	rottenTomatoesConfig := configModel.RottenTomatoes{Enabled: true}
+
+	// Act
+	s.builder.WithRottenTomatoes(rottenTomatoesConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(rottenTomatoesConfig, cfg.RottenTomatoes)
+}
+
+func (s *ConfigBuilderTestSuite) TestWithAniList() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	aniListConfig := configModel.AniList{Enabled: true, Libraries: []string{"Anime"}}
+
+	// Act
+	s.builder.WithAniList(aniListConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(aniListConfig, cfg.AniList)
+}
+
+func (s *ConfigBuilderTestSuite) TestWithMyAnimeList() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	myAnimeListConfig := configModel.MyAnimeList{Enabled: true, ClientID: "mal-client-id", Libraries: []string{"Anime"}}
+
+	// Act
+	s.builder.WithMyAnimeList(myAnimeListConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(myAnimeListConfig, cfg.MyAnimeList)
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_MyAnimeListEnabledNoClientID() {
+	// Arrange
+	s.builder.WithDefaults().WithMyAnimeList(configModel.MyAnimeList{Enabled: true})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for MyAnimeList enabled with no client ID")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "myanimelist.client_id is required when myanimelist is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestWithMDBList() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	mdblistConfig := configModel.MDBList{Enabled: true, ApiKey: "mdblist-api-key"}
+
+	// Act
+	s.builder.WithMDBList(mdblistConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(mdblistConfig, cfg.MDBList)
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_MDBListEnabledNoApiKey() {
+	// Arrange
+	s.builder.WithDefaults().WithMDBList(configModel.MDBList{Enabled: true})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for MDBList enabled with no API key")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), "mdblist.api_key is required when mdblist is enabled", "Error message mismatch")
+}
+
+func (s *ConfigBuilderTestSuite) TestWithVotes() {
+	// Arrange
+	s.builder.WithDefaults() // Apply defaults first
+	votesConfig := configModel.Votes{MinVotes: map[string]int{"TMDB": 50}, LowConfidence: configModel.LowConfidenceDim}
+
+	// Act
+	s.builder.WithVotes(votesConfig)
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(cfg)
+	s.Equal(votesConfig, cfg.Votes)
+}
+
+func (s *ConfigBuilderTestSuite) TestBuild_VotesUnknownSource() {
+	// Arrange
+	s.builder.WithDefaults().WithVotes(configModel.Votes{MinVotes: map[string]int{"Unknown": 50}, LowConfidence: configModel.LowConfidenceHide})
+
+	// Act
+	cfg, err := s.builder.Build()
+
+	// Assert
+	s.Error(err, "Build() should return an error for an unknown rating source")
+	s.Nil(cfg, "Config should be nil on validation error")
+	s.Contains(err.Error(), `unknown rating source "Unknown" in votes.min_votes`, "Error message mismatch")
+}
+
 func (s *ConfigBuilderTestSuite) TestBuild_TMDBEnabledNoApiKey() {
 	// Arrange
 	s.builder.WithDefaults().WithTMDB(configModel.TMDB{Enabled: true})
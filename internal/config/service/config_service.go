@@ -112,6 +112,53 @@ func (c *ConfigService) mergeConfigs(base, env *models.Config) (*models.Config,
 		if env.Plex.Token != "" { // Only override if env token is non-empty
 			merged.Plex.Token = env.Plex.Token
 		}
+		if env.Plex.Upload.Enabled {
+			merged.Plex.Upload = env.Plex.Upload
+		}
+	}
+
+	// Jellyfin
+	if env.Jellyfin.Enabled {
+		merged.Jellyfin.Enabled = true
+		if env.Jellyfin.Url != "" {
+			merged.Jellyfin.Url = env.Jellyfin.Url
+		}
+		if env.Jellyfin.ApiKey != "" {
+			merged.Jellyfin.ApiKey = env.Jellyfin.ApiKey
+		}
+	}
+
+	// Emby
+	if env.Emby.Enabled {
+		merged.Emby.Enabled = true
+		if env.Emby.Url != "" {
+			merged.Emby.Url = env.Emby.Url
+		}
+		if env.Emby.ApiKey != "" {
+			merged.Emby.ApiKey = env.Emby.ApiKey
+		}
+	}
+
+	// Kodi
+	if env.Kodi.Enabled {
+		merged.Kodi.Enabled = true
+		if env.Kodi.Url != "" {
+			merged.Kodi.Url = env.Kodi.Url
+		}
+		if env.Kodi.Username != "" {
+			merged.Kodi.Username = env.Kodi.Username
+		}
+		if env.Kodi.Password != "" {
+			merged.Kodi.Password = env.Kodi.Password
+		}
+	}
+
+	// Local
+	if env.Local.Enabled {
+		merged.Local.Enabled = true
+		if len(env.Local.Libraries) > 0 {
+			merged.Local.Libraries = env.Local.Libraries
+		}
 	}
 
 	// TMDB
@@ -122,6 +169,75 @@ func (c *ConfigService) mergeConfigs(base, env *models.Config) (*models.Config,
 		}
 	}
 
+	// OMDb
+	if env.OMDb.Enabled { // Gate
+		merged.OMDb.Enabled = true
+		if env.OMDb.ApiKey != "" {
+			merged.OMDb.ApiKey = env.OMDb.ApiKey
+		}
+	}
+
+	// Trakt
+	if env.Trakt.Enabled { // Gate
+		merged.Trakt.Enabled = true
+		if env.Trakt.ClientID != "" {
+			merged.Trakt.ClientID = env.Trakt.ClientID
+		}
+	}
+
+	// Letterboxd
+	if env.Letterboxd.Enabled {
+		merged.Letterboxd.Enabled = true
+	}
+
+	// Rotten Tomatoes
+	if env.RottenTomatoes.Enabled {
+		merged.RottenTomatoes.Enabled = true
+	}
+
+	// IMDB
+	if env.IMDB.Enabled { // Gate
+		merged.IMDB.Enabled = true
+		if env.IMDB.DatasetPath != "" {
+			merged.IMDB.DatasetPath = env.IMDB.DatasetPath
+		}
+	}
+
+	// AniList
+	if env.AniList.Enabled { // Gate
+		merged.AniList.Enabled = true
+		if len(env.AniList.Libraries) > 0 {
+			merged.AniList.Libraries = env.AniList.Libraries
+		}
+	}
+
+	// MyAnimeList
+	if env.MyAnimeList.Enabled { // Gate
+		merged.MyAnimeList.Enabled = true
+		if env.MyAnimeList.ClientID != "" {
+			merged.MyAnimeList.ClientID = env.MyAnimeList.ClientID
+		}
+		if len(env.MyAnimeList.Libraries) > 0 {
+			merged.MyAnimeList.Libraries = env.MyAnimeList.Libraries
+		}
+	}
+
+	// MDBList
+	if env.MDBList.Enabled { // Gate
+		merged.MDBList.Enabled = true
+		if env.MDBList.ApiKey != "" {
+			merged.MDBList.ApiKey = env.MDBList.ApiKey
+		}
+	}
+
+	// Votes
+	if len(env.Votes.MinVotes) > 0 {
+		merged.Votes.MinVotes = env.Votes.MinVotes
+	}
+	if env.Votes.LowConfidence != models.DefaultVotes().LowConfidence {
+		merged.Votes.LowConfidence = env.Votes.LowConfidence
+	}
+
 	// Performance
 	if env.Performance.MaxThreads != 0 || env.Performance.LibraryProcessingTimeout != 0 {
 		if env.Performance.MaxThreads != 0 {
@@ -137,6 +253,24 @@ func (c *ConfigService) mergeConfigs(base, env *models.Config) (*models.Config,
 		merged.HTTPClient.Timeout = env.HTTPClient.Timeout
 	}
 
+	// State
+	if env.State.Enabled {
+		merged.State.Enabled = true
+		if env.State.FilePath != "" {
+			merged.State.FilePath = env.State.FilePath
+		}
+	}
+
+	// Scheduler, loaded with its defaults when not set
+	if env.Scheduler != *models.DefaultScheduler() {
+		merged.Scheduler = env.Scheduler
+	}
+
+	// Webhook
+	if env.Webhook.Enabled {
+		merged.Webhook = env.Webhook
+	}
+
 	// Logger
 	if env.Logger.LogFilePath != "" || env.Logger.LogLevel != "" {
 		if env.Logger.LogFilePath != "" {
@@ -189,9 +323,54 @@ func (c *ConfigService) loadFromFile(path string) (*models.Config, error) {
 	if config.Plex.Enabled {
 		builder.WithPlex(config.Plex)
 	}
+	if config.Jellyfin.Enabled {
+		builder.WithJellyfin(config.Jellyfin)
+	}
+	if config.Emby.Enabled {
+		builder.WithEmby(config.Emby)
+	}
+	if config.Kodi.Enabled {
+		builder.WithKodi(config.Kodi)
+	}
+	if config.Local.Enabled {
+		builder.WithLocal(config.Local)
+	}
 	if config.TMDB.Enabled {
 		builder.WithTMDB(config.TMDB)
 	}
+	if config.OMDb.Enabled {
+		builder.WithOMDb(config.OMDb)
+	}
+	if config.Trakt.Enabled {
+		builder.WithTrakt(config.Trakt)
+	}
+	if config.Letterboxd.Enabled {
+		builder.WithLetterboxd(config.Letterboxd)
+	}
+	if config.RottenTomatoes.Enabled {
+		builder.WithRottenTomatoes(config.RottenTomatoes)
+	}
+	if config.AniList.Enabled {
+		builder.WithAniList(config.AniList)
+	}
+	if config.MyAnimeList.Enabled {
+		builder.WithMyAnimeList(config.MyAnimeList)
+	}
+	if config.MDBList.Enabled {
+		builder.WithMDBList(config.MDBList)
+	}
+	if len(config.Votes.MinVotes) > 0 || config.Votes.LowConfidence != "" {
+		if config.Votes.LowConfidence == "" {
+			config.Votes.LowConfidence = models.DefaultVotes().LowConfidence
+		}
+		builder.WithVotes(config.Votes)
+	}
+	if config.IMDB.Enabled {
+		if config.IMDB.DatasetPath == "" {
+			config.IMDB.DatasetPath = models.DefaultIMDB().DatasetPath
+		}
+		builder.WithIMDB(config.IMDB)
+	}
 	if config.Performance.MaxThreads != 0 {
 		builder.WithPerformance(config.Performance)
 	}
@@ -206,6 +385,21 @@ func (c *ConfigService) loadFromFile(path string) (*models.Config, error) {
 		config.Processor.LibraryProcessor.DefaultTimeout > 0 {
 		builder.WithProcessor(config.Processor)
 	}
+	if config.State.Enabled {
+		builder.WithState(config.State)
+	}
+	if config.Scheduler != (models.Scheduler{}) {
+		if config.Scheduler.Schedule == "" {
+			config.Scheduler.Schedule = models.DefaultScheduler().Schedule
+		}
+		builder.WithScheduler(config.Scheduler)
+	}
+	if config.Webhook.Enabled {
+		if config.Webhook.Address == "" {
+			config.Webhook.Address = models.DefaultWebhook().Address
+		}
+		builder.WithWebhook(config.Webhook)
+	}
 
 	return builder.Build()
 }
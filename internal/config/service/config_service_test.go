@@ -105,6 +105,46 @@ func (s *ConfigServiceTestSuite) TestLoad_WithBaseAndEnvFiles() {
 			UseJSON:        true,
 			UseStdout:      true,
 		},
+		Scheduler: configModels.Scheduler{
+			Schedule:    "*/30 * * * *",
+			Incremental: true,
+		},
+		OMDb: configModels.OMDb{
+			Enabled: true,
+			ApiKey:  "env-omdb-key",
+		},
+		Trakt: configModels.Trakt{
+			Enabled:  true,
+			ClientID: "env-trakt-client-id",
+		},
+		Letterboxd: configModels.Letterboxd{
+			Enabled: true,
+		},
+		RottenTomatoes: configModels.RottenTomatoes{
+			Enabled: true,
+		},
+		AniList: configModels.AniList{
+			Enabled:   true,
+			Libraries: []string{"Anime"},
+		},
+		MyAnimeList: configModels.MyAnimeList{
+			Enabled:  true,
+			ClientID: "env-mal-client-id",
+		},
+		MDBList: configModels.MDBList{
+			Enabled: true,
+			ApiKey:  "env-mdblist-key",
+		},
+		IMDB: configModels.IMDB{
+			Enabled: true,
+		},
+		Webhook: configModels.Webhook{
+			Enabled: true,
+			Token:   "env-webhook-token",
+		},
+		Votes: configModels.Votes{
+			MinVotes: map[string]int{"TMDB": 50},
+		},
 		// TMDB is not specified in env config: expecting it to be taken from baseContent.
 		// Performance is not specified: expecting it from baseContent.
 		// HTTPClient is not specified: expecting it from baseContent (as base had Timeout > 0)
@@ -151,7 +191,48 @@ func (s *ConfigServiceTestSuite) TestLoad_WithBaseAndEnvFiles() {
 	s.Equal(baseContent.Processor.ItemProcessor.RatingBuilder.Timeout, loadedConfig.Processor.ItemProcessor.RatingBuilder.Timeout, "Processor.ItemProcessor.RatingBuilder.Timeout should be from base")
 	s.Equal(baseContent.Processor.LibraryProcessor.DefaultTimeout, loadedConfig.Processor.LibraryProcessor.DefaultTimeout, "Processor.LibraryProcessor.DefaultTimeout should be from base")
 
-	// 7. Environment specific rules validation (debug logging is allowed for non-PROD env "unittest")
+	// 7. Scheduler: Should be taken from env.
+	s.Equal("*/30 * * * *", loadedConfig.Scheduler.Schedule, "Scheduler.Schedule should be from env")
+	s.True(loadedConfig.Scheduler.Incremental, "Scheduler.Incremental should be from env")
+
+	// 8. Webhook: Should be taken from env, with the default address.
+	s.True(loadedConfig.Webhook.Enabled, "Webhook.Enabled should be from env")
+	s.Equal(":8085", loadedConfig.Webhook.Address, "Webhook.Address should be the default one")
+	s.Equal("env-webhook-token", loadedConfig.Webhook.Token, "Webhook.Token should be from env")
+
+	// 9. OMDb: Should be taken from env.
+	s.True(loadedConfig.OMDb.Enabled, "OMDb.Enabled should be from env")
+	s.Equal("env-omdb-key", loadedConfig.OMDb.ApiKey, "OMDb.ApiKey should be from env")
+
+	// 10. Trakt: Should be taken from env.
+	s.True(loadedConfig.Trakt.Enabled, "Trakt.Enabled should be from env")
+	s.Equal("env-trakt-client-id", loadedConfig.Trakt.ClientID, "Trakt.ClientID should be from env")
+
+	// 11. Letterboxd: Should be enabled from env.
+	s.True(loadedConfig.Letterboxd.Enabled, "Letterboxd.Enabled should be from env")
+
+	// 12. MDBList: Should be taken from env.
+	s.True(loadedConfig.MDBList.Enabled, "MDBList.Enabled should be from env")
+	s.Equal("env-mdblist-key", loadedConfig.MDBList.ApiKey, "MDBList.ApiKey should be from env")
+
+	// 13. IMDB: Should be enabled from env, with the default dataset path.
+	s.True(loadedConfig.IMDB.Enabled, "IMDB.Enabled should be from env")
+	s.Equal("state/imdb-ratings.gob.gz", loadedConfig.IMDB.DatasetPath, "IMDB.DatasetPath should be the default one")
+
+	// 14. Rotten Tomatoes: Should be enabled from env.
+	s.True(loadedConfig.RottenTomatoes.Enabled, "RottenTomatoes.Enabled should be from env")
+
+	// 15. AniList and MyAnimeList: Should be taken from env.
+	s.True(loadedConfig.AniList.Enabled, "AniList.Enabled should be from env")
+	s.Equal([]string{"Anime"}, loadedConfig.AniList.Libraries, "AniList.Libraries should be from env")
+	s.True(loadedConfig.MyAnimeList.Enabled, "MyAnimeList.Enabled should be from env")
+	s.Equal("env-mal-client-id", loadedConfig.MyAnimeList.ClientID, "MyAnimeList.ClientID should be from env")
+
+	// 16. Votes: Minimum votes from env, low confidence mode defaulted.
+	s.Equal(map[string]int{"TMDB": 50}, loadedConfig.Votes.MinVotes, "Votes.MinVotes should be from env")
+	s.Equal(configModels.LowConfidenceHide, loadedConfig.Votes.LowConfidence, "Votes.LowConfidence should be the default one")
+
+	// 17. Environment specific rules validation (debug logging is allowed for non-PROD env "unittest")
 	// This is implicitly checked by `s.Require().NoError(err)` above, as `validateEnvironmentSpecificRules`
 	// would return an error if "debug" was not allowed for `testEnvironment`.
 }
@@ -10,9 +10,13 @@ import (
 	service "github.com/zepollabot/media-rating-overlay/internal/config/service"
 )
 
-// LoadConfig loads the application configuration
-func LoadConfig(env string) (*config.Config, error) {
-	config := service.NewConfigService(service.DefaultEnvConfigDir, service.DefaultConfigFileName, service.DefaultEnvConfigFilePattern, env)
+// LoadConfig loads the application configuration from configDir,
+// falling back to the default config dir when it is empty
+func LoadConfig(configDir string, env string) (*config.Config, error) {
+	if configDir == "" {
+		configDir = service.DefaultEnvConfigDir
+	}
+	config := service.NewConfigService(configDir, service.DefaultConfigFileName, service.DefaultEnvConfigFilePattern, env)
 	appConfig, err := config.Load()
 	if err != nil {
 		return nil, fmt.Errorf("error loading configuration: %w", err)
@@ -5,12 +5,16 @@ const (
 	MediaServicePlex     = "plex"
 	MediaServiceKodi     = "kodi"
 	MediaServiceJellyfin = "jellyfin"
+	MediaServiceEmby     = "emby"
+	MediaServiceLocal    = "local"
 )
 
 // Media types
 const (
-	MediaTypeMovie = "movie"
-	MediaTypeShow  = "show"
+	MediaTypeMovie   = "movie"
+	MediaTypeShow    = "show"
+	MediaTypeSeason  = "season"
+	MediaTypeEpisode = "episode"
 )
 
 // Media file types
@@ -5,6 +5,12 @@ const (
 	RatingServiceRottenTomatoes = "Rotten Tomatoes"
 	RatingServiceIMDB           = "IMDB"
 	RatingServiceTMDB           = "TMDB"
+	RatingServiceMetacritic     = "Metacritic"
+	RatingServiceTrakt          = "Trakt"
+	RatingServiceLetterboxd     = "Letterboxd"
+	RatingServiceMDBList        = "MDBList"
+	RatingServiceAniList        = "AniList"
+	RatingServiceMyAnimeList    = "MyAnimeList"
 )
 
 // Rating service types
@@ -2,25 +2,38 @@ package factory
 
 import (
 	"fmt"
+	"os"
 
 	"go.uber.org/zap"
 
 	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
 	"github.com/zepollabot/media-rating-overlay/internal/media-service"
 	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	embyPoster "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/emby/poster"
+	jellyfinPoster "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/jellyfin/poster"
+	kodiPoster "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/poster"
+	localPoster "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/local/poster"
 	plexPoster "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/poster"
+	"github.com/zepollabot/media-rating-overlay/internal/ports"
 	"github.com/zepollabot/media-rating-overlay/internal/processor/file"
 )
 
 type MediaServiceBaseFactory interface {
 	BuildPlexComponents() (media.MediaClient, media.LibraryService, media.ItemService, error)
-	GetLibraries() []config.Library
+	BuildJellyfinComponents() (media.MediaClient, media.LibraryService, media.ItemService, error)
+	BuildEmbyComponents() (media.MediaClient, media.LibraryService, media.ItemService, error)
+	BuildKodiComponents() (media.MediaClient, media.LibraryService, media.ItemService, error)
+	BuildLocalComponents() (media.LibraryService, media.ItemService, error)
+	GetLibraries(serviceName string) []config.Library
+	GetKodiUpdateArt() bool
+	GetPlexUpload() config.PlexUpload
 }
 
 // MediaServiceFactory composes all components together
 type MediaServiceModelFactory struct {
-	baseFactory MediaServiceBaseFactory
-	logger      *zap.Logger
+	baseFactory   MediaServiceBaseFactory
+	logger        *zap.Logger
+	posterStorage ports.PosterStorage
 }
 
 func NewMediaServiceModelFactory(
@@ -33,10 +46,24 @@ func NewMediaServiceModelFactory(
 	}
 }
 
+// SetPosterStorage makes the poster services use the given storage instead of
+// writing next to the media files, e.g. to keep the libraries untouched on a dry run
+func (f *MediaServiceModelFactory) SetPosterStorage(posterStorage ports.PosterStorage) {
+	f.posterStorage = posterStorage
+}
+
 func (f *MediaServiceModelFactory) Create(serviceName string) (mediaModel.MediaService, error) {
 	switch serviceName {
 	case mediaModel.MediaServicePlex:
 		return f.buildPlexMediaService()
+	case mediaModel.MediaServiceJellyfin:
+		return f.buildJellyfinMediaService()
+	case mediaModel.MediaServiceEmby:
+		return f.buildEmbyMediaService()
+	case mediaModel.MediaServiceKodi:
+		return f.buildKodiMediaService()
+	case mediaModel.MediaServiceLocal:
+		return f.buildLocalMediaService()
 	default:
 		return mediaModel.MediaService{}, fmt.Errorf("unsupported media service: %s", serviceName)
 	}
@@ -50,18 +77,130 @@ func (f *MediaServiceModelFactory) buildPlexMediaService() (mediaModel.MediaServ
 	}
 
 	// Create processor-specific components
-	fileManager := file.NewFileManager(f.logger)
+	fileManager := f.newPosterStorage()
+
+	// Posters are kept out of the library when uploading them
+	upload := f.baseFactory.GetPlexUpload()
+	if upload.Enabled {
+		if err := os.MkdirAll(upload.PosterDir, 0775); err != nil {
+			f.logger.Error("unable to create Plex poster dir", zap.String("posterDir", upload.PosterDir), zap.Error(err))
+			return mediaModel.MediaService{}, err
+		}
+	}
 
 	// Create the poster service with the file manager
-	posterService := plexPoster.NewPlexPosterService(plexClient, f.logger, fileManager)
+	posterService := plexPoster.NewPlexPosterService(plexClient, f.logger, fileManager, upload)
 
 	f.logger.Info("Plex media service initialized")
 	return mediaModel.MediaService{
 		Name:           mediaModel.MediaServicePlex,
-		Libraries:      f.baseFactory.GetLibraries(),
+		Libraries:      f.baseFactory.GetLibraries(mediaModel.MediaServicePlex),
 		Client:         plexClient,
 		LibraryService: libraryService,
 		ItemService:    itemService,
 		PosterService:  posterService,
 	}, nil
 }
+
+func (f *MediaServiceModelFactory) buildJellyfinMediaService() (mediaModel.MediaService, error) {
+	// Get base components from the base factory
+	jellyfinClient, libraryService, itemService, err := f.baseFactory.BuildJellyfinComponents()
+	if err != nil {
+		return mediaModel.MediaService{}, err
+	}
+
+	// Create processor-specific components
+	fileManager := f.newPosterStorage()
+
+	// Create the poster service with the file manager
+	posterService := jellyfinPoster.NewJellyfinPosterService(jellyfinClient, f.logger, fileManager)
+
+	f.logger.Info("Jellyfin media service initialized")
+	return mediaModel.MediaService{
+		Name:           mediaModel.MediaServiceJellyfin,
+		Libraries:      f.baseFactory.GetLibraries(mediaModel.MediaServiceJellyfin),
+		Client:         jellyfinClient,
+		LibraryService: libraryService,
+		ItemService:    itemService,
+		PosterService:  posterService,
+	}, nil
+}
+
+func (f *MediaServiceModelFactory) buildEmbyMediaService() (mediaModel.MediaService, error) {
+	// Get base components from the base factory
+	embyClient, libraryService, itemService, err := f.baseFactory.BuildEmbyComponents()
+	if err != nil {
+		return mediaModel.MediaService{}, err
+	}
+
+	// Create processor-specific components
+	fileManager := f.newPosterStorage()
+
+	// Create the poster service with the file manager
+	posterService := embyPoster.NewEmbyPosterService(embyClient, f.logger, fileManager)
+
+	f.logger.Info("Emby media service initialized")
+	return mediaModel.MediaService{
+		Name:           mediaModel.MediaServiceEmby,
+		Libraries:      f.baseFactory.GetLibraries(mediaModel.MediaServiceEmby),
+		Client:         embyClient,
+		LibraryService: libraryService,
+		ItemService:    itemService,
+		PosterService:  posterService,
+	}, nil
+}
+
+func (f *MediaServiceModelFactory) buildKodiMediaService() (mediaModel.MediaService, error) {
+	// Get base components from the base factory
+	kodiClient, libraryService, itemService, err := f.baseFactory.BuildKodiComponents()
+	if err != nil {
+		return mediaModel.MediaService{}, err
+	}
+
+	// Create processor-specific components
+	fileManager := f.newPosterStorage()
+
+	// Create the poster service with the file manager
+	posterService := kodiPoster.NewKodiPosterService(kodiClient, f.logger, fileManager, f.baseFactory.GetKodiUpdateArt())
+
+	f.logger.Info("Kodi media service initialized")
+	return mediaModel.MediaService{
+		Name:           mediaModel.MediaServiceKodi,
+		Libraries:      f.baseFactory.GetLibraries(mediaModel.MediaServiceKodi),
+		Client:         kodiClient,
+		LibraryService: libraryService,
+		ItemService:    itemService,
+		PosterService:  posterService,
+	}, nil
+}
+
+func (f *MediaServiceModelFactory) buildLocalMediaService() (mediaModel.MediaService, error) {
+	// Get base components from the base factory
+	libraryService, itemService, err := f.baseFactory.BuildLocalComponents()
+	if err != nil {
+		return mediaModel.MediaService{}, err
+	}
+
+	// Create processor-specific components
+	fileManager := f.newPosterStorage()
+
+	// Create the poster service with the file manager
+	posterService := localPoster.NewLocalPosterService(f.logger, fileManager)
+
+	f.logger.Info("Local media service initialized")
+	return mediaModel.MediaService{
+		Name:           mediaModel.MediaServiceLocal,
+		Libraries:      f.baseFactory.GetLibraries(mediaModel.MediaServiceLocal),
+		LibraryService: libraryService,
+		ItemService:    itemService,
+		PosterService:  posterService,
+	}, nil
+}
+
+func (f *MediaServiceModelFactory) newPosterStorage() ports.PosterStorage {
+	if f.posterStorage != nil {
+		return f.posterStorage
+	}
+
+	return file.NewFileManager(f.logger)
+}
@@ -1,7 +1,10 @@
 package factory
 
 import (
+	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -11,6 +14,8 @@ import (
 	factory_mocks "github.com/zepollabot/media-rating-overlay/internal/factory/mocks"
 	media_service_mocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
 	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	ports_mocks "github.com/zepollabot/media-rating-overlay/internal/ports/mocks"
 )
 
 type MediaServiceModelFactorySuite struct {
@@ -54,7 +59,8 @@ func (s *MediaServiceModelFactorySuite) TestCreate_PlexSuccess() {
 	expectedLibraries := []config.Library{{Name: "Movies", Path: "/movies"}}
 
 	s.mockBaseFactory.On("BuildPlexComponents").Return(mockPlexClient, mockLibraryService, mockItemService, nil).Once()
-	s.mockBaseFactory.On("GetLibraries").Return(expectedLibraries).Once()
+	s.mockBaseFactory.On("GetLibraries", mediaModel.MediaServicePlex).Return(expectedLibraries).Once()
+	s.mockBaseFactory.On("GetPlexUpload").Return(config.PlexUpload{}).Once()
 
 	// Act
 	mediaService, err := s.factory.Create(mediaModel.MediaServicePlex)
@@ -84,6 +90,205 @@ func (s *MediaServiceModelFactorySuite) TestCreate_PlexBuildError() {
 	s.Equal(mediaModel.MediaService{}, mediaService, "MediaService should be empty on error")
 }
 
+func (s *MediaServiceModelFactorySuite) TestCreate_PlexUploadCreatesPosterDir() {
+	// Arrange
+	mockPlexClient := media_service_mocks.NewMediaClient(s.T())
+	mockLibraryService := media_service_mocks.NewLibraryService(s.T())
+	mockItemService := media_service_mocks.NewItemService(s.T())
+	posterDir := filepath.Join(s.T().TempDir(), "posters")
+
+	s.mockBaseFactory.On("BuildPlexComponents").Return(mockPlexClient, mockLibraryService, mockItemService, nil).Once()
+	s.mockBaseFactory.On("GetLibraries", mediaModel.MediaServicePlex).Return([]config.Library{}).Once()
+	s.mockBaseFactory.On("GetPlexUpload").Return(config.PlexUpload{Enabled: true, PosterDir: posterDir}).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServicePlex)
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(mediaService.PosterService)
+	s.DirExists(posterDir)
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_PlexUploadPosterDirError() {
+	// Arrange
+	mockPlexClient := media_service_mocks.NewMediaClient(s.T())
+	mockLibraryService := media_service_mocks.NewLibraryService(s.T())
+	mockItemService := media_service_mocks.NewItemService(s.T())
+	notADir := filepath.Join(s.T().TempDir(), "file")
+	s.Require().NoError(os.WriteFile(notADir, []byte{}, 0644))
+
+	s.mockBaseFactory.On("BuildPlexComponents").Return(mockPlexClient, mockLibraryService, mockItemService, nil).Once()
+	s.mockBaseFactory.On("GetPlexUpload").Return(config.PlexUpload{Enabled: true, PosterDir: filepath.Join(notADir, "posters")}).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServicePlex)
+
+	// Assert
+	s.Error(err)
+	s.Equal(mediaModel.MediaService{}, mediaService)
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_JellyfinSuccess() {
+	// Arrange
+	mockJellyfinClient := media_service_mocks.NewMediaClient(s.T())
+	mockLibraryService := media_service_mocks.NewLibraryService(s.T())
+	mockItemService := media_service_mocks.NewItemService(s.T())
+	expectedLibraries := []config.Library{{Name: "Movies", Path: "/movies"}}
+
+	s.mockBaseFactory.On("BuildJellyfinComponents").Return(mockJellyfinClient, mockLibraryService, mockItemService, nil).Once()
+	s.mockBaseFactory.On("GetLibraries", mediaModel.MediaServiceJellyfin).Return(expectedLibraries).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceJellyfin)
+
+	// Assert
+	s.NoError(err, "Should not return an error for Jellyfin service")
+	s.Equal(mediaModel.MediaServiceJellyfin, mediaService.Name, "MediaService name should be Jellyfin")
+	s.Equal(expectedLibraries, mediaService.Libraries, "Libraries should match")
+	s.NotNil(mediaService.Client, "Client should not be nil")
+	s.NotNil(mediaService.LibraryService, "LibraryService should not be nil")
+	s.NotNil(mediaService.ItemService, "ItemService should not be nil")
+	s.NotNil(mediaService.PosterService, "PosterService should not be nil")
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_JellyfinBuildError() {
+	// Arrange
+	expectedError := errors.New("jellyfin build error")
+	s.mockBaseFactory.On("BuildJellyfinComponents").Return(nil, nil, nil, expectedError).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceJellyfin)
+
+	// Assert
+	s.Error(err, "Should return an error when BuildJellyfinComponents fails")
+	s.Equal(expectedError, err, "Error should be the one returned by BuildJellyfinComponents")
+	s.Equal(mediaModel.MediaService{}, mediaService, "MediaService should be empty on error")
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_EmbySuccess() {
+	// Arrange
+	mockEmbyClient := media_service_mocks.NewMediaClient(s.T())
+	mockLibraryService := media_service_mocks.NewLibraryService(s.T())
+	mockItemService := media_service_mocks.NewItemService(s.T())
+	expectedLibraries := []config.Library{{Name: "Movies", Path: "/movies"}}
+
+	s.mockBaseFactory.On("BuildEmbyComponents").Return(mockEmbyClient, mockLibraryService, mockItemService, nil).Once()
+	s.mockBaseFactory.On("GetLibraries", mediaModel.MediaServiceEmby).Return(expectedLibraries).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceEmby)
+
+	// Assert
+	s.NoError(err, "Should not return an error for Emby service")
+	s.Equal(mediaModel.MediaServiceEmby, mediaService.Name, "MediaService name should be Emby")
+	s.Equal(expectedLibraries, mediaService.Libraries, "Libraries should match")
+	s.NotNil(mediaService.Client, "Client should not be nil")
+	s.NotNil(mediaService.PosterService, "PosterService should not be nil")
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_EmbyBuildError() {
+	// Arrange
+	expectedError := errors.New("emby build error")
+	s.mockBaseFactory.On("BuildEmbyComponents").Return(nil, nil, nil, expectedError).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceEmby)
+
+	// Assert
+	s.Equal(expectedError, err, "Error should be the one returned by BuildEmbyComponents")
+	s.Equal(mediaModel.MediaService{}, mediaService, "MediaService should be empty on error")
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_KodiSuccess() {
+	// Arrange
+	mockKodiClient := media_service_mocks.NewMediaClient(s.T())
+	mockLibraryService := media_service_mocks.NewLibraryService(s.T())
+	mockItemService := media_service_mocks.NewItemService(s.T())
+	expectedLibraries := []config.Library{{Name: "Movies", Path: "/movies"}}
+
+	s.mockBaseFactory.On("BuildKodiComponents").Return(mockKodiClient, mockLibraryService, mockItemService, nil).Once()
+	s.mockBaseFactory.On("GetKodiUpdateArt").Return(true).Once()
+	s.mockBaseFactory.On("GetLibraries", mediaModel.MediaServiceKodi).Return(expectedLibraries).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceKodi)
+
+	// Assert
+	s.NoError(err, "Should not return an error for Kodi service")
+	s.Equal(mediaModel.MediaServiceKodi, mediaService.Name, "MediaService name should be Kodi")
+	s.Equal(expectedLibraries, mediaService.Libraries, "Libraries should match")
+	s.NotNil(mediaService.Client, "Client should not be nil")
+	s.NotNil(mediaService.PosterService, "PosterService should not be nil")
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_KodiBuildError() {
+	// Arrange
+	expectedError := errors.New("kodi build error")
+	s.mockBaseFactory.On("BuildKodiComponents").Return(nil, nil, nil, expectedError).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceKodi)
+
+	// Assert
+	s.Equal(expectedError, err, "Error should be the one returned by BuildKodiComponents")
+	s.Equal(mediaModel.MediaService{}, mediaService, "MediaService should be empty on error")
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_LocalSuccess() {
+	// Arrange
+	mockLibraryService := media_service_mocks.NewLibraryService(s.T())
+	mockItemService := media_service_mocks.NewItemService(s.T())
+	expectedLibraries := []config.Library{{Name: "Movies", Path: "/movies"}}
+
+	s.mockBaseFactory.On("BuildLocalComponents").Return(mockLibraryService, mockItemService, nil).Once()
+	s.mockBaseFactory.On("GetLibraries", mediaModel.MediaServiceLocal).Return(expectedLibraries).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceLocal)
+
+	// Assert
+	s.NoError(err, "Should not return an error for local service")
+	s.Equal(mediaModel.MediaServiceLocal, mediaService.Name, "MediaService name should be local")
+	s.Equal(expectedLibraries, mediaService.Libraries, "Libraries should match")
+	s.Nil(mediaService.Client, "Client should be nil, there is no media server")
+	s.NotNil(mediaService.PosterService, "PosterService should not be nil")
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_LocalWithPosterStorage() {
+	// Arrange
+	mockLibraryService := media_service_mocks.NewLibraryService(s.T())
+	mockItemService := media_service_mocks.NewItemService(s.T())
+	mockPosterStorage := ports_mocks.NewPosterStorage(s.T())
+	libraryConfig := &config.Library{Name: "Movies", Path: "/movies"}
+	item := model.Item{Media: []model.Media{{File: []model.File{{Position: "/movies/Movie/Movie.mkv"}}}}}
+
+	s.mockBaseFactory.On("BuildLocalComponents").Return(mockLibraryService, mockItemService, nil).Once()
+	s.mockBaseFactory.On("GetLibraries", mediaModel.MediaServiceLocal).Return([]config.Library{*libraryConfig}).Once()
+	mockPosterStorage.On("CheckIfPosterExists", "/movies/Movie/Movie-original.jpeg").Return(true, nil).Once()
+	s.factory.SetPosterStorage(mockPosterStorage)
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceLocal)
+
+	// Assert
+	s.Require().NoError(err)
+	s.NoError(mediaService.PosterService.EnsurePosterExists(context.Background(), item, libraryConfig))
+}
+
+func (s *MediaServiceModelFactorySuite) TestCreate_LocalBuildError() {
+	// Arrange
+	expectedError := errors.New("local build error")
+	s.mockBaseFactory.On("BuildLocalComponents").Return(nil, nil, expectedError).Once()
+
+	// Act
+	mediaService, err := s.factory.Create(mediaModel.MediaServiceLocal)
+
+	// Assert
+	s.Equal(expectedError, err, "Error should be the one returned by BuildLocalComponents")
+	s.Equal(mediaModel.MediaService{}, mediaService, "MediaService should be empty on error")
+}
+
 func (s *MediaServiceModelFactorySuite) TestCreate_UnsupportedService() {
 	// Arrange
 	unsupportedServiceName := "unsupported"
@@ -100,7 +305,7 @@ func (s *MediaServiceModelFactorySuite) TestCreate_UnsupportedService() {
 func (s *MediaServiceModelFactorySuite) TestCreate_PlexSuccess_NilPosterServiceDependencies() {
 	// Arrange
 	// We are testing the factory, not the poster service itself.
-	// The poster service constructor NewPlexPosterService(plexClient, f.logger, fileManager)
+	// The poster service constructor NewPlexPosterService(plexClient, f.logger, fileManager, upload)
 	// can handle nil client for its own internal checks if any, but here we ensure
 	// that the MediaServiceModelFactory correctly passes along what it receives
 	// or constructs. The critical part is that BuildPlexComponents provides the client.
@@ -110,7 +315,8 @@ func (s *MediaServiceModelFactorySuite) TestCreate_PlexSuccess_NilPosterServiceD
 	expectedLibraries := []config.Library{{Name: "TV Shows", Path: "/tv"}}
 
 	s.mockBaseFactory.On("BuildPlexComponents").Return(mockPlexClient, mockLibraryService, mockItemService, nil).Once()
-	s.mockBaseFactory.On("GetLibraries").Return(expectedLibraries).Once()
+	s.mockBaseFactory.On("GetLibraries", mediaModel.MediaServicePlex).Return(expectedLibraries).Once()
+	s.mockBaseFactory.On("GetPlexUpload").Return(config.PlexUpload{}).Once()
 
 	// Act
 	mediaService, err := s.factory.Create(mediaModel.MediaServicePlex)
@@ -23,6 +23,297 @@ func (_m *MediaServiceBaseFactory) EXPECT() *MediaServiceBaseFactory_Expecter {
 	return &MediaServiceBaseFactory_Expecter{mock: &_m.Mock}
 }
 
+// BuildEmbyComponents provides a mock function with no fields
+func (_m *MediaServiceBaseFactory) BuildEmbyComponents() (media.MediaClient, media.LibraryService, media.ItemService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildEmbyComponents")
+	}
+
+	var r0 media.MediaClient
+	var r1 media.LibraryService
+	var r2 media.ItemService
+	var r3 error
+	if rf, ok := ret.Get(0).(func() (media.MediaClient, media.LibraryService, media.ItemService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() media.MediaClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(media.MediaClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() media.LibraryService); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(media.LibraryService)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func() media.ItemService); ok {
+		r2 = rf()
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(media.ItemService)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func() error); ok {
+		r3 = rf()
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MediaServiceBaseFactory_BuildEmbyComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildEmbyComponents'
+type MediaServiceBaseFactory_BuildEmbyComponents_Call struct {
+	*mock.Call
+}
+
+// BuildEmbyComponents is a helper method to define mock.On call
+func (_e *MediaServiceBaseFactory_Expecter) BuildEmbyComponents() *MediaServiceBaseFactory_BuildEmbyComponents_Call {
+	return &MediaServiceBaseFactory_BuildEmbyComponents_Call{Call: _e.mock.On("BuildEmbyComponents")}
+}
+
+func (_c *MediaServiceBaseFactory_BuildEmbyComponents_Call) Run(run func()) *MediaServiceBaseFactory_BuildEmbyComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_BuildEmbyComponents_Call) Return(_a0 media.MediaClient, _a1 media.LibraryService, _a2 media.ItemService, _a3 error) *MediaServiceBaseFactory_BuildEmbyComponents_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_BuildEmbyComponents_Call) RunAndReturn(run func() (media.MediaClient, media.LibraryService, media.ItemService, error)) *MediaServiceBaseFactory_BuildEmbyComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildJellyfinComponents provides a mock function with no fields
+func (_m *MediaServiceBaseFactory) BuildJellyfinComponents() (media.MediaClient, media.LibraryService, media.ItemService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildJellyfinComponents")
+	}
+
+	var r0 media.MediaClient
+	var r1 media.LibraryService
+	var r2 media.ItemService
+	var r3 error
+	if rf, ok := ret.Get(0).(func() (media.MediaClient, media.LibraryService, media.ItemService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() media.MediaClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(media.MediaClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() media.LibraryService); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(media.LibraryService)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func() media.ItemService); ok {
+		r2 = rf()
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(media.ItemService)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func() error); ok {
+		r3 = rf()
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MediaServiceBaseFactory_BuildJellyfinComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildJellyfinComponents'
+type MediaServiceBaseFactory_BuildJellyfinComponents_Call struct {
+	*mock.Call
+}
+
+// BuildJellyfinComponents is a helper method to define mock.On call
+func (_e *MediaServiceBaseFactory_Expecter) BuildJellyfinComponents() *MediaServiceBaseFactory_BuildJellyfinComponents_Call {
+	return &MediaServiceBaseFactory_BuildJellyfinComponents_Call{Call: _e.mock.On("BuildJellyfinComponents")}
+}
+
+func (_c *MediaServiceBaseFactory_BuildJellyfinComponents_Call) Run(run func()) *MediaServiceBaseFactory_BuildJellyfinComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_BuildJellyfinComponents_Call) Return(_a0 media.MediaClient, _a1 media.LibraryService, _a2 media.ItemService, _a3 error) *MediaServiceBaseFactory_BuildJellyfinComponents_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_BuildJellyfinComponents_Call) RunAndReturn(run func() (media.MediaClient, media.LibraryService, media.ItemService, error)) *MediaServiceBaseFactory_BuildJellyfinComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildKodiComponents provides a mock function with no fields
+func (_m *MediaServiceBaseFactory) BuildKodiComponents() (media.MediaClient, media.LibraryService, media.ItemService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildKodiComponents")
+	}
+
+	var r0 media.MediaClient
+	var r1 media.LibraryService
+	var r2 media.ItemService
+	var r3 error
+	if rf, ok := ret.Get(0).(func() (media.MediaClient, media.LibraryService, media.ItemService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() media.MediaClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(media.MediaClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() media.LibraryService); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(media.LibraryService)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func() media.ItemService); ok {
+		r2 = rf()
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(media.ItemService)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func() error); ok {
+		r3 = rf()
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MediaServiceBaseFactory_BuildKodiComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildKodiComponents'
+type MediaServiceBaseFactory_BuildKodiComponents_Call struct {
+	*mock.Call
+}
+
+// BuildKodiComponents is a helper method to define mock.On call
+func (_e *MediaServiceBaseFactory_Expecter) BuildKodiComponents() *MediaServiceBaseFactory_BuildKodiComponents_Call {
+	return &MediaServiceBaseFactory_BuildKodiComponents_Call{Call: _e.mock.On("BuildKodiComponents")}
+}
+
+func (_c *MediaServiceBaseFactory_BuildKodiComponents_Call) Run(run func()) *MediaServiceBaseFactory_BuildKodiComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_BuildKodiComponents_Call) Return(_a0 media.MediaClient, _a1 media.LibraryService, _a2 media.ItemService, _a3 error) *MediaServiceBaseFactory_BuildKodiComponents_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_BuildKodiComponents_Call) RunAndReturn(run func() (media.MediaClient, media.LibraryService, media.ItemService, error)) *MediaServiceBaseFactory_BuildKodiComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildLocalComponents provides a mock function with no fields
+func (_m *MediaServiceBaseFactory) BuildLocalComponents() (media.LibraryService, media.ItemService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildLocalComponents")
+	}
+
+	var r0 media.LibraryService
+	var r1 media.ItemService
+	var r2 error
+	if rf, ok := ret.Get(0).(func() (media.LibraryService, media.ItemService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() media.LibraryService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(media.LibraryService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() media.ItemService); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(media.ItemService)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MediaServiceBaseFactory_BuildLocalComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildLocalComponents'
+type MediaServiceBaseFactory_BuildLocalComponents_Call struct {
+	*mock.Call
+}
+
+// BuildLocalComponents is a helper method to define mock.On call
+func (_e *MediaServiceBaseFactory_Expecter) BuildLocalComponents() *MediaServiceBaseFactory_BuildLocalComponents_Call {
+	return &MediaServiceBaseFactory_BuildLocalComponents_Call{Call: _e.mock.On("BuildLocalComponents")}
+}
+
+func (_c *MediaServiceBaseFactory_BuildLocalComponents_Call) Run(run func()) *MediaServiceBaseFactory_BuildLocalComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_BuildLocalComponents_Call) Return(_a0 media.LibraryService, _a1 media.ItemService, _a2 error) *MediaServiceBaseFactory_BuildLocalComponents_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_BuildLocalComponents_Call) RunAndReturn(run func() (media.LibraryService, media.ItemService, error)) *MediaServiceBaseFactory_BuildLocalComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BuildPlexComponents provides a mock function with no fields
 func (_m *MediaServiceBaseFactory) BuildPlexComponents() (media.MediaClient, media.LibraryService, media.ItemService, error) {
 	ret := _m.Called()
@@ -98,17 +389,62 @@ func (_c *MediaServiceBaseFactory_BuildPlexComponents_Call) RunAndReturn(run fun
 	return _c
 }
 
-// GetLibraries provides a mock function with no fields
-func (_m *MediaServiceBaseFactory) GetLibraries() []config.Library {
+// GetKodiUpdateArt provides a mock function with no fields
+func (_m *MediaServiceBaseFactory) GetKodiUpdateArt() bool {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetKodiUpdateArt")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MediaServiceBaseFactory_GetKodiUpdateArt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKodiUpdateArt'
+type MediaServiceBaseFactory_GetKodiUpdateArt_Call struct {
+	*mock.Call
+}
+
+// GetKodiUpdateArt is a helper method to define mock.On call
+func (_e *MediaServiceBaseFactory_Expecter) GetKodiUpdateArt() *MediaServiceBaseFactory_GetKodiUpdateArt_Call {
+	return &MediaServiceBaseFactory_GetKodiUpdateArt_Call{Call: _e.mock.On("GetKodiUpdateArt")}
+}
+
+func (_c *MediaServiceBaseFactory_GetKodiUpdateArt_Call) Run(run func()) *MediaServiceBaseFactory_GetKodiUpdateArt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_GetKodiUpdateArt_Call) Return(_a0 bool) *MediaServiceBaseFactory_GetKodiUpdateArt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_GetKodiUpdateArt_Call) RunAndReturn(run func() bool) *MediaServiceBaseFactory_GetKodiUpdateArt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLibraries provides a mock function with given fields: serviceName
+func (_m *MediaServiceBaseFactory) GetLibraries(serviceName string) []config.Library {
+	ret := _m.Called(serviceName)
+
 	if len(ret) == 0 {
 		panic("no return value specified for GetLibraries")
 	}
 
 	var r0 []config.Library
-	if rf, ok := ret.Get(0).(func() []config.Library); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) []config.Library); ok {
+		r0 = rf(serviceName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]config.Library)
@@ -124,13 +460,14 @@ type MediaServiceBaseFactory_GetLibraries_Call struct {
 }
 
 // GetLibraries is a helper method to define mock.On call
-func (_e *MediaServiceBaseFactory_Expecter) GetLibraries() *MediaServiceBaseFactory_GetLibraries_Call {
-	return &MediaServiceBaseFactory_GetLibraries_Call{Call: _e.mock.On("GetLibraries")}
+//   - serviceName string
+func (_e *MediaServiceBaseFactory_Expecter) GetLibraries(serviceName interface{}) *MediaServiceBaseFactory_GetLibraries_Call {
+	return &MediaServiceBaseFactory_GetLibraries_Call{Call: _e.mock.On("GetLibraries", serviceName)}
 }
 
-func (_c *MediaServiceBaseFactory_GetLibraries_Call) Run(run func()) *MediaServiceBaseFactory_GetLibraries_Call {
+func (_c *MediaServiceBaseFactory_GetLibraries_Call) Run(run func(serviceName string)) *MediaServiceBaseFactory_GetLibraries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
@@ -140,7 +477,52 @@ func (_c *MediaServiceBaseFactory_GetLibraries_Call) Return(_a0 []config.Library
 	return _c
 }
 
-func (_c *MediaServiceBaseFactory_GetLibraries_Call) RunAndReturn(run func() []config.Library) *MediaServiceBaseFactory_GetLibraries_Call {
+func (_c *MediaServiceBaseFactory_GetLibraries_Call) RunAndReturn(run func(string) []config.Library) *MediaServiceBaseFactory_GetLibraries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlexUpload provides a mock function with no fields
+func (_m *MediaServiceBaseFactory) GetPlexUpload() config.PlexUpload {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlexUpload")
+	}
+
+	var r0 config.PlexUpload
+	if rf, ok := ret.Get(0).(func() config.PlexUpload); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(config.PlexUpload)
+	}
+
+	return r0
+}
+
+// MediaServiceBaseFactory_GetPlexUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlexUpload'
+type MediaServiceBaseFactory_GetPlexUpload_Call struct {
+	*mock.Call
+}
+
+// GetPlexUpload is a helper method to define mock.On call
+func (_e *MediaServiceBaseFactory_Expecter) GetPlexUpload() *MediaServiceBaseFactory_GetPlexUpload_Call {
+	return &MediaServiceBaseFactory_GetPlexUpload_Call{Call: _e.mock.On("GetPlexUpload")}
+}
+
+func (_c *MediaServiceBaseFactory_GetPlexUpload_Call) Run(run func()) *MediaServiceBaseFactory_GetPlexUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_GetPlexUpload_Call) Return(_a0 config.PlexUpload) *MediaServiceBaseFactory_GetPlexUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaServiceBaseFactory_GetPlexUpload_Call) RunAndReturn(run func() config.PlexUpload) *MediaServiceBaseFactory_GetPlexUpload_Call {
 	_c.Call.Return(run)
 	return _c
 }
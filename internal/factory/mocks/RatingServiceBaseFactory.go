@@ -20,6 +20,61 @@ func (_m *RatingServiceBaseFactory) EXPECT() *RatingServiceBaseFactory_Expecter
 	return &RatingServiceBaseFactory_Expecter{mock: &_m.Mock}
 }
 
+// BuildAniListComponents provides a mock function with no fields
+func (_m *RatingServiceBaseFactory) BuildAniListComponents() (model.RatingService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildAniListComponents")
+	}
+
+	var r0 model.RatingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (model.RatingService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() model.RatingService); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.RatingService)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RatingServiceBaseFactory_BuildAniListComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildAniListComponents'
+type RatingServiceBaseFactory_BuildAniListComponents_Call struct {
+	*mock.Call
+}
+
+// BuildAniListComponents is a helper method to define mock.On call
+func (_e *RatingServiceBaseFactory_Expecter) BuildAniListComponents() *RatingServiceBaseFactory_BuildAniListComponents_Call {
+	return &RatingServiceBaseFactory_BuildAniListComponents_Call{Call: _e.mock.On("BuildAniListComponents")}
+}
+
+func (_c *RatingServiceBaseFactory_BuildAniListComponents_Call) Run(run func()) *RatingServiceBaseFactory_BuildAniListComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildAniListComponents_Call) Return(_a0 model.RatingService, _a1 error) *RatingServiceBaseFactory_BuildAniListComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildAniListComponents_Call) RunAndReturn(run func() (model.RatingService, error)) *RatingServiceBaseFactory_BuildAniListComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BuildIMDBComponents provides a mock function with no fields
 func (_m *RatingServiceBaseFactory) BuildIMDBComponents() (model.RatingService, error) {
 	ret := _m.Called()
@@ -75,6 +130,226 @@ func (_c *RatingServiceBaseFactory_BuildIMDBComponents_Call) RunAndReturn(run fu
 	return _c
 }
 
+// BuildLetterboxdComponents provides a mock function with no fields
+func (_m *RatingServiceBaseFactory) BuildLetterboxdComponents() (model.RatingService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildLetterboxdComponents")
+	}
+
+	var r0 model.RatingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (model.RatingService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() model.RatingService); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.RatingService)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RatingServiceBaseFactory_BuildLetterboxdComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildLetterboxdComponents'
+type RatingServiceBaseFactory_BuildLetterboxdComponents_Call struct {
+	*mock.Call
+}
+
+// BuildLetterboxdComponents is a helper method to define mock.On call
+func (_e *RatingServiceBaseFactory_Expecter) BuildLetterboxdComponents() *RatingServiceBaseFactory_BuildLetterboxdComponents_Call {
+	return &RatingServiceBaseFactory_BuildLetterboxdComponents_Call{Call: _e.mock.On("BuildLetterboxdComponents")}
+}
+
+func (_c *RatingServiceBaseFactory_BuildLetterboxdComponents_Call) Run(run func()) *RatingServiceBaseFactory_BuildLetterboxdComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildLetterboxdComponents_Call) Return(_a0 model.RatingService, _a1 error) *RatingServiceBaseFactory_BuildLetterboxdComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildLetterboxdComponents_Call) RunAndReturn(run func() (model.RatingService, error)) *RatingServiceBaseFactory_BuildLetterboxdComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildMDBListComponents provides a mock function with no fields
+func (_m *RatingServiceBaseFactory) BuildMDBListComponents() (model.RatingService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildMDBListComponents")
+	}
+
+	var r0 model.RatingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (model.RatingService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() model.RatingService); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.RatingService)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RatingServiceBaseFactory_BuildMDBListComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildMDBListComponents'
+type RatingServiceBaseFactory_BuildMDBListComponents_Call struct {
+	*mock.Call
+}
+
+// BuildMDBListComponents is a helper method to define mock.On call
+func (_e *RatingServiceBaseFactory_Expecter) BuildMDBListComponents() *RatingServiceBaseFactory_BuildMDBListComponents_Call {
+	return &RatingServiceBaseFactory_BuildMDBListComponents_Call{Call: _e.mock.On("BuildMDBListComponents")}
+}
+
+func (_c *RatingServiceBaseFactory_BuildMDBListComponents_Call) Run(run func()) *RatingServiceBaseFactory_BuildMDBListComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildMDBListComponents_Call) Return(_a0 model.RatingService, _a1 error) *RatingServiceBaseFactory_BuildMDBListComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildMDBListComponents_Call) RunAndReturn(run func() (model.RatingService, error)) *RatingServiceBaseFactory_BuildMDBListComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildMetacriticComponents provides a mock function with no fields
+func (_m *RatingServiceBaseFactory) BuildMetacriticComponents() (model.RatingService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildMetacriticComponents")
+	}
+
+	var r0 model.RatingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (model.RatingService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() model.RatingService); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.RatingService)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RatingServiceBaseFactory_BuildMetacriticComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildMetacriticComponents'
+type RatingServiceBaseFactory_BuildMetacriticComponents_Call struct {
+	*mock.Call
+}
+
+// BuildMetacriticComponents is a helper method to define mock.On call
+func (_e *RatingServiceBaseFactory_Expecter) BuildMetacriticComponents() *RatingServiceBaseFactory_BuildMetacriticComponents_Call {
+	return &RatingServiceBaseFactory_BuildMetacriticComponents_Call{Call: _e.mock.On("BuildMetacriticComponents")}
+}
+
+func (_c *RatingServiceBaseFactory_BuildMetacriticComponents_Call) Run(run func()) *RatingServiceBaseFactory_BuildMetacriticComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildMetacriticComponents_Call) Return(_a0 model.RatingService, _a1 error) *RatingServiceBaseFactory_BuildMetacriticComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildMetacriticComponents_Call) RunAndReturn(run func() (model.RatingService, error)) *RatingServiceBaseFactory_BuildMetacriticComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildMyAnimeListComponents provides a mock function with no fields
+func (_m *RatingServiceBaseFactory) BuildMyAnimeListComponents() (model.RatingService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildMyAnimeListComponents")
+	}
+
+	var r0 model.RatingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (model.RatingService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() model.RatingService); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.RatingService)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RatingServiceBaseFactory_BuildMyAnimeListComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildMyAnimeListComponents'
+type RatingServiceBaseFactory_BuildMyAnimeListComponents_Call struct {
+	*mock.Call
+}
+
+// BuildMyAnimeListComponents is a helper method to define mock.On call
+func (_e *RatingServiceBaseFactory_Expecter) BuildMyAnimeListComponents() *RatingServiceBaseFactory_BuildMyAnimeListComponents_Call {
+	return &RatingServiceBaseFactory_BuildMyAnimeListComponents_Call{Call: _e.mock.On("BuildMyAnimeListComponents")}
+}
+
+func (_c *RatingServiceBaseFactory_BuildMyAnimeListComponents_Call) Run(run func()) *RatingServiceBaseFactory_BuildMyAnimeListComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildMyAnimeListComponents_Call) Return(_a0 model.RatingService, _a1 error) *RatingServiceBaseFactory_BuildMyAnimeListComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildMyAnimeListComponents_Call) RunAndReturn(run func() (model.RatingService, error)) *RatingServiceBaseFactory_BuildMyAnimeListComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BuildRottenTomatoesComponents provides a mock function with no fields
 func (_m *RatingServiceBaseFactory) BuildRottenTomatoesComponents() (model.RatingService, error) {
 	ret := _m.Called()
@@ -185,6 +460,61 @@ func (_c *RatingServiceBaseFactory_BuildTMDBComponents_Call) RunAndReturn(run fu
 	return _c
 }
 
+// BuildTraktComponents provides a mock function with no fields
+func (_m *RatingServiceBaseFactory) BuildTraktComponents() (model.RatingService, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildTraktComponents")
+	}
+
+	var r0 model.RatingService
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (model.RatingService, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() model.RatingService); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.RatingService)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RatingServiceBaseFactory_BuildTraktComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildTraktComponents'
+type RatingServiceBaseFactory_BuildTraktComponents_Call struct {
+	*mock.Call
+}
+
+// BuildTraktComponents is a helper method to define mock.On call
+func (_e *RatingServiceBaseFactory_Expecter) BuildTraktComponents() *RatingServiceBaseFactory_BuildTraktComponents_Call {
+	return &RatingServiceBaseFactory_BuildTraktComponents_Call{Call: _e.mock.On("BuildTraktComponents")}
+}
+
+func (_c *RatingServiceBaseFactory_BuildTraktComponents_Call) Run(run func()) *RatingServiceBaseFactory_BuildTraktComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildTraktComponents_Call) Return(_a0 model.RatingService, _a1 error) *RatingServiceBaseFactory_BuildTraktComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RatingServiceBaseFactory_BuildTraktComponents_Call) RunAndReturn(run func() (model.RatingService, error)) *RatingServiceBaseFactory_BuildTraktComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRatingServiceBaseFactory creates a new instance of RatingServiceBaseFactory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRatingServiceBaseFactory(t interface {
@@ -11,9 +11,14 @@ import (
 	"github.com/zepollabot/media-rating-overlay/internal/processor/text"
 
 	ratingModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/model"
+	logoAnilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/logo"
 	logoIMDB "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/logo"
+	logoLetterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/logo"
+	logoMetacritic "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/metacritic/logo"
+	logoMyanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/logo"
 	logoRottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/logo"
 	logoTmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/logo"
+	logoTrakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/logo"
 )
 
 // RatingServiceBaseFactory interface is defined in this file.
@@ -21,6 +26,12 @@ type RatingServiceBaseFactory interface {
 	BuildTMDBComponents() (ratingModel.RatingService, error)
 	BuildRottenTomatoesComponents() (ratingModel.RatingService, error)
 	BuildIMDBComponents() (ratingModel.RatingService, error)
+	BuildMetacriticComponents() (ratingModel.RatingService, error)
+	BuildTraktComponents() (ratingModel.RatingService, error)
+	BuildLetterboxdComponents() (ratingModel.RatingService, error)
+	BuildAniListComponents() (ratingModel.RatingService, error)
+	BuildMyAnimeListComponents() (ratingModel.RatingService, error)
+	BuildMDBListComponents() (ratingModel.RatingService, error)
 }
 
 type RatingPlatformServiceModelFactory struct {
@@ -52,6 +63,19 @@ func (f *RatingPlatformServiceModelFactory) Create(serviceName string) (ratingMo
 		return f.buildRottenTomatoesRatingService(logoCreator, defaultPosterConfig)
 	case constant.RatingServiceIMDB:
 		return f.buildIMDBRatingService(logoCreator, defaultPosterConfig)
+	case constant.RatingServiceMetacritic:
+		return f.buildMetacriticRatingService(logoCreator, defaultPosterConfig)
+	case constant.RatingServiceTrakt:
+		return f.buildTraktRatingService(logoCreator, defaultPosterConfig)
+	case constant.RatingServiceLetterboxd:
+		return f.buildLetterboxdRatingService(logoCreator, defaultPosterConfig)
+	case constant.RatingServiceAniList:
+		return f.buildAniListRatingService(logoCreator, defaultPosterConfig)
+	case constant.RatingServiceMyAnimeList:
+		return f.buildMyAnimeListRatingService(logoCreator, defaultPosterConfig)
+	case constant.RatingServiceMDBList:
+		// No logo service: the MDBList ratings are drawn by the services of their platforms
+		return f.baseFactory.BuildMDBListComponents()
 	default:
 		return ratingModel.RatingService{}, fmt.Errorf("unsupported rating service: %s", serviceName)
 	}
@@ -98,3 +122,73 @@ func (f *RatingPlatformServiceModelFactory) buildIMDBRatingService(logoCreator *
 
 	return imdbService, nil
 }
+
+func (f *RatingPlatformServiceModelFactory) buildMetacriticRatingService(logoCreator *logo.LogoCreator, defaultPosterConfig *model.PosterConfig) (ratingModel.RatingService, error) {
+	// Get base components
+	metacriticService, err := f.baseFactory.BuildMetacriticComponents()
+	if err != nil {
+		return ratingModel.RatingService{}, err
+	}
+
+	// Add logo service
+	logoService := logoMetacritic.NewMetacriticLogoService(f.logger, defaultPosterConfig, logoCreator)
+	metacriticService.LogoService = logoService
+
+	return metacriticService, nil
+}
+
+func (f *RatingPlatformServiceModelFactory) buildTraktRatingService(logoCreator *logo.LogoCreator, defaultPosterConfig *model.PosterConfig) (ratingModel.RatingService, error) {
+	// Get base components
+	traktService, err := f.baseFactory.BuildTraktComponents()
+	if err != nil {
+		return ratingModel.RatingService{}, err
+	}
+
+	// Add logo service
+	logoService := logoTrakt.NewTraktLogoService(f.logger, defaultPosterConfig, logoCreator)
+	traktService.LogoService = logoService
+
+	return traktService, nil
+}
+
+func (f *RatingPlatformServiceModelFactory) buildLetterboxdRatingService(logoCreator *logo.LogoCreator, defaultPosterConfig *model.PosterConfig) (ratingModel.RatingService, error) {
+	// Get base components
+	letterboxdService, err := f.baseFactory.BuildLetterboxdComponents()
+	if err != nil {
+		return ratingModel.RatingService{}, err
+	}
+
+	// Add logo service
+	logoService := logoLetterboxd.NewLetterboxdLogoService(f.logger, defaultPosterConfig, logoCreator)
+	letterboxdService.LogoService = logoService
+
+	return letterboxdService, nil
+}
+
+func (f *RatingPlatformServiceModelFactory) buildAniListRatingService(logoCreator *logo.LogoCreator, defaultPosterConfig *model.PosterConfig) (ratingModel.RatingService, error) {
+	// Get base components
+	aniListService, err := f.baseFactory.BuildAniListComponents()
+	if err != nil {
+		return ratingModel.RatingService{}, err
+	}
+
+	// Add logo service
+	logoService := logoAnilist.NewAniListLogoService(f.logger, defaultPosterConfig, logoCreator)
+	aniListService.LogoService = logoService
+
+	return aniListService, nil
+}
+
+func (f *RatingPlatformServiceModelFactory) buildMyAnimeListRatingService(logoCreator *logo.LogoCreator, defaultPosterConfig *model.PosterConfig) (ratingModel.RatingService, error) {
+	// Get base components
+	myAnimeListService, err := f.baseFactory.BuildMyAnimeListComponents()
+	if err != nil {
+		return ratingModel.RatingService{}, err
+	}
+
+	// Add logo service
+	logoService := logoMyanimelist.NewMyAnimeListLogoService(f.logger, defaultPosterConfig, logoCreator)
+	myAnimeListService.LogoService = logoService
+
+	return myAnimeListService, nil
+}
@@ -95,6 +95,98 @@ func (s *RatingPlatformServiceModelFactorySuite) TestCreate_IMDBSuccess() {
 	s.NotNil(ratingService.LogoService, "LogoService should be initialized for IMDB")
 }
 
+// TestCreate_MetacriticSuccess verifies that the Metacritic rating service is created correctly.
+func (s *RatingPlatformServiceModelFactorySuite) TestCreate_MetacriticSuccess() {
+	// Arrange
+	baseMetacriticService := rating_service_model.RatingService{Name: constant.RatingServiceMetacritic}
+	s.mockBaseFactory.On("BuildMetacriticComponents").Return(baseMetacriticService, nil).Once()
+
+	// Act
+	ratingService, err := s.factory.Create(constant.RatingServiceMetacritic)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(constant.RatingServiceMetacritic, ratingService.Name, "Service name should be Metacritic")
+	s.NotNil(ratingService.LogoService, "LogoService should be initialized for Metacritic")
+}
+
+// TestCreate_TraktSuccess verifies that the Trakt rating service is created correctly.
+func (s *RatingPlatformServiceModelFactorySuite) TestCreate_TraktSuccess() {
+	// Arrange
+	baseTraktService := rating_service_model.RatingService{Name: constant.RatingServiceTrakt}
+	s.mockBaseFactory.On("BuildTraktComponents").Return(baseTraktService, nil).Once()
+
+	// Act
+	ratingService, err := s.factory.Create(constant.RatingServiceTrakt)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(constant.RatingServiceTrakt, ratingService.Name, "Service name should be Trakt")
+	s.NotNil(ratingService.LogoService, "LogoService should be initialized for Trakt")
+}
+
+// TestCreate_LetterboxdSuccess verifies that the Letterboxd rating service is created correctly.
+func (s *RatingPlatformServiceModelFactorySuite) TestCreate_LetterboxdSuccess() {
+	// Arrange
+	baseLetterboxdService := rating_service_model.RatingService{Name: constant.RatingServiceLetterboxd}
+	s.mockBaseFactory.On("BuildLetterboxdComponents").Return(baseLetterboxdService, nil).Once()
+
+	// Act
+	ratingService, err := s.factory.Create(constant.RatingServiceLetterboxd)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(constant.RatingServiceLetterboxd, ratingService.Name, "Service name should be Letterboxd")
+	s.NotNil(ratingService.LogoService, "LogoService should be initialized for Letterboxd")
+}
+
+// TestCreate_AniListSuccess verifies that the AniList rating service is created correctly.
+func (s *RatingPlatformServiceModelFactorySuite) TestCreate_AniListSuccess() {
+	// Arrange
+	baseAniListService := rating_service_model.RatingService{Name: constant.RatingServiceAniList, Libraries: []string{"Anime"}}
+	s.mockBaseFactory.On("BuildAniListComponents").Return(baseAniListService, nil).Once()
+
+	// Act
+	ratingService, err := s.factory.Create(constant.RatingServiceAniList)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(constant.RatingServiceAniList, ratingService.Name, "Service name should be AniList")
+	s.Equal([]string{"Anime"}, ratingService.Libraries, "Libraries should be kept")
+	s.NotNil(ratingService.LogoService, "LogoService should be initialized for AniList")
+}
+
+// TestCreate_MyAnimeListSuccess verifies that the MyAnimeList rating service is created correctly.
+func (s *RatingPlatformServiceModelFactorySuite) TestCreate_MyAnimeListSuccess() {
+	// Arrange
+	baseMyAnimeListService := rating_service_model.RatingService{Name: constant.RatingServiceMyAnimeList, Libraries: []string{"Anime"}}
+	s.mockBaseFactory.On("BuildMyAnimeListComponents").Return(baseMyAnimeListService, nil).Once()
+
+	// Act
+	ratingService, err := s.factory.Create(constant.RatingServiceMyAnimeList)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(constant.RatingServiceMyAnimeList, ratingService.Name, "Service name should be MyAnimeList")
+	s.Equal([]string{"Anime"}, ratingService.Libraries, "Libraries should be kept")
+	s.NotNil(ratingService.LogoService, "LogoService should be initialized for MyAnimeList")
+}
+
+// TestCreate_MDBListSuccess verifies that the MDBList rating service is created without logo service.
+func (s *RatingPlatformServiceModelFactorySuite) TestCreate_MDBListSuccess() {
+	// Arrange
+	baseMDBListService := rating_service_model.RatingService{Name: constant.RatingServiceMDBList}
+	s.mockBaseFactory.On("BuildMDBListComponents").Return(baseMDBListService, nil).Once()
+
+	// Act
+	ratingService, err := s.factory.Create(constant.RatingServiceMDBList)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(constant.RatingServiceMDBList, ratingService.Name, "Service name should be MDBList")
+	s.Nil(ratingService.LogoService, "LogoService should not be initialized for MDBList")
+}
+
 // TestCreate_TMDBBuildError verifies error handling when TMDB component building fails.
 func (s *RatingPlatformServiceModelFactorySuite) TestCreate_TMDBBuildError() {
 	// Arrange
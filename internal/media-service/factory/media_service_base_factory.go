@@ -7,6 +7,21 @@ import (
 
 	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
 	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediaModel "github.com/zepollabot/media-rating-overlay/internal/media-service/model"
+	embyClient "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/emby/client"
+	embyFilters "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/emby/filter"
+	embyItem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/emby/item"
+	embyLibrary "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/emby/library"
+	jellyfinClient "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/jellyfin/client"
+	jellyfinFilters "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/jellyfin/filter"
+	jellyfinItem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/jellyfin/item"
+	jellyfinLibrary "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/jellyfin/library"
+	kodiClient "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/client"
+	kodiFilters "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/filter"
+	kodiItem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/item"
+	kodiLibrary "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/library"
+	localItem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/local/item"
+	localLibrary "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/local/library"
 	plexClient "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/client"
 	plexFilters "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/filter"
 	plexItem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/item"
@@ -59,6 +74,111 @@ func (f *MediaServiceBaseFactory) BuildPlexComponents() (
 	return plexClient, libraryService, itemService, nil
 }
 
-func (f *MediaServiceBaseFactory) GetLibraries() []config.Library {
-	return f.config.Plex.Libraries
+// BuildJellyfinComponents returns all the Jellyfin-specific components without the poster service
+func (f *MediaServiceBaseFactory) BuildJellyfinComponents() (
+	media.MediaClient,
+	media.LibraryService,
+	media.ItemService,
+	error,
+) {
+	f.logger.Info("Building Jellyfin media service components")
+
+	jellyfinClient, err := jellyfinClient.NewJellyfinClient(&f.config.Jellyfin, &f.config.HTTPClient, f.config.Logger.LogFilePath, f.logger)
+	if err != nil {
+		f.logger.Error("error creating jellyfin client", zap.Error(err))
+		return nil, nil, nil, err
+	}
+
+	libraryService := jellyfinLibrary.NewJellyfinLibraryService(jellyfinClient, f.logger)
+	filtersService := jellyfinFilters.NewJellyfinFiltersService(f.clock)
+	itemService := jellyfinItem.NewJellyfinItemService(jellyfinClient, f.logger, filtersService, f.clock)
+
+	return jellyfinClient, libraryService, itemService, nil
+}
+
+// BuildEmbyComponents returns all the Emby-specific components without the poster service
+func (f *MediaServiceBaseFactory) BuildEmbyComponents() (
+	media.MediaClient,
+	media.LibraryService,
+	media.ItemService,
+	error,
+) {
+	f.logger.Info("Building Emby media service components")
+
+	embyClient, err := embyClient.NewEmbyClient(&f.config.Emby, &f.config.HTTPClient, f.config.Logger.LogFilePath, f.logger)
+	if err != nil {
+		f.logger.Error("error creating emby client", zap.Error(err))
+		return nil, nil, nil, err
+	}
+
+	libraryService := embyLibrary.NewEmbyLibraryService(embyClient, f.logger)
+	filtersService := embyFilters.NewEmbyFiltersService()
+	itemService := embyItem.NewEmbyItemService(embyClient, f.logger, filtersService, f.clock)
+
+	return embyClient, libraryService, itemService, nil
+}
+
+// BuildKodiComponents returns all the Kodi-specific components without the poster service
+func (f *MediaServiceBaseFactory) BuildKodiComponents() (
+	media.MediaClient,
+	media.LibraryService,
+	media.ItemService,
+	error,
+) {
+	f.logger.Info("Building Kodi media service components")
+
+	kodiClient, err := kodiClient.NewKodiClient(&f.config.Kodi, &f.config.HTTPClient, f.config.Logger.LogFilePath, f.logger)
+	if err != nil {
+		f.logger.Error("error creating kodi client", zap.Error(err))
+		return nil, nil, nil, err
+	}
+
+	libraryService := kodiLibrary.NewKodiLibraryService(kodiClient, f.logger)
+	filtersService := kodiFilters.NewKodiFiltersService(f.clock)
+	itemService := kodiItem.NewKodiItemService(kodiClient, f.logger, filtersService)
+
+	return kodiClient, libraryService, itemService, nil
+}
+
+// BuildLocalComponents returns the components reading libraries straight from disk.
+// There is no media server, hence no client.
+func (f *MediaServiceBaseFactory) BuildLocalComponents() (
+	media.LibraryService,
+	media.ItemService,
+	error,
+) {
+	f.logger.Info("Building local media service components")
+
+	libraryService := localLibrary.NewLocalLibraryService(f.config.Local.Libraries, f.logger)
+	itemService := localItem.NewLocalItemService(f.logger, f.clock)
+
+	return libraryService, itemService, nil
+}
+
+// GetLibraries returns the libraries configured for the given media service
+func (f *MediaServiceBaseFactory) GetLibraries(serviceName string) []config.Library {
+	switch serviceName {
+	case mediaModel.MediaServicePlex:
+		return f.config.Plex.Libraries
+	case mediaModel.MediaServiceJellyfin:
+		return f.config.Jellyfin.Libraries
+	case mediaModel.MediaServiceEmby:
+		return f.config.Emby.Libraries
+	case mediaModel.MediaServiceKodi:
+		return f.config.Kodi.Libraries
+	case mediaModel.MediaServiceLocal:
+		return f.config.Local.Libraries
+	default:
+		return []config.Library{}
+	}
+}
+
+// GetKodiUpdateArt tells whether generated posters must be set as Kodi movie art
+func (f *MediaServiceBaseFactory) GetKodiUpdateArt() bool {
+	return f.config.Kodi.UpdateArt
+}
+
+// GetPlexUpload returns how generated posters are uploaded to Plex
+func (f *MediaServiceBaseFactory) GetPlexUpload() config.PlexUpload {
+	return f.config.Plex.Upload
 }
@@ -14,7 +14,8 @@ import (
 
 type MediaServiceBaseFactoryInterface interface {
 	BuildPlexComponents() (media.MediaClient, media.LibraryService, media.ItemService, error)
-	GetLibraries() []config.Library
+	GetLibraries(serviceName string) []config.Library
+	GetPlexUpload() config.PlexUpload
 }
 
 // MediaServiceFactory composes all components together
@@ -54,12 +55,12 @@ func (f *MediaServiceFactory) buildPlexMediaService() (mediaModel.MediaService,
 	fileManager := file.NewFileManager(f.logger)
 
 	// Create the poster service with the file manager
-	posterService := plexPoster.NewPlexPosterService(plexClient, f.logger, fileManager)
+	posterService := plexPoster.NewPlexPosterService(plexClient, f.logger, fileManager, f.baseFactory.GetPlexUpload())
 
 	f.logger.Info("Plex media service initialized")
 	return mediaModel.MediaService{
 		Name:           mediaModel.MediaServicePlex,
-		Libraries:      f.baseFactory.GetLibraries(),
+		Libraries:      f.baseFactory.GetLibraries(mediaModel.MediaServicePlex),
 		Client:         plexClient,
 		LibraryService: libraryService,
 		ItemService:    itemService,
@@ -98,17 +98,17 @@ func (_c *MediaServiceBaseFactoryInterface_BuildPlexComponents_Call) RunAndRetur
 	return _c
 }
 
-// GetLibraries provides a mock function with no fields
-func (_m *MediaServiceBaseFactoryInterface) GetLibraries() []config.Library {
-	ret := _m.Called()
+// GetLibraries provides a mock function with given fields: serviceName
+func (_m *MediaServiceBaseFactoryInterface) GetLibraries(serviceName string) []config.Library {
+	ret := _m.Called(serviceName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLibraries")
 	}
 
 	var r0 []config.Library
-	if rf, ok := ret.Get(0).(func() []config.Library); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) []config.Library); ok {
+		r0 = rf(serviceName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]config.Library)
@@ -124,13 +124,14 @@ type MediaServiceBaseFactoryInterface_GetLibraries_Call struct {
 }
 
 // GetLibraries is a helper method to define mock.On call
-func (_e *MediaServiceBaseFactoryInterface_Expecter) GetLibraries() *MediaServiceBaseFactoryInterface_GetLibraries_Call {
-	return &MediaServiceBaseFactoryInterface_GetLibraries_Call{Call: _e.mock.On("GetLibraries")}
+//   - serviceName string
+func (_e *MediaServiceBaseFactoryInterface_Expecter) GetLibraries(serviceName interface{}) *MediaServiceBaseFactoryInterface_GetLibraries_Call {
+	return &MediaServiceBaseFactoryInterface_GetLibraries_Call{Call: _e.mock.On("GetLibraries", serviceName)}
 }
 
-func (_c *MediaServiceBaseFactoryInterface_GetLibraries_Call) Run(run func()) *MediaServiceBaseFactoryInterface_GetLibraries_Call {
+func (_c *MediaServiceBaseFactoryInterface_GetLibraries_Call) Run(run func(serviceName string)) *MediaServiceBaseFactoryInterface_GetLibraries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
@@ -140,7 +141,52 @@ func (_c *MediaServiceBaseFactoryInterface_GetLibraries_Call) Return(_a0 []confi
 	return _c
 }
 
-func (_c *MediaServiceBaseFactoryInterface_GetLibraries_Call) RunAndReturn(run func() []config.Library) *MediaServiceBaseFactoryInterface_GetLibraries_Call {
+func (_c *MediaServiceBaseFactoryInterface_GetLibraries_Call) RunAndReturn(run func(string) []config.Library) *MediaServiceBaseFactoryInterface_GetLibraries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlexUpload provides a mock function with no fields
+func (_m *MediaServiceBaseFactoryInterface) GetPlexUpload() config.PlexUpload {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlexUpload")
+	}
+
+	var r0 config.PlexUpload
+	if rf, ok := ret.Get(0).(func() config.PlexUpload); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(config.PlexUpload)
+	}
+
+	return r0
+}
+
+// MediaServiceBaseFactoryInterface_GetPlexUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlexUpload'
+type MediaServiceBaseFactoryInterface_GetPlexUpload_Call struct {
+	*mock.Call
+}
+
+// GetPlexUpload is a helper method to define mock.On call
+func (_e *MediaServiceBaseFactoryInterface_Expecter) GetPlexUpload() *MediaServiceBaseFactoryInterface_GetPlexUpload_Call {
+	return &MediaServiceBaseFactoryInterface_GetPlexUpload_Call{Call: _e.mock.On("GetPlexUpload")}
+}
+
+func (_c *MediaServiceBaseFactoryInterface_GetPlexUpload_Call) Run(run func()) *MediaServiceBaseFactoryInterface_GetPlexUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MediaServiceBaseFactoryInterface_GetPlexUpload_Call) Return(_a0 config.PlexUpload) *MediaServiceBaseFactoryInterface_GetPlexUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MediaServiceBaseFactoryInterface_GetPlexUpload_Call) RunAndReturn(run func() config.PlexUpload) *MediaServiceBaseFactoryInterface_GetPlexUpload_Call {
 	_c.Call.Return(run)
 	return _c
 }
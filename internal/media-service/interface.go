@@ -29,6 +29,16 @@ type FilterService interface {
 type PosterService interface {
 	GetPosterDiskPosition(ctx context.Context, item model.Item, config *config.Library) (string, error)
 	EnsurePosterExists(ctx context.Context, item model.Item, config *config.Library) error
+	// PublishPoster makes the generated poster visible on the media server
+	PublishPoster(ctx context.Context, item model.Item, config *config.Library, posterFilePath string) error
+}
+
+// PosterRestorer is implemented by poster services that publish posters through the
+// media server API, so restoring the original poster needs the API as well
+type PosterRestorer interface {
+	// RestorePoster publishes the original poster again, reporting whether there was one.
+	// On a dry run nothing is changed.
+	RestorePoster(ctx context.Context, item model.Item, config *config.Library, dryRun bool) (bool, error)
 }
 
 type LibraryService interface {
@@ -36,6 +46,23 @@ type LibraryService interface {
 	RefreshLibrary(ctx context.Context, libraryID string, force bool) error
 }
 
+// ItemRefresher is implemented by library services able to refresh the metadata of a single item
+type ItemRefresher interface {
+	RefreshItem(ctx context.Context, itemID string) error
+}
+
 type ItemService interface {
 	GetItems(ctx context.Context, library model.Library, config *config.Library) ([]model.Item, error)
 }
+
+// PagedItemService is implemented by item services able to retrieve a library page by page,
+// handing each page over as soon as it is received
+type PagedItemService interface {
+	GetItemPages(ctx context.Context, library model.Library, config *config.Library, handlePage func(items []model.Item) error) error
+}
+
+// SingleItemService is implemented by item services able to retrieve a single item, e.g. the one
+// a webhook notified about. A show is returned along with its seasons when enabled in the configuration
+type SingleItemService interface {
+	GetItem(ctx context.Context, itemID string, config *config.Library) ([]model.Item, error)
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package media_mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ItemRefresher is an autogenerated mock type for the ItemRefresher type
+type ItemRefresher struct {
+	mock.Mock
+}
+
+type ItemRefresher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ItemRefresher) EXPECT() *ItemRefresher_Expecter {
+	return &ItemRefresher_Expecter{mock: &_m.Mock}
+}
+
+// RefreshItem provides a mock function with given fields: ctx, itemID
+func (_m *ItemRefresher) RefreshItem(ctx context.Context, itemID string) error {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ItemRefresher_RefreshItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshItem'
+type ItemRefresher_RefreshItem_Call struct {
+	*mock.Call
+}
+
+// RefreshItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+func (_e *ItemRefresher_Expecter) RefreshItem(ctx interface{}, itemID interface{}) *ItemRefresher_RefreshItem_Call {
+	return &ItemRefresher_RefreshItem_Call{Call: _e.mock.On("RefreshItem", ctx, itemID)}
+}
+
+func (_c *ItemRefresher_RefreshItem_Call) Run(run func(ctx context.Context, itemID string)) *ItemRefresher_RefreshItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ItemRefresher_RefreshItem_Call) Return(_a0 error) *ItemRefresher_RefreshItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ItemRefresher_RefreshItem_Call) RunAndReturn(run func(context.Context, string) error) *ItemRefresher_RefreshItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewItemRefresher creates a new instance of ItemRefresher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewItemRefresher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ItemRefresher {
+	mock := &ItemRefresher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
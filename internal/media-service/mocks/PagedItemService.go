@@ -0,0 +1,89 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package media_mocks
+
+import (
+	context "context"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// PagedItemService is an autogenerated mock type for the PagedItemService type
+type PagedItemService struct {
+	mock.Mock
+}
+
+type PagedItemService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PagedItemService) EXPECT() *PagedItemService_Expecter {
+	return &PagedItemService_Expecter{mock: &_m.Mock}
+}
+
+// GetItemPages provides a mock function with given fields: ctx, library, _a2, handlePage
+func (_m *PagedItemService) GetItemPages(ctx context.Context, library model.Library, _a2 *config.Library, handlePage func([]model.Item) error) error {
+	ret := _m.Called(ctx, library, _a2, handlePage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemPages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Library, *config.Library, func([]model.Item) error) error); ok {
+		r0 = rf(ctx, library, _a2, handlePage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PagedItemService_GetItemPages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItemPages'
+type PagedItemService_GetItemPages_Call struct {
+	*mock.Call
+}
+
+// GetItemPages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - library model.Library
+//   - _a2 *config.Library
+//   - handlePage func([]model.Item) error
+func (_e *PagedItemService_Expecter) GetItemPages(ctx interface{}, library interface{}, _a2 interface{}, handlePage interface{}) *PagedItemService_GetItemPages_Call {
+	return &PagedItemService_GetItemPages_Call{Call: _e.mock.On("GetItemPages", ctx, library, _a2, handlePage)}
+}
+
+func (_c *PagedItemService_GetItemPages_Call) Run(run func(ctx context.Context, library model.Library, _a2 *config.Library, handlePage func([]model.Item) error)) *PagedItemService_GetItemPages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Library), args[2].(*config.Library), args[3].(func([]model.Item) error))
+	})
+	return _c
+}
+
+func (_c *PagedItemService_GetItemPages_Call) Return(_a0 error) *PagedItemService_GetItemPages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PagedItemService_GetItemPages_Call) RunAndReturn(run func(context.Context, model.Library, *config.Library, func([]model.Item) error) error) *PagedItemService_GetItemPages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPagedItemService creates a new instance of PagedItemService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPagedItemService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PagedItemService {
+	mock := &PagedItemService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package media_mocks
+
+import (
+	context "context"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// PosterRestorer is an autogenerated mock type for the PosterRestorer type
+type PosterRestorer struct {
+	mock.Mock
+}
+
+type PosterRestorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PosterRestorer) EXPECT() *PosterRestorer_Expecter {
+	return &PosterRestorer_Expecter{mock: &_m.Mock}
+}
+
+// RestorePoster provides a mock function with given fields: ctx, item, _a2, dryRun
+func (_m *PosterRestorer) RestorePoster(ctx context.Context, item model.Item, _a2 *config.Library, dryRun bool) (bool, error) {
+	ret := _m.Called(ctx, item, _a2, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestorePoster")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item, *config.Library, bool) (bool, error)); ok {
+		return rf(ctx, item, _a2, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item, *config.Library, bool) bool); ok {
+		r0 = rf(ctx, item, _a2, dryRun)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Item, *config.Library, bool) error); ok {
+		r1 = rf(ctx, item, _a2, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PosterRestorer_RestorePoster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestorePoster'
+type PosterRestorer_RestorePoster_Call struct {
+	*mock.Call
+}
+
+// RestorePoster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.Item
+//   - _a2 *config.Library
+//   - dryRun bool
+func (_e *PosterRestorer_Expecter) RestorePoster(ctx interface{}, item interface{}, _a2 interface{}, dryRun interface{}) *PosterRestorer_RestorePoster_Call {
+	return &PosterRestorer_RestorePoster_Call{Call: _e.mock.On("RestorePoster", ctx, item, _a2, dryRun)}
+}
+
+func (_c *PosterRestorer_RestorePoster_Call) Run(run func(ctx context.Context, item model.Item, _a2 *config.Library, dryRun bool)) *PosterRestorer_RestorePoster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Item), args[2].(*config.Library), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *PosterRestorer_RestorePoster_Call) Return(_a0 bool, _a1 error) *PosterRestorer_RestorePoster_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PosterRestorer_RestorePoster_Call) RunAndReturn(run func(context.Context, model.Item, *config.Library, bool) (bool, error)) *PosterRestorer_RestorePoster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPosterRestorer creates a new instance of PosterRestorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPosterRestorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PosterRestorer {
+	mock := &PosterRestorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
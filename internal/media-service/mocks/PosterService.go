@@ -131,6 +131,55 @@ func (_c *PosterService_GetPosterDiskPosition_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// PublishPoster provides a mock function with given fields: ctx, item, _a2, posterFilePath
+func (_m *PosterService) PublishPoster(ctx context.Context, item model.Item, _a2 *config.Library, posterFilePath string) error {
+	ret := _m.Called(ctx, item, _a2, posterFilePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPoster")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item, *config.Library, string) error); ok {
+		r0 = rf(ctx, item, _a2, posterFilePath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PosterService_PublishPoster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPoster'
+type PosterService_PublishPoster_Call struct {
+	*mock.Call
+}
+
+// PublishPoster is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.Item
+//   - _a2 *config.Library
+//   - posterFilePath string
+func (_e *PosterService_Expecter) PublishPoster(ctx interface{}, item interface{}, _a2 interface{}, posterFilePath interface{}) *PosterService_PublishPoster_Call {
+	return &PosterService_PublishPoster_Call{Call: _e.mock.On("PublishPoster", ctx, item, _a2, posterFilePath)}
+}
+
+func (_c *PosterService_PublishPoster_Call) Run(run func(ctx context.Context, item model.Item, _a2 *config.Library, posterFilePath string)) *PosterService_PublishPoster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Item), args[2].(*config.Library), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *PosterService_PublishPoster_Call) Return(_a0 error) *PosterService_PublishPoster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PosterService_PublishPoster_Call) RunAndReturn(run func(context.Context, model.Item, *config.Library, string) error) *PosterService_PublishPoster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPosterService creates a new instance of PosterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPosterService(t interface {
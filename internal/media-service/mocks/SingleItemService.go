@@ -0,0 +1,100 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package media_mocks
+
+import (
+	context "context"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// SingleItemService is an autogenerated mock type for the SingleItemService type
+type SingleItemService struct {
+	mock.Mock
+}
+
+type SingleItemService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SingleItemService) EXPECT() *SingleItemService_Expecter {
+	return &SingleItemService_Expecter{mock: &_m.Mock}
+}
+
+// GetItem provides a mock function with given fields: ctx, itemID, _a2
+func (_m *SingleItemService) GetItem(ctx context.Context, itemID string, _a2 *config.Library) ([]model.Item, error) {
+	ret := _m.Called(ctx, itemID, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItem")
+	}
+
+	var r0 []model.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *config.Library) ([]model.Item, error)); ok {
+		return rf(ctx, itemID, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *config.Library) []model.Item); ok {
+		r0 = rf(ctx, itemID, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *config.Library) error); ok {
+		r1 = rf(ctx, itemID, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SingleItemService_GetItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItem'
+type SingleItemService_GetItem_Call struct {
+	*mock.Call
+}
+
+// GetItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - _a2 *config.Library
+func (_e *SingleItemService_Expecter) GetItem(ctx interface{}, itemID interface{}, _a2 interface{}) *SingleItemService_GetItem_Call {
+	return &SingleItemService_GetItem_Call{Call: _e.mock.On("GetItem", ctx, itemID, _a2)}
+}
+
+func (_c *SingleItemService_GetItem_Call) Run(run func(ctx context.Context, itemID string, _a2 *config.Library)) *SingleItemService_GetItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*config.Library))
+	})
+	return _c
+}
+
+func (_c *SingleItemService_GetItem_Call) Return(_a0 []model.Item, _a1 error) *SingleItemService_GetItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SingleItemService_GetItem_Call) RunAndReturn(run func(context.Context, string, *config.Library) ([]model.Item, error)) *SingleItemService_GetItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSingleItemService creates a new instance of SingleItemService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSingleItemService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SingleItemService {
+	mock := &SingleItemService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
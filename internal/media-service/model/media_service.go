@@ -9,12 +9,16 @@ const (
 	MediaServicePlex     = "plex"
 	MediaServiceKodi     = "kodi"
 	MediaServiceJellyfin = "jellyfin"
+	MediaServiceEmby     = "emby"
+	MediaServiceLocal    = "local"
 )
 
 var MediaServices = []string{
 	MediaServicePlex,
 	MediaServiceKodi,
 	MediaServiceJellyfin,
+	MediaServiceEmby,
+	MediaServiceLocal,
 }
 
 type MediaService struct {
@@ -0,0 +1,14 @@
+package emby
+
+import (
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	mediabrowserClient "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/client"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+)
+
+// NewEmbyClient creates a new Emby client
+func NewEmbyClient(clientConfig *config.Emby, httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*mediabrowserClient.MediaBrowserClient, error) {
+	return mediabrowserClient.NewMediaBrowserClient(mediabrowser.Emby, clientConfig.Url, clientConfig.ApiKey, httpClientConfig, logFilePath, logger)
+}
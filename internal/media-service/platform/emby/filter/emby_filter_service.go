@@ -0,0 +1,60 @@
+package emby
+
+import (
+	"net/http"
+	"strings"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type EmbyFiltersService struct{}
+
+func NewEmbyFiltersService() media.FilterService {
+	return &EmbyFiltersService{}
+}
+
+func (s *EmbyFiltersService) ApplyFiltersToRequest(request *http.Request, filters []model.Filter) {
+
+	q := request.URL.Query()
+
+	for _, filter := range filters {
+		if filter.Name != "" && filter.Value != "" {
+			q.Add(filter.Name, filter.Value)
+		}
+	}
+
+	request.URL.RawQuery = q.Encode()
+}
+
+// ConvertConfigFiltersToRequestFilters maps the library filters to /Items query parameters.
+// Emby only accepts a single search term, so titles are matched through SearchTerm
+// using the first configured title. The added_at filter has no query parameter on
+// Emby and is applied by the item service.
+func (s *EmbyFiltersService) ConvertConfigFiltersToRequestFilters(config *config.Library) []model.Filter {
+	filters := []model.Filter{}
+
+	if len(config.Filters.Year) > 0 {
+		filters = append(filters, model.Filter{
+			Name:  "Years",
+			Value: strings.Join(config.Filters.Year, ","),
+		})
+	}
+
+	if len(config.Filters.Title) > 0 {
+		filters = append(filters, model.Filter{
+			Name:  "SearchTerm",
+			Value: config.Filters.Title[0],
+		})
+	}
+
+	if len(config.Filters.Genre) > 0 {
+		filters = append(filters, model.Filter{
+			Name:  "Genres",
+			Value: strings.Join(config.Filters.Genre, "|"),
+		})
+	}
+
+	return filters
+}
@@ -0,0 +1,76 @@
+package emby
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	appmodel "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type EmbyFiltersServiceTestSuite struct {
+	suite.Suite
+	service media.FilterService
+}
+
+func (s *EmbyFiltersServiceTestSuite) SetupTest() {
+	s.service = NewEmbyFiltersService()
+}
+
+func TestEmbyFiltersServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbyFiltersServiceTestSuite))
+}
+
+func (s *EmbyFiltersServiceTestSuite) TestApplyFiltersToRequest() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodGet, "http://emby.test/emby/Items?ParentId=1", nil)
+	filters := []appmodel.Filter{
+		{Name: "Years", Value: "2020,2021"},
+		{Name: "Genres", Value: ""},
+		{Name: "", Value: "ignored"},
+	}
+
+	// Act
+	s.service.ApplyFiltersToRequest(req, filters)
+
+	// Assert
+	q := req.URL.Query()
+	assert.Equal(s.T(), "1", q.Get("ParentId"))
+	assert.Equal(s.T(), "2020,2021", q.Get("Years"))
+	assert.False(s.T(), q.Has("Genres"))
+	assert.Len(s.T(), q, 2)
+}
+
+func (s *EmbyFiltersServiceTestSuite) TestConvertConfigFiltersToRequestFilters() {
+	// Arrange
+	libConfig := &config.Library{
+		Filters: config.Filter{
+			Year:    []string{"2020", "2021"},
+			Title:   []string{"Alien", "Aliens"},
+			Genre:   []string{"Horror", "Sci-Fi"},
+			AddedAt: "last_10_days",
+		},
+	}
+
+	// Act
+	filters := s.service.ConvertConfigFiltersToRequestFilters(libConfig)
+
+	// Assert
+	assert.Equal(s.T(), []appmodel.Filter{
+		{Name: "Years", Value: "2020,2021"},
+		{Name: "SearchTerm", Value: "Alien"},
+		{Name: "Genres", Value: "Horror|Sci-Fi"},
+	}, filters)
+}
+
+func (s *EmbyFiltersServiceTestSuite) TestConvertConfigFiltersToRequestFilters_NoFilters() {
+	// Act
+	filters := s.service.ConvertConfigFiltersToRequestFilters(&config.Library{})
+
+	// Assert
+	assert.Empty(s.T(), filters)
+}
@@ -0,0 +1,14 @@
+package emby
+
+import (
+	"go.uber.org/zap"
+
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediabrowserItem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/item"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+)
+
+// NewEmbyItemService creates a new Emby item service
+func NewEmbyItemService(client media.MediaClient, logger *zap.Logger, filtersService media.FilterService, clock mediabrowserItem.Clock) media.ItemService {
+	return mediabrowserItem.NewMediaBrowserItemService(mediabrowser.Emby, client, logger, filtersService, clock)
+}
@@ -0,0 +1,144 @@
+package emby
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// newRequestWithContextFunc defines the signature for a function that creates an HTTP request.
+type newRequestWithContextFunc func(ctx context.Context, method, url string, body io.Reader) (*http.Request, error)
+
+// EmbyLibraryService handles Emby library operations
+type EmbyLibraryService struct {
+	client         media.MediaClient
+	logger         *zap.Logger
+	NewRequestFunc newRequestWithContextFunc // Exported field for request creation
+}
+
+// NewEmbyLibraryService creates a new Emby library service
+func NewEmbyLibraryService(client media.MediaClient, logger *zap.Logger) media.LibraryService {
+	return &EmbyLibraryService{
+		client:         client,
+		logger:         logger,
+		NewRequestFunc: http.NewRequestWithContext, // Default to the real function
+	}
+}
+
+// GetLibraries retrieves all libraries (virtual folders) from Emby
+func (s *EmbyLibraryService) GetLibraries(ctx context.Context) ([]model.Library, error) {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath("/emby/Library/VirtualFolders")
+
+	req, err := s.NewRequestFunc(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetLibraries"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	response, err := s.client.DoWithMediaResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to Emby Client",
+			zap.String("method", "GetLibraries"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	embyResponse, ok := response.(*mediabrowser.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to mediabrowser.Response",
+			zap.String("method", "GetLibraries"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	if len(embyResponse.VirtualFolders) == 0 {
+		return []model.Library{}, nil
+	}
+
+	return s.convertEmbyLibraries(embyResponse.VirtualFolders), nil
+}
+
+// RefreshLibrary asks Emby to scan the library folder. Images are never replaced,
+// so posters uploaded by this application survive the refresh.
+func (s *EmbyLibraryService) RefreshLibrary(ctx context.Context, libraryID string, force bool) error {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(fmt.Sprintf("/emby/Items/%s/Refresh", libraryID))
+
+	req, err := s.NewRequestFunc(ctx, http.MethodPost, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "RefreshLibrary"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	q := req.URL.Query()
+	q.Set("Recursive", "true")
+	q.Set("ReplaceAllImages", "false")
+	if force {
+		q.Set("MetadataRefreshMode", "FullRefresh")
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := s.client.DoWithResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to Emby Client",
+			zap.String("method", "RefreshLibrary"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	defer func(Body io.ReadCloser) {
+		if err := Body.Close(); err != nil {
+			s.logger.Error("unable to close response body")
+		}
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unable to refresh library %s, status code: %d", libraryID, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// convertEmbyLibraries converts Emby virtual folders to common Library model
+func (s *EmbyLibraryService) convertEmbyLibraries(libraries []mediabrowser.VirtualFolder) []model.Library {
+	var convertedLibraries []model.Library
+	lo.ForEach(libraries, func(item mediabrowser.VirtualFolder, index int) {
+		convertedLibraries = append(convertedLibraries, model.Library{
+			ID:   item.ID,
+			Type: convertCollectionType(item.CollectionType),
+			Name: item.Name,
+		})
+	})
+	return convertedLibraries
+}
+
+func convertCollectionType(collectionType string) string {
+	switch collectionType {
+	case "movies":
+		return constant.MediaTypeMovie
+	case "tvshows":
+		return constant.MediaTypeShow
+	default:
+		return collectionType
+	}
+}
@@ -0,0 +1,170 @@
+package emby_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediaClientMock "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	embylibrary "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/emby/library"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type EmbyLibraryServiceTestSuite struct {
+	suite.Suite
+	mockClient *mediaClientMock.MediaClient
+	service    media.LibraryService
+	baseURL    *url.URL
+}
+
+func (s *EmbyLibraryServiceTestSuite) SetupTest() {
+	s.mockClient = mediaClientMock.NewMediaClient(s.T())
+	s.service = embylibrary.NewEmbyLibraryService(s.mockClient, zap.NewNop())
+	s.baseURL, _ = url.Parse("http://localhost:8096")
+}
+
+func (s *EmbyLibraryServiceTestSuite) TearDownTest() {
+	s.mockClient.AssertExpectations(s.T())
+}
+
+func TestEmbyLibraryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbyLibraryServiceTestSuite))
+}
+
+func (s *EmbyLibraryServiceTestSuite) TestGetLibraries_Success() {
+	// Arrange
+	embyResponse := &mediabrowser.Response{
+		VirtualFolders: []mediabrowser.VirtualFolder{
+			{ID: "a1", Name: "Movies", CollectionType: "movies"},
+			{ID: "b2", Name: "Shows", CollectionType: "tvshows"},
+			{ID: "c3", Name: "Music", CollectionType: "music"},
+		},
+	}
+	expectedLibs := []model.Library{
+		{ID: "a1", Name: "Movies", Type: "movie"},
+		{ID: "b2", Name: "Shows", Type: "show"},
+		{ID: "c3", Name: "Music", Type: "music"},
+	}
+
+	s.mockClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockClient.On("DoWithMediaResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodGet && req.URL.String() == "http://localhost:8096/emby/Library/VirtualFolders"
+	})).Return(embyResponse, nil)
+
+	// Act
+	libs, err := s.service.GetLibraries(context.Background())
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expectedLibs, libs)
+}
+
+func (s *EmbyLibraryServiceTestSuite) TestGetLibraries_Empty() {
+	// Arrange
+	s.mockClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return(&mediabrowser.Response{}, nil)
+
+	// Act
+	libs, err := s.service.GetLibraries(context.Background())
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), libs)
+}
+
+func (s *EmbyLibraryServiceTestSuite) TestGetLibraries_ClientError() {
+	// Arrange
+	expectedErr := errors.New("client error")
+	s.mockClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return(nil, expectedErr)
+
+	// Act
+	libs, err := s.service.GetLibraries(context.Background())
+
+	// Assert
+	assert.Nil(s.T(), libs)
+	assert.Equal(s.T(), expectedErr, err)
+}
+
+func (s *EmbyLibraryServiceTestSuite) TestGetLibraries_InvalidResponseType() {
+	// Arrange
+	s.mockClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return("not a emby response", nil)
+
+	// Act
+	libs, err := s.service.GetLibraries(context.Background())
+
+	// Assert
+	assert.Nil(s.T(), libs)
+	assert.EqualError(s.T(), err, "invalid response type")
+}
+
+func (s *EmbyLibraryServiceTestSuite) TestRefreshLibrary_Success() {
+	// Arrange
+	s.mockClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		q := req.URL.Query()
+		return req.Method == http.MethodPost &&
+			req.URL.Path == "/emby/Items/a1/Refresh" &&
+			q.Get("Recursive") == "true" &&
+			q.Get("ReplaceAllImages") == "false" &&
+			q.Get("MetadataRefreshMode") == "FullRefresh"
+	})).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	// Act
+	err := s.service.RefreshLibrary(context.Background(), "a1", true)
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *EmbyLibraryServiceTestSuite) TestRefreshLibrary_NotForced() {
+	// Arrange
+	s.mockClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Query().Get("MetadataRefreshMode") == ""
+	})).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	// Act
+	err := s.service.RefreshLibrary(context.Background(), "a1", false)
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *EmbyLibraryServiceTestSuite) TestRefreshLibrary_ErrorStatus() {
+	// Arrange
+	s.mockClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockClient.On("DoWithResponse", mock.AnythingOfType("*http.Request")).
+		Return(&http.Response{StatusCode: http.StatusForbidden, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	// Act
+	err := s.service.RefreshLibrary(context.Background(), "a1", false)
+
+	// Assert
+	assert.EqualError(s.T(), err, "unable to refresh library a1, status code: 403")
+}
+
+func (s *EmbyLibraryServiceTestSuite) TestRefreshLibrary_ClientError() {
+	// Arrange
+	expectedErr := errors.New("client error")
+	s.mockClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockClient.On("DoWithResponse", mock.AnythingOfType("*http.Request")).Return(nil, expectedErr)
+
+	// Act
+	err := s.service.RefreshLibrary(context.Background(), "a1", false)
+
+	// Assert
+	assert.Equal(s.T(), expectedErr, err)
+}
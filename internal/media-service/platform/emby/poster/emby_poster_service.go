@@ -0,0 +1,15 @@
+package emby
+
+import (
+	"go.uber.org/zap"
+
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	mediabrowserPoster "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/poster"
+	"github.com/zepollabot/media-rating-overlay/internal/ports"
+)
+
+// NewEmbyPosterService creates a new Emby poster service
+func NewEmbyPosterService(client media.MediaClient, logger *zap.Logger, storage ports.PosterStorage) media.PosterService {
+	return mediabrowserPoster.NewMediaBrowserPosterService(mediabrowser.Emby, client, logger, storage)
+}
@@ -0,0 +1,14 @@
+package jellyfin
+
+import (
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	mediabrowserClient "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/client"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+)
+
+// NewJellyfinClient creates a new Jellyfin client
+func NewJellyfinClient(clientConfig *config.Jellyfin, httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*mediabrowserClient.MediaBrowserClient, error) {
+	return mediabrowserClient.NewMediaBrowserClient(mediabrowser.Jellyfin, clientConfig.Url, clientConfig.ApiKey, httpClientConfig, logFilePath, logger)
+}
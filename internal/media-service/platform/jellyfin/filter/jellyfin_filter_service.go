@@ -0,0 +1,84 @@
+package jellyfin
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+type JellyfinFiltersService struct {
+	clock Clock
+}
+
+func NewJellyfinFiltersService(clock Clock) media.FilterService {
+	return &JellyfinFiltersService{
+		clock: clock,
+	}
+}
+
+func (s *JellyfinFiltersService) ApplyFiltersToRequest(request *http.Request, filters []model.Filter) {
+
+	q := request.URL.Query()
+
+	for _, filter := range filters {
+		if filter.Name != "" && filter.Value != "" {
+			q.Add(filter.Name, filter.Value)
+		}
+	}
+
+	request.URL.RawQuery = q.Encode()
+}
+
+func (s *JellyfinFiltersService) buildMinDateCreatedValue(addedAt string) string {
+	after, ok := media.GetAddedAfter(addedAt, s.clock.Now)
+	if !ok {
+		return ""
+	}
+
+	return after.UTC().Format(time.RFC3339)
+}
+
+// ConvertConfigFiltersToRequestFilters maps the library filters to /Items query parameters.
+// Jellyfin only accepts a single search term, so titles are matched through SearchTerm
+// using the first configured title.
+func (s *JellyfinFiltersService) ConvertConfigFiltersToRequestFilters(config *config.Library) []model.Filter {
+	filters := []model.Filter{}
+
+	if len(config.Filters.Year) > 0 {
+		filters = append(filters, model.Filter{
+			Name:  "Years",
+			Value: strings.Join(config.Filters.Year, ","),
+		})
+	}
+
+	if len(config.Filters.Title) > 0 {
+		filters = append(filters, model.Filter{
+			Name:  "SearchTerm",
+			Value: config.Filters.Title[0],
+		})
+	}
+
+	if len(config.Filters.Genre) > 0 {
+		filters = append(filters, model.Filter{
+			Name:  "Genres",
+			Value: strings.Join(config.Filters.Genre, "|"),
+		})
+	}
+
+	if config.Filters.AddedAt != "" {
+		filters = append(filters, model.Filter{
+			Name:  "MinDateCreated",
+			Value: s.buildMinDateCreatedValue(config.Filters.AddedAt),
+		})
+	}
+
+	return filters
+}
@@ -0,0 +1,100 @@
+package jellyfin
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	jellyfin_mocks "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/jellyfin/filter/mocks"
+	appmodel "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type JellyfinFiltersServiceTestSuite struct {
+	suite.Suite
+	mockClock *jellyfin_mocks.Clock
+	service   media.FilterService
+}
+
+func (s *JellyfinFiltersServiceTestSuite) SetupTest() {
+	s.mockClock = jellyfin_mocks.NewClock(s.T())
+	s.service = NewJellyfinFiltersService(s.mockClock)
+}
+
+func (s *JellyfinFiltersServiceTestSuite) TearDownTest() {
+	s.mockClock.AssertExpectations(s.T())
+}
+
+func TestJellyfinFiltersServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(JellyfinFiltersServiceTestSuite))
+}
+
+func (s *JellyfinFiltersServiceTestSuite) TestApplyFiltersToRequest() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodGet, "http://jellyfin.test/Items?ParentId=1", nil)
+	filters := []appmodel.Filter{
+		{Name: "Years", Value: "2020,2021"},
+		{Name: "Genres", Value: ""},
+		{Name: "", Value: "ignored"},
+	}
+
+	// Act
+	s.service.ApplyFiltersToRequest(req, filters)
+
+	// Assert
+	q := req.URL.Query()
+	assert.Equal(s.T(), "1", q.Get("ParentId"))
+	assert.Equal(s.T(), "2020,2021", q.Get("Years"))
+	assert.False(s.T(), q.Has("Genres"))
+	assert.Len(s.T(), q, 2)
+}
+
+func (s *JellyfinFiltersServiceTestSuite) TestConvertConfigFiltersToRequestFilters() {
+	// Arrange
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+	s.mockClock.On("Now").Return(now).Once()
+	libConfig := &config.Library{
+		Filters: config.Filter{
+			Year:    []string{"2020", "2021"},
+			Title:   []string{"Alien", "Aliens"},
+			Genre:   []string{"Horror", "Sci-Fi"},
+			AddedAt: "last_10_days",
+		},
+	}
+
+	// Act
+	filters := s.service.ConvertConfigFiltersToRequestFilters(libConfig)
+
+	// Assert
+	assert.Equal(s.T(), []appmodel.Filter{
+		{Name: "Years", Value: "2020,2021"},
+		{Name: "SearchTerm", Value: "Alien"},
+		{Name: "Genres", Value: "Horror|Sci-Fi"},
+		{Name: "MinDateCreated", Value: "2024-05-05T12:00:00Z"},
+	}, filters)
+}
+
+func (s *JellyfinFiltersServiceTestSuite) TestConvertConfigFiltersToRequestFilters_InvalidAddedAt() {
+	// Arrange
+	libConfig := &config.Library{
+		Filters: config.Filter{AddedAt: "yesterday"},
+	}
+
+	// Act
+	filters := s.service.ConvertConfigFiltersToRequestFilters(libConfig)
+
+	// Assert
+	assert.Equal(s.T(), []appmodel.Filter{{Name: "MinDateCreated", Value: ""}}, filters)
+}
+
+func (s *JellyfinFiltersServiceTestSuite) TestConvertConfigFiltersToRequestFilters_NoFilters() {
+	// Act
+	filters := s.service.ConvertConfigFiltersToRequestFilters(&config.Library{})
+
+	// Assert
+	assert.Empty(s.T(), filters)
+}
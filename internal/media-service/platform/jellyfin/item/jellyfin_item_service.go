@@ -0,0 +1,14 @@
+package jellyfin
+
+import (
+	"go.uber.org/zap"
+
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediabrowserItem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/item"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+)
+
+// NewJellyfinItemService creates a new Jellyfin item service
+func NewJellyfinItemService(client media.MediaClient, logger *zap.Logger, filtersService media.FilterService, clock mediabrowserItem.Clock) media.ItemService {
+	return mediabrowserItem.NewMediaBrowserItemService(mediabrowser.Jellyfin, client, logger, filtersService, clock)
+}
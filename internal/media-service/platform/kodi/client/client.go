@@ -0,0 +1,146 @@
+package kodi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	kodiModels "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// KodiClient implements the MediaClient interface on top of the Kodi JSON-RPC API
+type KodiClient struct {
+	httpClient common.ServiceHTTPClient
+	username   string
+	password   string
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewKodiClient creates a new Kodi client
+func NewKodiClient(clientConfig *config.Kodi, httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*KodiClient, error) {
+	if clientConfig.Url == "" {
+		logger.Error("kodi.url is required")
+		return nil, errors.New("kodi.url is required")
+	}
+
+	baseUrl, err := url.Parse(clientConfig.Url)
+	if err != nil {
+		logger.Error("error parsing Kodi URL", zap.Error(err))
+		return nil, err
+	}
+
+	httpClient := NewKodiHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		logger.Error("error setting up logging", zap.Error(err))
+		return nil, err
+	}
+
+	return &KodiClient{
+		httpClient: httpClient,
+		username:   clientConfig.Username,
+		password:   clientConfig.Password,
+		baseUrl:    *baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+// DoWithResponse performs a request and returns the raw HTTP response
+func (c *KodiClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	if err := c.setupRequest(request); err != nil {
+		c.logger.Error("unable to setup request", zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("unable to perform request to Kodi API",
+			zap.String("url", request.URL.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithMediaResponse performs a JSON-RPC request and returns the parsed envelope.
+// A JSON-RPC error carried by the envelope is returned as an error.
+func (c *KodiClient) DoWithMediaResponse(request *http.Request) (media.MediaResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseKodiResponse(resp)
+}
+
+// setupRequest configures the request with common headers and authentication
+func (c *KodiClient) setupRequest(request *http.Request) error {
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	if c.username != "" {
+		request.SetBasicAuth(c.username, c.password)
+	}
+
+	return nil
+}
+
+// parseKodiResponse handles the Kodi JSON-RPC response parsing
+func (c *KodiClient) parseKodiResponse(resp *http.Response) (*kodiModels.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		err := errors.New(model.NotAuthorized)
+		c.logger.Error(
+			"your Kodi credentials are invalid, please check kodi.username and kodi.password",
+			zap.Error(err),
+		)
+		return nil, err
+	case http.StatusNotFound:
+		err := errors.New(model.NotFound)
+		c.logger.Error("Kodi resource not found", zap.Error(err))
+		return nil, err
+	}
+
+	var kodiResponse kodiModels.Response
+	if err := json.NewDecoder(resp.Body).Decode(&kodiResponse); err != nil {
+		c.logger.Error("unable to decode Kodi API response",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if kodiResponse.Error != nil {
+		c.logger.Error("Kodi JSON-RPC call failed",
+			zap.Int("code", kodiResponse.Error.Code),
+			zap.String("message", kodiResponse.Error.Message),
+		)
+		return nil, kodiResponse.Error
+	}
+
+	return &kodiResponse, nil
+}
+
+// GetBaseUrl returns the base URL for the Kodi server
+func (c *KodiClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient (primarily for testing)
+func (c *KodiClient) SetHttpClient(client common.HTTPClient) {
+	c.httpClient = client
+}
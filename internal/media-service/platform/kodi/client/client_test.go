@@ -0,0 +1,234 @@
+package kodi
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	httpclientmocks "github.com/zepollabot/media-rating-overlay/internal/httpclient/mocks"
+	kodiModels "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const (
+	testKodiURL      = "http://localhost:8080"
+	testKodiUsername = "kodi"
+	testKodiPassword = "secret"
+	logFilePath      = "/tmp/test-kodi-client.log"
+)
+
+type KodiClientTestSuite struct {
+	suite.Suite
+	mockHTTPClient   *httpclientmocks.ServiceHTTPClient
+	kodiClient       *KodiClient
+	logger           *zap.Logger
+	kodiConfig       *config.Kodi
+	httpClientConfig *config.HTTPClient
+}
+
+func (s *KodiClientTestSuite) SetupTest() {
+	s.logger = zap.NewNop()
+	s.mockHTTPClient = new(httpclientmocks.ServiceHTTPClient)
+
+	s.kodiConfig = &config.Kodi{
+		Url:      testKodiURL,
+		Username: testKodiUsername,
+		Password: testKodiPassword,
+	}
+	s.httpClientConfig = &config.HTTPClient{
+		Timeout:    10,
+		MaxRetries: 3,
+	}
+
+	var err error
+	s.kodiClient, err = NewKodiClient(s.kodiConfig, s.httpClientConfig, logFilePath, s.logger)
+	s.Require().NoError(err)
+	s.kodiClient.httpClient = s.mockHTTPClient // Replace with mock
+}
+
+func (s *KodiClientTestSuite) TearDownTest() {
+	s.mockHTTPClient.AssertExpectations(s.T())
+}
+
+func TestKodiClientTestSuite(t *testing.T) {
+	suite.Run(t, new(KodiClientTestSuite))
+}
+
+func (s *KodiClientTestSuite) TestNewKodiClient_Success() {
+	client, err := NewKodiClient(s.kodiConfig, s.httpClientConfig, logFilePath, s.logger)
+	s.NoError(err)
+	s.NotNil(client)
+	s.Equal(testKodiUsername, client.username)
+	s.Equal(testKodiURL, client.GetBaseUrl().String())
+}
+
+func (s *KodiClientTestSuite) TestNewKodiClient_EmptyURL() {
+	client, err := NewKodiClient(&config.Kodi{}, s.httpClientConfig, logFilePath, s.logger)
+
+	s.Error(err)
+	s.Nil(client)
+	s.Contains(err.Error(), "kodi.url is required")
+}
+
+func (s *KodiClientTestSuite) TestNewKodiClient_InvalidURL() {
+	client, err := NewKodiClient(&config.Kodi{Url: ":invalid-url"}, s.httpClientConfig, logFilePath, s.logger)
+
+	s.Error(err)
+	s.Nil(client)
+	s.Contains(err.Error(), "missing protocol scheme")
+}
+
+func (s *KodiClientTestSuite) TestDoWithResponse_SetsHeaders() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodPost, testKodiURL+"/jsonrpc", nil)
+	expectedResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		request := args.Get(0).(*http.Request)
+		s.Equal("application/json", request.Header.Get("Content-Type"))
+		s.Equal("application/json", request.Header.Get("Accept"))
+		username, password, ok := request.BasicAuth()
+		s.True(ok)
+		s.Equal(testKodiUsername, username)
+		s.Equal(testKodiPassword, password)
+	}).Return(expectedResp, nil).Once()
+
+	// Act
+	resp, err := s.kodiClient.DoWithResponse(req)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(expectedResp, resp)
+}
+
+func (s *KodiClientTestSuite) TestDoWithResponse_NoCredentials() {
+	// Arrange
+	s.kodiClient.username = ""
+	req, _ := http.NewRequest(http.MethodPost, testKodiURL+"/jsonrpc", nil)
+	expectedResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		request := args.Get(0).(*http.Request)
+		s.Empty(request.Header.Get("Authorization"))
+	}).Return(expectedResp, nil).Once()
+
+	// Act
+	_, err := s.kodiClient.DoWithResponse(req)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *KodiClientTestSuite) TestDoWithResponse_HTTPClientError() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodPost, testKodiURL+"/jsonrpc", nil)
+	expectedErr := errors.New("http client error")
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expectedErr).Once()
+
+	// Act
+	resp, err := s.kodiClient.DoWithResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.Equal(expectedErr, err)
+}
+
+func (s *KodiClientTestSuite) TestDoWithMediaResponse_Success() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodPost, testKodiURL+"/jsonrpc", nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"jsonrpc": "2.0", "id": 1, "result": "OK"}`)),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.kodiClient.DoWithMediaResponse(req)
+
+	// Assert
+	s.NoError(err)
+	kodiResp, ok := resp.(*kodiModels.Response)
+	s.Require().True(ok)
+	s.Equal(1, kodiResp.ID)
+	s.JSONEq(`"OK"`, string(kodiResp.Result))
+	s.Nil(kodiResp.Error)
+}
+
+func (s *KodiClientTestSuite) TestDoWithMediaResponse_RPCError() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodPost, testKodiURL+"/jsonrpc", nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"jsonrpc": "2.0", "id": 1, "error": {"code": -32602, "message": "Invalid params."}}`)),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.kodiClient.DoWithMediaResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.EqualError(err, "kodi json-rpc error -32602: Invalid params.")
+}
+
+func (s *KodiClientTestSuite) TestDoWithMediaResponse_Unauthorized() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodPost, testKodiURL+"/jsonrpc", nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusUnauthorized,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.kodiClient.DoWithMediaResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.EqualError(err, model.NotAuthorized)
+}
+
+func (s *KodiClientTestSuite) TestDoWithMediaResponse_NotFound() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodPost, testKodiURL+"/jsonrpc", nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.kodiClient.DoWithMediaResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.EqualError(err, model.NotFound)
+}
+
+func (s *KodiClientTestSuite) TestDoWithMediaResponse_InvalidJSON() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodPost, testKodiURL+"/jsonrpc", nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{invalid")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.kodiClient.DoWithMediaResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.Error(err)
+}
@@ -0,0 +1,25 @@
+package kodi
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// KodiHTTPClient implements the HTTPClient interface for Kodi
+type KodiHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewKodiHTTPClient creates a new Kodi HTTP client
+func NewKodiHTTPClient(timeout time.Duration, maxRetries int) *KodiHTTPClient {
+	return &KodiHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *KodiHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
@@ -0,0 +1,132 @@
+// Package fake provides an in-memory Kodi JSON-RPC server for tests
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	kodi "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/model"
+)
+
+const (
+	methodNotFoundCode    = -32601
+	methodNotFoundMessage = "Method not found."
+)
+
+// Server answers JSON-RPC calls with the results registered per method and
+// serves the images registered per art URL under /image/
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	results map[string]any
+	errors  map[string]*kodi.Error
+	images  map[string][]byte
+	calls   []kodi.Request
+}
+
+// NewServer starts a new fake Kodi server; callers must Close it
+func NewServer() *Server {
+	s := &Server{
+		results: map[string]any{},
+		errors:  map[string]*kodi.Error{},
+		images:  map[string][]byte{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jsonrpc", s.handleRPC)
+	mux.HandleFunc("/image/", s.handleImage)
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// HandleResult registers the result returned for method
+func (s *Server) HandleResult(method string, result any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[method] = result
+	delete(s.errors, method)
+}
+
+// HandleError registers a JSON-RPC error returned for method
+func (s *Server) HandleError(method string, code int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[method] = &kodi.Error{Code: code, Message: message}
+	delete(s.results, method)
+}
+
+// HandleImage registers the image served for the given Kodi art URL
+func (s *Server) HandleImage(art string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.images[art] = data
+}
+
+// Calls returns the requests received for method, in order
+func (s *Server) Calls(method string) []kodi.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var calls []kodi.Request
+	for _, call := range s.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (s *Server) handleRPC(w http.ResponseWriter, r *http.Request) {
+	var request kodi.Request
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, request)
+	result, hasResult := s.results[request.Method]
+	rpcError := s.errors[request.Method]
+	s.mu.Unlock()
+
+	response := map[string]any{
+		"jsonrpc": kodi.JSONRPCVersion,
+		"id":      request.ID,
+	}
+	switch {
+	case rpcError != nil:
+		response["error"] = rpcError
+	case hasResult:
+		response["result"] = result
+	default:
+		response["error"] = &kodi.Error{Code: methodNotFoundCode, Message: methodNotFoundMessage}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (s *Server) handleImage(w http.ResponseWriter, r *http.Request) {
+	art, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/image/"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	data, ok := s.images[art]
+	s.mu.Unlock()
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,134 @@
+package kodi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	"github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/rpc"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const dateAddedLayout = "2006-01-02"
+
+// operators maps the Kodi filter fields used by this service to their operator
+var operators = map[string]string{
+	"year":      "is",
+	"title":     "contains",
+	"genre":     "is",
+	"dateadded": "after",
+}
+
+type Clock interface {
+	Now() time.Time
+}
+
+type KodiFiltersService struct {
+	clock Clock
+}
+
+func NewKodiFiltersService(clock Clock) media.FilterService {
+	return &KodiFiltersService{
+		clock: clock,
+	}
+}
+
+// ApplyFiltersToRequest adds the filters to the "filter" parameter of a JSON-RPC request.
+// Filters sharing the same field are OR'ed, different fields are AND'ed together with
+// any filter already present in the request.
+func (s *KodiFiltersService) ApplyFiltersToRequest(request *http.Request, filters []model.Filter) {
+	rules := s.buildRules(filters)
+	if len(rules) == 0 {
+		return
+	}
+
+	rpcRequest, err := rpc.ReadRequest(request)
+	if err != nil {
+		return
+	}
+
+	if rpcRequest.Params == nil {
+		rpcRequest.Params = map[string]any{}
+	}
+	if existing, ok := rpcRequest.Params["filter"]; ok {
+		rules = append([]any{existing}, rules...)
+	}
+
+	if len(rules) == 1 {
+		rpcRequest.Params["filter"] = rules[0]
+	} else {
+		rpcRequest.Params["filter"] = map[string]any{"and": rules}
+	}
+
+	_ = rpc.WriteRequest(request, rpcRequest)
+}
+
+func (s *KodiFiltersService) buildRules(filters []model.Filter) []any {
+	filters = lo.Filter(filters, func(filter model.Filter, _ int) bool {
+		_, supported := operators[filter.Name]
+		return supported && filter.Value != ""
+	})
+
+	var fields []string
+	grouped := map[string][]any{}
+	for _, filter := range filters {
+		if _, ok := grouped[filter.Name]; !ok {
+			fields = append(fields, filter.Name)
+		}
+		grouped[filter.Name] = append(grouped[filter.Name], map[string]any{
+			"field":    filter.Name,
+			"operator": operators[filter.Name],
+			"value":    filter.Value,
+		})
+	}
+
+	var rules []any
+	for _, field := range fields {
+		if len(grouped[field]) == 1 {
+			rules = append(rules, grouped[field][0])
+			continue
+		}
+		rules = append(rules, map[string]any{"or": grouped[field]})
+	}
+
+	return rules
+}
+
+func (s *KodiFiltersService) buildDateAddedValue(addedAt string) string {
+	after, ok := media.GetAddedAfter(addedAt, s.clock.Now)
+	if !ok {
+		return ""
+	}
+
+	return after.Format(dateAddedLayout)
+}
+
+// ConvertConfigFiltersToRequestFilters maps the library filters to Kodi smart playlist rules,
+// one filter per configured value
+func (s *KodiFiltersService) ConvertConfigFiltersToRequestFilters(config *config.Library) []model.Filter {
+	filters := []model.Filter{}
+
+	for _, year := range config.Filters.Year {
+		filters = append(filters, model.Filter{Name: "year", Value: year})
+	}
+
+	for _, title := range config.Filters.Title {
+		filters = append(filters, model.Filter{Name: "title", Value: title})
+	}
+
+	for _, genre := range config.Filters.Genre {
+		filters = append(filters, model.Filter{Name: "genre", Value: genre})
+	}
+
+	if config.Filters.AddedAt != "" {
+		filters = append(filters, model.Filter{
+			Name:  "dateadded",
+			Value: s.buildDateAddedValue(config.Filters.AddedAt),
+		})
+	}
+
+	return filters
+}
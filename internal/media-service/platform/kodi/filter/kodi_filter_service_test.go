@@ -0,0 +1,144 @@
+package kodi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	media_mocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	kodi_mocks "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/filter/mocks"
+	"github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/rpc"
+	appmodel "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type KodiFiltersServiceTestSuite struct {
+	suite.Suite
+	mockClock *kodi_mocks.Clock
+	service   media.FilterService
+}
+
+func (s *KodiFiltersServiceTestSuite) SetupTest() {
+	s.mockClock = kodi_mocks.NewClock(s.T())
+	s.service = NewKodiFiltersService(s.mockClock)
+}
+
+func (s *KodiFiltersServiceTestSuite) TearDownTest() {
+	s.mockClock.AssertExpectations(s.T())
+}
+
+func TestKodiFiltersServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(KodiFiltersServiceTestSuite))
+}
+
+func (s *KodiFiltersServiceTestSuite) newRequest(params map[string]any) *http.Request {
+	baseUrl, _ := url.Parse("http://kodi.test:8080")
+	client := media_mocks.NewMediaClient(s.T())
+	client.On("GetBaseUrl").Return(baseUrl).Once()
+	req, err := rpc.NewRequest(context.Background(), client, "VideoLibrary.GetMovies", params)
+	s.Require().NoError(err)
+	return req
+}
+
+func (s *KodiFiltersServiceTestSuite) TestApplyFiltersToRequest_CombinesWithExistingFilter() {
+	// Arrange
+	req := s.newRequest(map[string]any{
+		"filter": map[string]any{"field": "path", "operator": "startswith", "value": "/movies/"},
+	})
+	filters := []appmodel.Filter{
+		{Name: "year", Value: "2020"},
+		{Name: "year", Value: "2021"},
+		{Name: "genre", Value: "Horror"},
+		{Name: "genre", Value: ""},
+		{Name: "unsupported", Value: "ignored"},
+	}
+
+	// Act
+	s.service.ApplyFiltersToRequest(req, filters)
+
+	// Assert
+	rpcRequest, err := rpc.ReadRequest(req)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), map[string]any{
+		"and": []any{
+			map[string]any{"field": "path", "operator": "startswith", "value": "/movies/"},
+			map[string]any{"or": []any{
+				map[string]any{"field": "year", "operator": "is", "value": "2020"},
+				map[string]any{"field": "year", "operator": "is", "value": "2021"},
+			}},
+			map[string]any{"field": "genre", "operator": "is", "value": "Horror"},
+		},
+	}, rpcRequest.Params["filter"])
+	assert.Equal(s.T(), "VideoLibrary.GetMovies", rpcRequest.Method)
+}
+
+func (s *KodiFiltersServiceTestSuite) TestApplyFiltersToRequest_SingleRule() {
+	// Arrange
+	req := s.newRequest(nil)
+
+	// Act
+	s.service.ApplyFiltersToRequest(req, []appmodel.Filter{{Name: "title", Value: "Alien"}})
+
+	// Assert
+	rpcRequest, err := rpc.ReadRequest(req)
+	s.Require().NoError(err)
+	assert.Equal(s.T(),
+		map[string]any{"field": "title", "operator": "contains", "value": "Alien"},
+		rpcRequest.Params["filter"],
+	)
+}
+
+func (s *KodiFiltersServiceTestSuite) TestApplyFiltersToRequest_NoFilters() {
+	// Arrange
+	req := s.newRequest(map[string]any{"properties": []string{"title"}})
+	contentLength := req.ContentLength
+
+	// Act
+	s.service.ApplyFiltersToRequest(req, []appmodel.Filter{})
+
+	// Assert
+	rpcRequest, err := rpc.ReadRequest(req)
+	s.Require().NoError(err)
+	assert.NotContains(s.T(), rpcRequest.Params, "filter")
+	assert.Equal(s.T(), contentLength, req.ContentLength)
+}
+
+func (s *KodiFiltersServiceTestSuite) TestConvertConfigFiltersToRequestFilters() {
+	// Arrange
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+	s.mockClock.On("Now").Return(now).Once()
+	libConfig := &config.Library{
+		Filters: config.Filter{
+			Year:    []string{"2020", "2021"},
+			Title:   []string{"Alien"},
+			Genre:   []string{"Horror"},
+			AddedAt: "last_10_days",
+		},
+	}
+
+	// Act
+	filters := s.service.ConvertConfigFiltersToRequestFilters(libConfig)
+
+	// Assert
+	assert.Equal(s.T(), []appmodel.Filter{
+		{Name: "year", Value: "2020"},
+		{Name: "year", Value: "2021"},
+		{Name: "title", Value: "Alien"},
+		{Name: "genre", Value: "Horror"},
+		{Name: "dateadded", Value: "2024-05-05"},
+	}, filters)
+}
+
+func (s *KodiFiltersServiceTestSuite) TestConvertConfigFiltersToRequestFilters_Empty() {
+	// Act
+	filters := s.service.ConvertConfigFiltersToRequestFilters(&config.Library{})
+
+	// Assert
+	assert.Empty(s.T(), filters)
+}
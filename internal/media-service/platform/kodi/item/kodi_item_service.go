@@ -0,0 +1,176 @@
+package kodi
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	kodi "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/model"
+	"github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/rpc"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const dateAddedLayout = "2006-01-02 15:04:05"
+
+var movieProperties = []string{
+	"title", "originaltitle", "year", "file", "dateadded", "genre", "art", "uniqueid", "ratings",
+}
+
+// ratingSources maps the rating names stored by the Kodi scrapers to the rating
+// services of this application, in the order they are reported
+var ratingSources = []struct {
+	kodiName string
+	rating   model.Rating
+}{
+	{"imdb", model.Rating{Name: constant.RatingServiceIMDB, Type: model.RatingServiceTypeAudience}},
+	{"themoviedb", model.Rating{Name: constant.RatingServiceTMDB, Type: model.RatingServiceTypeAudience}},
+	{"tmdb", model.Rating{Name: constant.RatingServiceTMDB, Type: model.RatingServiceTypeAudience}},
+	{"tomatometerallcritics", model.Rating{Name: constant.RatingServiceRottenTomatoes, Type: model.RatingServiceTypeCritic}},
+	{"tomatometerallaudience", model.Rating{Name: constant.RatingServiceRottenTomatoes, Type: model.RatingServiceTypeAudience}},
+	{"metacritic", model.Rating{Name: constant.RatingServiceMetacritic, Type: model.RatingServiceTypeCritic}},
+}
+
+// KodiItemService handles Kodi item operations
+type KodiItemService struct {
+	client         media.MediaClient
+	logger         *zap.Logger
+	filtersService media.FilterService
+}
+
+// NewKodiItemService creates a new Kodi item service
+func NewKodiItemService(client media.MediaClient, logger *zap.Logger, filtersService media.FilterService) media.ItemService {
+	return &KodiItemService{
+		client:         client,
+		logger:         logger,
+		filtersService: filtersService,
+	}
+}
+
+// GetItems retrieves the movies stored under the library (source) path
+func (s *KodiItemService) GetItems(ctx context.Context, library model.Library, config *config.Library) ([]model.Item, error) {
+	req, err := rpc.NewRequest(ctx, s.client, "VideoLibrary.GetMovies", map[string]any{
+		"properties": movieProperties,
+		"filter": map[string]any{
+			"field":    "path",
+			"operator": "startswith",
+			"value":    library.ID,
+		},
+	})
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetItems"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	filters := s.filtersService.ConvertConfigFiltersToRequestFilters(config)
+	s.filtersService.ApplyFiltersToRequest(req, filters)
+
+	var result kodi.MoviesResult
+	if err := rpc.Call(s.client, req, &result); err != nil {
+		s.logger.Error("unable to perform request to Kodi Client",
+			zap.String("method", "GetItems"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return s.convertKodiMovies(result.Movies), nil
+}
+
+// convertKodiMovies converts Kodi movies to common Item model
+func (s *KodiItemService) convertKodiMovies(movies []kodi.Movie) []model.Item {
+	var convertedItems []model.Item
+	lo.ForEach(movies, func(movie kodi.Movie, index int) {
+		id := strconv.Itoa(movie.ID)
+		addedAt := s.parseDate(movie.DateAdded)
+
+		convertedItems = append(convertedItems, model.Item{
+			ID:            id,
+			GUID:          "kodi://movie/" + id,
+			Title:         movie.Title,
+			OriginalTitle: movie.OriginalTitle,
+			Type:          constant.MediaTypeMovie,
+			Year:          movie.Year,
+			Ratings:       s.buildRatings(movie),
+			AddedAt:       addedAt,
+			UpdatedAt:     addedAt,
+			Poster:        movie.Art["poster"],
+			Media:         s.convertKodiMedia(movie.File),
+			IsEligible:    s.isEligibleForPoster(movie),
+			ExternalIDs: model.ExternalIDs{
+				IMDB: movie.UniqueID["imdb"],
+				TMDB: movie.UniqueID["tmdb"],
+				TVDB: movie.UniqueID["tvdb"],
+			},
+		})
+	})
+	return convertedItems
+}
+
+// buildRatings maps the ratings Kodi already stores; all of them use a 0-10 scale
+func (s *KodiItemService) buildRatings(movie kodi.Movie) []model.Rating {
+	ratings := []model.Rating{}
+
+	for _, source := range ratingSources {
+		kodiRating, ok := movie.Ratings[source.kodiName]
+		if !ok || kodiRating.Rating <= 0 {
+			continue
+		}
+
+		alreadyMapped := lo.ContainsBy(ratings, func(rating model.Rating) bool {
+			return rating.Name == source.rating.Name && rating.Type == source.rating.Type
+		})
+		if alreadyMapped {
+			continue
+		}
+
+		rating := source.rating
+		rating.Rating = kodiRating.Rating
+		ratings = append(ratings, rating)
+	}
+
+	return ratings
+}
+
+func (s *KodiItemService) parseDate(value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+
+	parsed, err := time.Parse(dateAddedLayout, value)
+	if err != nil {
+		s.logger.Debug("unable to parse date",
+			zap.String("value", value),
+			zap.Error(err),
+		)
+		return time.Time{}
+	}
+
+	return parsed
+}
+
+// isEligibleForPoster excludes stacked (multi-part) files, whose path cannot
+// be used to place the poster
+func (s *KodiItemService) isEligibleForPoster(movie kodi.Movie) bool {
+	return movie.File != "" &&
+		!strings.HasPrefix(movie.File, "stack://")
+}
+
+func (s *KodiItemService) convertKodiMedia(file string) []model.Media {
+	if file == "" {
+		return []model.Media{}
+	}
+
+	return []model.Media{
+		{File: []model.File{{Position: file}}},
+	}
+}
@@ -0,0 +1,163 @@
+package kodi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	configmodel "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediamocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	kodiclient "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/client"
+	"github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/fake"
+	kodiitem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/item"
+	kodimodel "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type KodiItemServiceTestSuite struct {
+	suite.Suite
+	server            *fake.Server
+	mockFilterService *mediamocks.FilterService
+	service           media.ItemService
+	ctx               context.Context
+}
+
+func (s *KodiItemServiceTestSuite) SetupTest() {
+	s.server = fake.NewServer()
+	s.mockFilterService = mediamocks.NewFilterService(s.T())
+	s.ctx = context.Background()
+
+	client, err := kodiclient.NewKodiClient(
+		&configmodel.Kodi{Url: s.server.URL},
+		&configmodel.HTTPClient{Timeout: 5 * time.Second, MaxRetries: 0},
+		"/tmp/test-kodi-item.log",
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	s.service = kodiitem.NewKodiItemService(client, zap.NewNop(), s.mockFilterService)
+}
+
+func (s *KodiItemServiceTestSuite) TearDownTest() {
+	s.mockFilterService.AssertExpectations(s.T())
+	s.server.Close()
+}
+
+func TestKodiItemServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(KodiItemServiceTestSuite))
+}
+
+func (s *KodiItemServiceTestSuite) TestGetItems_Success() {
+	// Arrange
+	library := model.Library{ID: "/media/movies/"}
+	libConfig := &configmodel.Library{
+		Filters: configmodel.Filter{Genre: []string{"Action"}},
+	}
+	requestFilters := []model.Filter{{Name: "genre", Value: "Action"}}
+	s.server.HandleResult("VideoLibrary.GetMovies", kodimodel.MoviesResult{
+		Movies: []kodimodel.Movie{
+			{
+				ID:            12,
+				Title:         "Movie One",
+				OriginalTitle: "Film Un",
+				Year:          2020,
+				File:          "/media/movies/Movie One (2020)/Movie One (2020).mkv",
+				DateAdded:     "2024-01-02 03:04:05",
+				Art:           map[string]string{"poster": "image://%2fmedia%2fposter.jpg/"},
+				UniqueID:      map[string]string{"imdb": "tt0000001", "tmdb": "101"},
+				Ratings: map[string]kodimodel.Rating{
+					"imdb":                  {Rating: 7.8, Votes: 1000, Default: true},
+					"themoviedb":            {Rating: 7.1},
+					"tomatometerallcritics": {Rating: 9.1},
+					"metacritic":            {Rating: 8},
+				},
+			},
+			{
+				ID:    13,
+				Title: "Stacked Movie",
+				File:  "stack:///media/movies/cd1.avi , /media/movies/cd2.avi",
+			},
+		},
+	})
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return(requestFilters).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.Anything, requestFilters).Once()
+
+	// Act
+	items, err := s.service.GetItems(s.ctx, library, libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(items, 2)
+
+	addedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.Equal(model.Item{
+		ID:            "12",
+		GUID:          "kodi://movie/12",
+		Title:         "Movie One",
+		OriginalTitle: "Film Un",
+		Type:          constant.MediaTypeMovie,
+		Year:          2020,
+		Ratings: []model.Rating{
+			{Name: constant.RatingServiceIMDB, Type: model.RatingServiceTypeAudience, Rating: 7.8},
+			{Name: constant.RatingServiceTMDB, Type: model.RatingServiceTypeAudience, Rating: 7.1},
+			{Name: constant.RatingServiceRottenTomatoes, Type: model.RatingServiceTypeCritic, Rating: 9.1},
+			{Name: constant.RatingServiceMetacritic, Type: model.RatingServiceTypeCritic, Rating: 8},
+		},
+		AddedAt:   addedAt,
+		UpdatedAt: addedAt,
+		Poster:    "image://%2fmedia%2fposter.jpg/",
+		Media: []model.Media{
+			{File: []model.File{{Position: "/media/movies/Movie One (2020)/Movie One (2020).mkv"}}},
+		},
+		IsEligible:  true,
+		ExternalIDs: model.ExternalIDs{IMDB: "tt0000001", TMDB: "101"},
+	}, items[0])
+	s.Equal("13", items[1].ID)
+	s.False(items[1].IsEligible)
+	s.Empty(items[1].Ratings)
+
+	calls := s.server.Calls("VideoLibrary.GetMovies")
+	s.Require().Len(calls, 1)
+	s.Equal(map[string]any{
+		"field":    "path",
+		"operator": "startswith",
+		"value":    "/media/movies/",
+	}, calls[0].Params["filter"])
+	s.Contains(calls[0].Params["properties"], "uniqueid")
+}
+
+func (s *KodiItemServiceTestSuite) TestGetItems_Empty() {
+	// Arrange
+	libConfig := &configmodel.Library{}
+	s.server.HandleResult("VideoLibrary.GetMovies", map[string]any{"limits": map[string]int{"total": 0}})
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.Anything, []model.Filter{}).Once()
+
+	// Act
+	items, err := s.service.GetItems(s.ctx, model.Library{ID: "/media/movies/"}, libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(items)
+}
+
+func (s *KodiItemServiceTestSuite) TestGetItems_RPCError() {
+	// Arrange
+	libConfig := &configmodel.Library{}
+	s.server.HandleError("VideoLibrary.GetMovies", -32602, "Invalid params.")
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.Anything, []model.Filter{}).Once()
+
+	// Act
+	items, err := s.service.GetItems(s.ctx, model.Library{ID: "/media/movies/"}, libConfig)
+
+	// Assert
+	s.Nil(items)
+	s.EqualError(err, "kodi json-rpc error -32602: Invalid params.")
+}
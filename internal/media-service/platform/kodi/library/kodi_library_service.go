@@ -0,0 +1,88 @@
+package kodi
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	kodi "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/model"
+	"github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/rpc"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// KodiLibraryService handles Kodi library operations.
+// Kodi has no library entity: each video source is exposed as a movie library,
+// identified by its path.
+type KodiLibraryService struct {
+	client media.MediaClient
+	logger *zap.Logger
+}
+
+// NewKodiLibraryService creates a new Kodi library service
+func NewKodiLibraryService(client media.MediaClient, logger *zap.Logger) media.LibraryService {
+	return &KodiLibraryService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetLibraries retrieves the video sources configured in Kodi
+func (s *KodiLibraryService) GetLibraries(ctx context.Context) ([]model.Library, error) {
+	req, err := rpc.NewRequest(ctx, s.client, "Files.GetSources", map[string]any{"media": "video"})
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetLibraries"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var result kodi.SourcesResult
+	if err := rpc.Call(s.client, req, &result); err != nil {
+		s.logger.Error("unable to perform request to Kodi Client",
+			zap.String("method", "GetLibraries"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return s.convertKodiSources(result.Sources), nil
+}
+
+// RefreshLibrary asks Kodi to scan the source for new and changed items.
+// Kodi always performs an incremental scan, so force has no effect.
+func (s *KodiLibraryService) RefreshLibrary(ctx context.Context, libraryID string, force bool) error {
+	req, err := rpc.NewRequest(ctx, s.client, "VideoLibrary.Scan", map[string]any{"directory": libraryID})
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "RefreshLibrary"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if err := rpc.Call(s.client, req, nil); err != nil {
+		s.logger.Error("unable to perform request to Kodi Client",
+			zap.String("method", "RefreshLibrary"),
+			zap.String("libraryID", libraryID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// convertKodiSources converts Kodi video sources to common Library model
+func (s *KodiLibraryService) convertKodiSources(sources []kodi.Source) []model.Library {
+	return lo.Map(sources, func(source kodi.Source, _ int) model.Library {
+		return model.Library{
+			ID:   source.File,
+			Type: constant.MediaTypeMovie,
+			Name: source.Label,
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package kodi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	client "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/client"
+	"github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/fake"
+	kodi "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/library"
+	kodiModel "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type KodiLibraryServiceTestSuite struct {
+	suite.Suite
+	server  *fake.Server
+	service media.LibraryService
+	ctx     context.Context
+}
+
+func (s *KodiLibraryServiceTestSuite) SetupTest() {
+	s.server = fake.NewServer()
+	s.ctx = context.Background()
+
+	kodiClient, err := client.NewKodiClient(
+		&config.Kodi{Url: s.server.URL},
+		&config.HTTPClient{Timeout: 5 * time.Second, MaxRetries: 0},
+		"/tmp/test-kodi-library.log",
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	s.service = kodi.NewKodiLibraryService(kodiClient, zap.NewNop())
+}
+
+func (s *KodiLibraryServiceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestKodiLibraryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(KodiLibraryServiceTestSuite))
+}
+
+func (s *KodiLibraryServiceTestSuite) TestGetLibraries_Success() {
+	// Arrange
+	s.server.HandleResult("Files.GetSources", kodiModel.SourcesResult{
+		Sources: []kodiModel.Source{
+			{File: "/media/movies/", Label: "Movies"},
+			{File: "/media/docs/", Label: "Documentaries"},
+		},
+	})
+
+	// Act
+	libraries, err := s.service.GetLibraries(s.ctx)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Library{
+		{ID: "/media/movies/", Type: constant.MediaTypeMovie, Name: "Movies"},
+		{ID: "/media/docs/", Type: constant.MediaTypeMovie, Name: "Documentaries"},
+	}, libraries)
+	calls := s.server.Calls("Files.GetSources")
+	s.Require().Len(calls, 1)
+	s.Equal("video", calls[0].Params["media"])
+}
+
+func (s *KodiLibraryServiceTestSuite) TestGetLibraries_Empty() {
+	// Arrange
+	s.server.HandleResult("Files.GetSources", map[string]any{})
+
+	// Act
+	libraries, err := s.service.GetLibraries(s.ctx)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(libraries)
+}
+
+func (s *KodiLibraryServiceTestSuite) TestGetLibraries_RPCError() {
+	// Arrange
+	s.server.HandleError("Files.GetSources", -32602, "Invalid params.")
+
+	// Act
+	libraries, err := s.service.GetLibraries(s.ctx)
+
+	// Assert
+	s.Nil(libraries)
+	s.EqualError(err, "kodi json-rpc error -32602: Invalid params.")
+}
+
+func (s *KodiLibraryServiceTestSuite) TestRefreshLibrary_Success() {
+	// Arrange
+	s.server.HandleResult("VideoLibrary.Scan", "OK")
+
+	// Act
+	err := s.service.RefreshLibrary(s.ctx, "/media/movies/", true)
+
+	// Assert
+	s.NoError(err)
+	calls := s.server.Calls("VideoLibrary.Scan")
+	s.Require().Len(calls, 1)
+	s.Equal("/media/movies/", calls[0].Params["directory"])
+}
+
+func (s *KodiLibraryServiceTestSuite) TestRefreshLibrary_RPCError() {
+	// Act
+	err := s.service.RefreshLibrary(s.ctx, "/media/movies/", false)
+
+	// Assert
+	s.EqualError(err, "kodi json-rpc error -32601: Method not found.")
+}
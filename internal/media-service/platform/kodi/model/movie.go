@@ -0,0 +1,32 @@
+package kodi
+
+type Movie struct {
+	ID            int               `json:"movieid"`
+	Label         string            `json:"label"`
+	Title         string            `json:"title"`
+	OriginalTitle string            `json:"originaltitle"`
+	Year          int               `json:"year"`
+	File          string            `json:"file"`
+	DateAdded     string            `json:"dateadded"`
+	Genre         []string          `json:"genre"`
+	Art           map[string]string `json:"art"`
+	UniqueID      map[string]string `json:"uniqueid"`
+	Ratings       map[string]Rating `json:"ratings"`
+}
+
+type Rating struct {
+	Rating  float32 `json:"rating"`
+	Votes   int     `json:"votes"`
+	Default bool    `json:"default"`
+}
+
+type MoviesResult struct {
+	Movies []Movie `json:"movies"`
+	Limits Limits  `json:"limits"`
+}
+
+type Limits struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+	Total int `json:"total"`
+}
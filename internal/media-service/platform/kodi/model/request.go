@@ -0,0 +1,11 @@
+package kodi
+
+const JSONRPCVersion = "2.0"
+
+// Request is a JSON-RPC 2.0 request sent to /jsonrpc
+type Request struct {
+	JSONRPC string         `json:"jsonrpc"`
+	Method  string         `json:"method"`
+	Params  map[string]any `json:"params,omitempty"`
+	ID      int            `json:"id"`
+}
@@ -0,0 +1,23 @@
+package kodi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Response is a JSON-RPC 2.0 response; Result is decoded by the caller
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int             `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *Error          `json:"error"`
+}
+
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("kodi json-rpc error %d: %s", e.Code, e.Message)
+}
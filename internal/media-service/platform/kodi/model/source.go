@@ -0,0 +1,11 @@
+package kodi
+
+// Source is a video source as returned by Files.GetSources
+type Source struct {
+	File  string `json:"file"`
+	Label string `json:"label"`
+}
+
+type SourcesResult struct {
+	Sources []Source `json:"sources"`
+}
@@ -0,0 +1,227 @@
+package kodi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	"github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/rpc"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/ports"
+)
+
+// KodiPosterService handles Kodi poster operations.
+// The original poster is kept next to the media file, as for Plex. The generated
+// poster is either picked up by Kodi as local artwork on the next scan or, when
+// updateArt is enabled, set as the movie poster through VideoLibrary.SetMovieDetails.
+// In the latter case the poster path must be readable by Kodi as well.
+type KodiPosterService struct {
+	client    media.MediaClient
+	logger    *zap.Logger
+	storage   ports.PosterStorage
+	updateArt bool
+}
+
+// NewKodiPosterService creates a new Kodi poster service
+func NewKodiPosterService(client media.MediaClient, logger *zap.Logger, storage ports.PosterStorage, updateArt bool) media.PosterService {
+	return &KodiPosterService{
+		client:    client,
+		logger:    logger,
+		storage:   storage,
+		updateArt: updateArt,
+	}
+}
+
+func (s *KodiPosterService) GetPosterDiskPosition(ctx context.Context, item model.Item, config *config.Library) (string, error) {
+	s.logger.Debug("Getting poster disk position..",
+		zap.String("Item ID", item.ID),
+	)
+
+	filePos, err := s.findExistingPoster(item.Media, config)
+	if err != nil {
+		return "", err
+	}
+	if filePos != "" {
+		return filePos, nil
+	}
+
+	posterData, err := s.getPoster(ctx, item.Poster)
+	if err != nil {
+		return "", err
+	}
+
+	posterFileExt, err := media.GetExtensionByMimeType(http.DetectContentType(posterData))
+	if err != nil {
+		return "", err
+	}
+
+	return media.GetPosterFilePosition(item.Media, posterFileExt, config.Path)
+}
+
+func (s *KodiPosterService) EnsurePosterExists(ctx context.Context, item model.Item, config *config.Library) error {
+	s.logger.Debug("Ensuring poster exists..",
+		zap.String("Item ID", item.ID),
+	)
+
+	filePos, err := s.findExistingPoster(item.Media, config)
+	if err != nil {
+		return err
+	}
+	if filePos != "" {
+		return nil // Poster already exists
+	}
+
+	posterData, err := s.getPoster(ctx, item.Poster)
+	if err != nil {
+		return err
+	}
+
+	posterFileExt, err := media.GetExtensionByMimeType(http.DetectContentType(posterData))
+	if err != nil {
+		return err
+	}
+
+	filePos, err = media.GetPosterFilePosition(item.Media, posterFileExt, config.Path)
+	if err != nil {
+		return err
+	}
+
+	return s.storage.SavePoster(filePos, posterData)
+}
+
+// PublishPoster sets the generated poster as the movie poster when updateArt is enabled
+func (s *KodiPosterService) PublishPoster(ctx context.Context, item model.Item, config *config.Library, posterFilePath string) error {
+	if !s.updateArt {
+		return nil
+	}
+
+	s.logger.Debug("Updating poster art..",
+		zap.String("Item ID", item.ID),
+		zap.String("posterFilePath", posterFilePath),
+	)
+
+	movieID, err := strconv.Atoi(item.ID)
+	if err != nil {
+		return fmt.Errorf("invalid kodi movie id %s: %w", item.ID, err)
+	}
+
+	req, err := rpc.NewRequest(ctx, s.client, "VideoLibrary.SetMovieDetails", map[string]any{
+		"movieid": movieID,
+		"art":     map[string]string{"poster": posterFilePath},
+	})
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "PublishPoster"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if err := rpc.Call(s.client, req, nil); err != nil {
+		s.logger.Error("unable to perform request to Kodi Client",
+			zap.String("method", "PublishPoster"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// RestorePoster sets the original poster kept next to the media file as the movie poster
+// when updateArt is enabled, otherwise the art was never changed through Kodi
+func (s *KodiPosterService) RestorePoster(ctx context.Context, item model.Item, config *config.Library, dryRun bool) (bool, error) {
+	if !s.updateArt {
+		return false, nil
+	}
+
+	originalPosterPath, err := s.findExistingPoster(item.Media, config)
+	if err != nil {
+		return false, err
+	}
+	if originalPosterPath == "" || dryRun {
+		return originalPosterPath != "", nil
+	}
+
+	return true, s.PublishPoster(ctx, item, config, originalPosterPath)
+}
+
+func (s *KodiPosterService) findExistingPoster(mediaModels []model.Media, config *config.Library) (string, error) {
+	supportedExt := []string{".jpeg", ".png"}
+
+	for _, ext := range supportedExt {
+		posterFilePos, err := media.GetPosterFilePosition(mediaModels, ext, config.Path)
+		if err != nil {
+			s.logger.Error("unable to find config dir",
+				zap.Any("media", mediaModels),
+				zap.String("library config path", config.Path),
+			)
+			return "", err
+		}
+
+		found, err := s.storage.CheckIfPosterExists(posterFilePos)
+		if err != nil {
+			return "", err
+		}
+
+		if found {
+			return posterFilePos, nil
+		}
+	}
+
+	return "", nil
+}
+
+// getPoster downloads an artwork through the Kodi image endpoint, which takes
+// the art URL (e.g. image://...) escaped as a single path segment
+func (s *KodiPosterService) getPoster(ctx context.Context, art string) ([]byte, error) {
+	s.logger.Debug("Getting poster..",
+		zap.String("art", art),
+	)
+
+	if art == "" {
+		return nil, fmt.Errorf("no poster available")
+	}
+
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := strings.TrimSuffix(baseUrl.String(), "/") + "/image/" + url.PathEscape(art)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "getPoster"),
+			zap.String("url", endpoint),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	resp, err := s.client.DoWithResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to Kodi Client",
+			zap.String("method", "getPoster"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		if err := Body.Close(); err != nil {
+			s.logger.Error("unable to close response body")
+		}
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to download poster %s, status code: %d", art, resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
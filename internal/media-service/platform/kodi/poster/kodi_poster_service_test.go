@@ -0,0 +1,203 @@
+package kodi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	configmodel "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	kodiclient "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/client"
+	"github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/fake"
+	kodiposter "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/poster"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	storagemock "github.com/zepollabot/media-rating-overlay/internal/ports/mocks"
+)
+
+const posterArt = "image://%2fmedia%2fmovies%2fMovie%20(2023)%2fposter.jpg/"
+
+var pngHeader = []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00, 0x00, 0x0D}
+
+type KodiPosterServiceTestSuite struct {
+	suite.Suite
+	server      *fake.Server
+	client      *kodiclient.KodiClient
+	mockStorage *storagemock.PosterStorage
+	service     media.PosterService
+	item        model.Item
+	libConfig   *configmodel.Library
+	ctx         context.Context
+}
+
+func (s *KodiPosterServiceTestSuite) SetupTest() {
+	s.server = fake.NewServer()
+	s.mockStorage = storagemock.NewPosterStorage(s.T())
+	s.ctx = context.Background()
+
+	var err error
+	s.client, err = kodiclient.NewKodiClient(
+		&configmodel.Kodi{Url: s.server.URL},
+		&configmodel.HTTPClient{Timeout: 5 * time.Second, MaxRetries: 0},
+		"/tmp/test-kodi-poster.log",
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	s.service = kodiposter.NewKodiPosterService(s.client, zap.NewNop(), s.mockStorage, true)
+
+	s.item = model.Item{
+		ID:     "12",
+		Poster: posterArt,
+		Media: []model.Media{
+			{File: []model.File{{Position: "/mnt/movies/Movie (2023)/Movie (2023).mkv"}}},
+		},
+	}
+	s.libConfig = &configmodel.Library{Path: "/mnt/movies"}
+}
+
+func (s *KodiPosterServiceTestSuite) TearDownTest() {
+	s.mockStorage.AssertExpectations(s.T())
+	s.server.Close()
+}
+
+func TestKodiPosterServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(KodiPosterServiceTestSuite))
+}
+
+func (s *KodiPosterServiceTestSuite) TestGetPosterDiskPosition_ExistingPosterFound() {
+	// Arrange
+	expectedPath := "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg"
+	s.mockStorage.On("CheckIfPosterExists", expectedPath).Return(true, nil).Once()
+
+	// Act
+	path, err := s.service.GetPosterDiskPosition(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(expectedPath, path)
+}
+
+func (s *KodiPosterServiceTestSuite) TestEnsurePosterExists_DownloadsAndSaves() {
+	// Arrange
+	s.server.HandleImage(posterArt, pngHeader)
+	s.mockStorage.On("CheckIfPosterExists", "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg").Return(false, nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", "/mnt/movies/Movie (2023)/Movie (2023)-original.png").Return(false, nil).Once()
+	s.mockStorage.On("SavePoster", "/mnt/movies/Movie (2023)/Movie (2023)-original.png", pngHeader).Return(nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *KodiPosterServiceTestSuite) TestEnsurePosterExists_DownloadError() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg").Return(false, nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", "/mnt/movies/Movie (2023)/Movie (2023)-original.png").Return(false, nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.EqualError(err, "unable to download poster "+posterArt+", status code: 404")
+}
+
+func (s *KodiPosterServiceTestSuite) TestEnsurePosterExists_NoPoster() {
+	// Arrange
+	s.item.Poster = ""
+	s.mockStorage.On("CheckIfPosterExists", "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg").Return(false, nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", "/mnt/movies/Movie (2023)/Movie (2023)-original.png").Return(false, nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.EqualError(err, "no poster available")
+}
+
+func (s *KodiPosterServiceTestSuite) TestPublishPoster_SetsMovieArt() {
+	// Arrange
+	s.server.HandleResult("VideoLibrary.SetMovieDetails", "OK")
+
+	// Act
+	err := s.service.PublishPoster(s.ctx, s.item, s.libConfig, "/mnt/movies/Movie (2023)/poster.png")
+
+	// Assert
+	s.NoError(err)
+	calls := s.server.Calls("VideoLibrary.SetMovieDetails")
+	s.Require().Len(calls, 1)
+	s.Equal(float64(12), calls[0].Params["movieid"])
+	s.Equal(map[string]any{"poster": "/mnt/movies/Movie (2023)/poster.png"}, calls[0].Params["art"])
+}
+
+func (s *KodiPosterServiceTestSuite) TestPublishPoster_RPCError() {
+	// Arrange
+	s.server.HandleError("VideoLibrary.SetMovieDetails", -32602, "Invalid params.")
+
+	// Act
+	err := s.service.PublishPoster(s.ctx, s.item, s.libConfig, "/mnt/movies/Movie (2023)/poster.png")
+
+	// Assert
+	s.EqualError(err, "kodi json-rpc error -32602: Invalid params.")
+}
+
+func (s *KodiPosterServiceTestSuite) TestPublishPoster_InvalidID() {
+	// Arrange
+	s.item.ID = "abc"
+
+	// Act
+	err := s.service.PublishPoster(s.ctx, s.item, s.libConfig, "/mnt/movies/Movie (2023)/poster.png")
+
+	// Assert
+	s.ErrorContains(err, "invalid kodi movie id abc")
+	s.Empty(s.server.Calls("VideoLibrary.SetMovieDetails"))
+}
+
+func (s *KodiPosterServiceTestSuite) TestPublishPoster_UpdateArtDisabled() {
+	// Arrange
+	service := kodiposter.NewKodiPosterService(s.client, zap.NewNop(), s.mockStorage, false)
+
+	// Act
+	err := service.PublishPoster(s.ctx, s.item, s.libConfig, "/mnt/movies/Movie (2023)/poster.png")
+
+	// Assert
+	s.NoError(err)
+	s.Empty(s.server.Calls("VideoLibrary.SetMovieDetails"))
+}
+
+func (s *KodiPosterServiceTestSuite) TestRestorePoster_SetsOriginalArt() {
+	// Arrange
+	originalPath := "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg"
+	s.mockStorage.On("CheckIfPosterExists", originalPath).Return(true, nil).Once()
+	s.server.HandleResult("VideoLibrary.SetMovieDetails", "OK")
+	restorer, ok := s.service.(media.PosterRestorer)
+	s.Require().True(ok)
+
+	// Act
+	restored, err := restorer.RestorePoster(s.ctx, s.item, s.libConfig, false)
+
+	// Assert
+	s.NoError(err)
+	s.True(restored)
+	calls := s.server.Calls("VideoLibrary.SetMovieDetails")
+	s.Require().Len(calls, 1)
+	s.Equal(map[string]any{"poster": originalPath}, calls[0].Params["art"])
+}
+
+func (s *KodiPosterServiceTestSuite) TestRestorePoster_UpdateArtDisabled() {
+	// Arrange
+	restorer, ok := kodiposter.NewKodiPosterService(s.client, zap.NewNop(), s.mockStorage, false).(media.PosterRestorer)
+	s.Require().True(ok)
+
+	// Act
+	restored, err := restorer.RestorePoster(s.ctx, s.item, s.libConfig, false)
+
+	// Assert
+	s.NoError(err)
+	s.False(restored)
+	s.Empty(s.server.Calls("VideoLibrary.SetMovieDetails"))
+}
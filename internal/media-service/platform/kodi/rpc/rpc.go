@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	kodi "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/kodi/model"
+)
+
+const endpointPath = "/jsonrpc"
+
+// NewRequest builds the HTTP request for a JSON-RPC call to the Kodi server
+func NewRequest(ctx context.Context, client media.MediaClient, method string, params map[string]any) (*http.Request, error) {
+	endpoint := client.GetBaseUrl().JoinPath(endpointPath)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := WriteRequest(req, &kodi.Request{
+		JSONRPC: kodi.JSONRPCVersion,
+		Method:  method,
+		Params:  params,
+		ID:      1,
+	}); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ReadRequest decodes the JSON-RPC payload of an HTTP request, leaving the body readable
+func ReadRequest(req *http.Request) (*kodi.Request, error) {
+	if req.Body == nil {
+		return nil, fmt.Errorf("request has no body")
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	var rpcRequest kodi.Request
+	if err := json.Unmarshal(body, &rpcRequest); err != nil {
+		return nil, err
+	}
+
+	return &rpcRequest, nil
+}
+
+// WriteRequest replaces the body of an HTTP request with the given JSON-RPC payload
+func WriteRequest(req *http.Request, rpcRequest *kodi.Request) error {
+	body, err := json.Marshal(rpcRequest)
+	if err != nil {
+		return err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
+
+	return nil
+}
+
+// Call performs a JSON-RPC request and decodes its result into result, when not nil
+func Call(client media.MediaClient, req *http.Request, result any) error {
+	response, err := client.DoWithMediaResponse(req)
+	if err != nil {
+		return err
+	}
+
+	kodiResponse, ok := response.(*kodi.Response)
+	if !ok {
+		return fmt.Errorf("invalid response type")
+	}
+
+	if result == nil || len(kodiResponse.Result) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(kodiResponse.Result, result)
+}
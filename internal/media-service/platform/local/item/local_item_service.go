@@ -0,0 +1,303 @@
+package local
+
+import (
+	"context"
+	"encoding/xml"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	local "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/local/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const dateAddedLayout = "2006-01-02 15:04:05"
+
+var videoExtensions = []string{".mkv", ".mp4", ".m4v", ".avi", ".mov", ".wmv", ".ts", ".m2ts"}
+
+// posterNames are the poster files looked up in the movie folder, in order;
+// {name} is replaced by the video file name without extension
+var posterNames = []string{"poster.jpg", "poster.jpeg", "poster.png", "folder.jpg", "folder.png", "{name}-poster.jpg", "{name}-poster.png"}
+
+// titleYearRegex matches the "Title (Year)" naming convention
+var titleYearRegex = regexp.MustCompile(`^(.+?)\s*\((\d{4})\)`)
+
+// ratingSources maps the rating names used in NFO files to the rating
+// services of this application, in the order they are reported
+var ratingSources = []struct {
+	nfoName string
+	rating  model.Rating
+}{
+	{"imdb", model.Rating{Name: constant.RatingServiceIMDB, Type: model.RatingServiceTypeAudience}},
+	{"themoviedb", model.Rating{Name: constant.RatingServiceTMDB, Type: model.RatingServiceTypeAudience}},
+	{"tmdb", model.Rating{Name: constant.RatingServiceTMDB, Type: model.RatingServiceTypeAudience}},
+	{"tomatometerallcritics", model.Rating{Name: constant.RatingServiceRottenTomatoes, Type: model.RatingServiceTypeCritic}},
+	{"tomatometerallaudience", model.Rating{Name: constant.RatingServiceRottenTomatoes, Type: model.RatingServiceTypeAudience}},
+	{"metacritic", model.Rating{Name: constant.RatingServiceMetacritic, Type: model.RatingServiceTypeCritic}},
+}
+
+type Clock interface {
+	Now() time.Time
+}
+
+// movie is a video file found on disk, along with its metadata
+type movie struct {
+	item   model.Item
+	genres []string
+}
+
+// LocalItemService builds items from the folders and files of a library
+type LocalItemService struct {
+	logger *zap.Logger
+	clock  Clock
+}
+
+// NewLocalItemService creates a new local item service
+func NewLocalItemService(logger *zap.Logger, clock Clock) media.ItemService {
+	return &LocalItemService{
+		logger: logger,
+		clock:  clock,
+	}
+}
+
+// GetItems walks the library folder and returns one item per video file.
+// Title and year come from the "Title (Year)" folder or file name, and are
+// overridden by the NFO file next to the video when present.
+func (s *LocalItemService) GetItems(ctx context.Context, library model.Library, config *config.Library) ([]model.Item, error) {
+	var movies []movie
+
+	err := filepath.WalkDir(library.ID, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entry.IsDir() || !s.isVideoFile(entry.Name()) {
+			return nil
+		}
+
+		movies = append(movies, s.buildMovie(library.ID, path, entry))
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("unable to walk library folder",
+			zap.String("method", "GetItems"),
+			zap.String("path", library.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	movies = s.applyFilters(movies, config)
+
+	return lo.Map(movies, func(m movie, _ int) model.Item {
+		return m.item
+	}), nil
+}
+
+func (s *LocalItemService) buildMovie(libraryPath string, path string, entry fs.DirEntry) movie {
+	name := media.GetFileNameWithoutExtTrimSuffix(entry.Name())
+	dir := filepath.Dir(path)
+
+	id, err := filepath.Rel(libraryPath, path)
+	if err != nil {
+		id = path
+	}
+
+	title, year := s.parseName(filepath.Base(dir))
+	if year == 0 {
+		title, year = s.parseName(name)
+	}
+
+	var addedAt time.Time
+	if info, err := entry.Info(); err == nil {
+		addedAt = info.ModTime().UTC()
+	}
+
+	poster := s.findPoster(dir, name)
+
+	m := movie{
+		item: model.Item{
+			ID:         id,
+			GUID:       "local://" + filepath.ToSlash(id),
+			Title:      title,
+			Type:       constant.MediaTypeMovie,
+			Year:       year,
+			Ratings:    []model.Rating{},
+			AddedAt:    addedAt,
+			UpdatedAt:  addedAt,
+			Poster:     poster,
+			Media:      []model.Media{{File: []model.File{{Position: path}}}},
+			IsEligible: poster != "" || s.hasOriginalPoster(dir, name),
+		},
+	}
+
+	nfo := s.readNFO(dir, name)
+	if nfo == nil {
+		return m
+	}
+
+	if nfo.Title != "" {
+		m.item.Title = nfo.Title
+	}
+	m.item.OriginalTitle = nfo.OriginalTitle
+	if nfo.Year != 0 {
+		m.item.Year = nfo.Year
+	}
+	if parsed, err := time.Parse(dateAddedLayout, nfo.DateAdded); err == nil {
+		m.item.AddedAt = parsed
+		m.item.UpdatedAt = parsed
+	}
+	m.item.Ratings = s.buildRatings(nfo)
+	m.item.ExternalIDs = model.ExternalIDs{
+		IMDB: nfo.GetUniqueID("imdb"),
+		TMDB: nfo.GetUniqueID("tmdb"),
+		TVDB: nfo.GetUniqueID("tvdb"),
+	}
+	m.genres = nfo.Genres
+
+	return m
+}
+
+// parseName splits a "Title (Year)" name; the year is 0 when the name does not match
+func (s *LocalItemService) parseName(name string) (string, int) {
+	subMatch := titleYearRegex.FindStringSubmatch(name)
+	if subMatch == nil {
+		return name, 0
+	}
+
+	year, _ := strconv.Atoi(subMatch[2])
+	return strings.TrimSpace(subMatch[1]), year
+}
+
+// readNFO reads <name>.nfo, falling back to movie.nfo, in the video folder
+func (s *LocalItemService) readNFO(dir string, name string) *local.NFO {
+	for _, nfoName := range []string{name + ".nfo", "movie.nfo"} {
+		data, err := os.ReadFile(filepath.Join(dir, nfoName))
+		if err != nil {
+			continue
+		}
+
+		var nfo local.NFO
+		if err := xml.Unmarshal(data, &nfo); err != nil {
+			s.logger.Debug("unable to parse nfo file",
+				zap.String("path", filepath.Join(dir, nfoName)),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		return &nfo
+	}
+
+	return nil
+}
+
+// buildRatings maps the NFO ratings to a 0-10 scale
+func (s *LocalItemService) buildRatings(nfo *local.NFO) []model.Rating {
+	ratings := []model.Rating{}
+
+	for _, source := range ratingSources {
+		nfoRating, found := lo.Find(nfo.Ratings, func(rating local.Rating) bool {
+			return rating.Name == source.nfoName
+		})
+		if !found || nfoRating.Value <= 0 {
+			continue
+		}
+
+		alreadyMapped := lo.ContainsBy(ratings, func(rating model.Rating) bool {
+			return rating.Name == source.rating.Name && rating.Type == source.rating.Type
+		})
+		if alreadyMapped {
+			continue
+		}
+
+		rating := source.rating
+		rating.Rating = nfoRating.Value
+		if nfoRating.Max > 0 && nfoRating.Max != 10 {
+			rating.Rating = nfoRating.Value * 10 / nfoRating.Max
+		}
+		ratings = append(ratings, rating)
+	}
+
+	return ratings
+}
+
+func (s *LocalItemService) findPoster(dir string, name string) string {
+	for _, posterName := range posterNames {
+		path := filepath.Join(dir, strings.ReplaceAll(posterName, "{name}", name))
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// hasOriginalPoster tells whether the original poster was already saved by a previous run
+func (s *LocalItemService) hasOriginalPoster(dir string, name string) bool {
+	return lo.SomeBy([]string{".jpeg", ".png"}, func(ext string) bool {
+		_, err := os.Stat(filepath.Join(dir, name+"-original"+ext))
+		return err == nil
+	})
+}
+
+func (s *LocalItemService) isVideoFile(fileName string) bool {
+	name := strings.ToLower(fileName)
+	if strings.HasSuffix(media.GetFileNameWithoutExtTrimSuffix(name), "-trailer") {
+		return false
+	}
+
+	return slices.Contains(videoExtensions, filepath.Ext(name))
+}
+
+// applyFilters applies the library filters in memory
+func (s *LocalItemService) applyFilters(movies []movie, config *config.Library) []movie {
+	filters := config.Filters
+
+	if len(filters.Year) > 0 {
+		movies = lo.Filter(movies, func(m movie, _ int) bool {
+			return slices.Contains(filters.Year, strconv.Itoa(m.item.Year))
+		})
+	}
+
+	if len(filters.Title) > 0 {
+		movies = lo.Filter(movies, func(m movie, _ int) bool {
+			return lo.SomeBy(filters.Title, func(title string) bool {
+				return strings.Contains(strings.ToLower(m.item.Title), strings.ToLower(title))
+			})
+		})
+	}
+
+	if len(filters.Genre) > 0 {
+		movies = lo.Filter(movies, func(m movie, _ int) bool {
+			return lo.SomeBy(filters.Genre, func(genre string) bool {
+				return lo.ContainsBy(m.genres, func(movieGenre string) bool {
+					return strings.EqualFold(movieGenre, genre)
+				})
+			})
+		})
+	}
+
+	if filters.AddedAt != "" {
+		if after, ok := media.GetAddedAfter(filters.AddedAt, s.clock.Now); ok {
+			movies = lo.Filter(movies, func(m movie, _ int) bool {
+				return m.item.AddedAt.After(after)
+			})
+		}
+	}
+
+	return movies
+}
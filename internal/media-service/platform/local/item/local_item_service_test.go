@@ -0,0 +1,194 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	local_mocks "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/local/item/mocks"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const testNFO = `<?xml version="1.0" encoding="UTF-8" standalone="yes" ?>
+<movie>
+    <title>Movie One Extended</title>
+    <originaltitle>Film Un</originaltitle>
+    <year>2021</year>
+    <dateadded>2024-01-02 03:04:05</dateadded>
+    <genre>Action</genre>
+    <genre>Drama</genre>
+    <uniqueid type="imdb" default="true">tt0000001</uniqueid>
+    <uniqueid type="tmdb">101</uniqueid>
+    <ratings>
+        <rating name="imdb" max="10" default="true">
+            <value>7.8</value>
+            <votes>1000</votes>
+        </rating>
+        <rating name="tomatometerallcritics" max="100">
+            <value>91</value>
+        </rating>
+        <rating name="metacritic" max="100">
+            <value>80</value>
+        </rating>
+    </ratings>
+</movie>`
+
+type LocalItemServiceTestSuite struct {
+	suite.Suite
+	root      string
+	mockClock *local_mocks.Clock
+	service   media.ItemService
+	library   model.Library
+	ctx       context.Context
+}
+
+func (s *LocalItemServiceTestSuite) SetupTest() {
+	s.root = s.T().TempDir()
+	s.mockClock = local_mocks.NewClock(s.T())
+	s.service = NewLocalItemService(zap.NewNop(), s.mockClock)
+	s.library = model.Library{ID: s.root, Name: "Movies", Type: constant.MediaTypeMovie}
+	s.ctx = context.Background()
+}
+
+func (s *LocalItemServiceTestSuite) TearDownTest() {
+	s.mockClock.AssertExpectations(s.T())
+}
+
+func TestLocalItemServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalItemServiceTestSuite))
+}
+
+func (s *LocalItemServiceTestSuite) writeFile(path string, content string) string {
+	fullPath := filepath.Join(s.root, path)
+	s.Require().NoError(os.MkdirAll(filepath.Dir(fullPath), 0755))
+	s.Require().NoError(os.WriteFile(fullPath, []byte(content), 0644))
+	return fullPath
+}
+
+func (s *LocalItemServiceTestSuite) TestGetItems_WithNFO() {
+	// Arrange
+	video := s.writeFile("Movie One (2020)/Movie One (2020).mkv", "")
+	poster := s.writeFile("Movie One (2020)/poster.jpg", "")
+	s.writeFile("Movie One (2020)/Movie One (2020).nfo", testNFO)
+
+	// Act
+	items, err := s.service.GetItems(s.ctx, s.library, &config.Library{})
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(items, 1)
+	addedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.Equal(model.Item{
+		ID:            filepath.Join("Movie One (2020)", "Movie One (2020).mkv"),
+		GUID:          "local://Movie One (2020)/Movie One (2020).mkv",
+		Title:         "Movie One Extended",
+		OriginalTitle: "Film Un",
+		Type:          constant.MediaTypeMovie,
+		Year:          2021,
+		Ratings: []model.Rating{
+			{Name: constant.RatingServiceIMDB, Type: model.RatingServiceTypeAudience, Rating: 7.8},
+			{Name: constant.RatingServiceRottenTomatoes, Type: model.RatingServiceTypeCritic, Rating: 9.1},
+			{Name: constant.RatingServiceMetacritic, Type: model.RatingServiceTypeCritic, Rating: 8},
+		},
+		AddedAt:     addedAt,
+		UpdatedAt:   addedAt,
+		Poster:      poster,
+		Media:       []model.Media{{File: []model.File{{Position: video}}}},
+		IsEligible:  true,
+		ExternalIDs: model.ExternalIDs{IMDB: "tt0000001", TMDB: "101"},
+	}, items[0])
+}
+
+func (s *LocalItemServiceTestSuite) TestGetItems_FromNamingConvention() {
+	// Arrange
+	s.writeFile("Collection/Movie Two (1999).mp4", "")
+	s.writeFile("Collection/Movie Two (1999)-poster.png", "")
+	s.writeFile("Unknown/some-file.avi", "")
+	s.writeFile("Unknown/some-file-trailer.mkv", "")
+	s.writeFile("Unknown/readme.txt", "")
+
+	// Act
+	items, err := s.service.GetItems(s.ctx, s.library, &config.Library{})
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(items, 2)
+	s.Equal("Movie Two", items[0].Title)
+	s.Equal(1999, items[0].Year)
+	s.True(items[0].IsEligible)
+	s.Empty(items[0].Ratings)
+	s.Equal("some-file", items[1].Title)
+	s.Equal(0, items[1].Year)
+	s.Empty(items[1].Poster)
+	s.False(items[1].IsEligible)
+}
+
+func (s *LocalItemServiceTestSuite) TestGetItems_EligibleWithOriginalPoster() {
+	// Arrange
+	s.writeFile("Movie (2020)/Movie (2020).mkv", "")
+	s.writeFile("Movie (2020)/Movie (2020)-original.jpeg", "")
+
+	// Act
+	items, err := s.service.GetItems(s.ctx, s.library, &config.Library{})
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(items, 1)
+	s.Empty(items[0].Poster)
+	s.True(items[0].IsEligible)
+}
+
+func (s *LocalItemServiceTestSuite) TestGetItems_Filters() {
+	// Arrange
+	s.writeFile("Movie One (2020)/Movie One (2020).mkv", "")
+	s.writeFile("Movie One (2020)/movie.nfo", testNFO)
+	s.writeFile("Alien (1979)/Alien (1979).mkv", "")
+	s.writeFile("Aliens (1986)/Aliens (1986).mkv", "")
+
+	s.T().Run("Year and title", func(t *testing.T) {
+		items, err := s.service.GetItems(s.ctx, s.library, &config.Library{
+			Filters: config.Filter{Year: []string{"1979", "1986"}, Title: []string{"alien"}},
+		})
+		s.NoError(err)
+		s.Equal([]string{"Alien", "Aliens"}, []string{items[0].Title, items[1].Title})
+	})
+
+	s.T().Run("Genre", func(t *testing.T) {
+		items, err := s.service.GetItems(s.ctx, s.library, &config.Library{
+			Filters: config.Filter{Genre: []string{"drama"}},
+		})
+		s.NoError(err)
+		s.Require().Len(items, 1)
+		s.Equal("Movie One Extended", items[0].Title)
+	})
+
+	s.T().Run("Added at", func(t *testing.T) {
+		s.mockClock.On("Now").Return(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)).Once()
+		items, err := s.service.GetItems(s.ctx, s.library, &config.Library{
+			Filters: config.Filter{AddedAt: "last_30_days"},
+		})
+		s.NoError(err)
+		// Files without NFO use their modification time, which is now
+		s.Len(items, 3)
+	})
+}
+
+func (s *LocalItemServiceTestSuite) TestGetItems_MissingFolder() {
+	// Arrange
+	library := model.Library{ID: filepath.Join(s.root, "missing")}
+
+	// Act
+	items, err := s.service.GetItems(s.ctx, library, &config.Library{})
+
+	// Assert
+	s.Nil(items)
+	s.Error(err)
+}
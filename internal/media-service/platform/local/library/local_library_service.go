@@ -0,0 +1,62 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// LocalLibraryService exposes the configured folders as movie libraries,
+// identified by their path
+type LocalLibraryService struct {
+	libraries []config.Library
+	logger    *zap.Logger
+}
+
+// NewLocalLibraryService creates a new local library service
+func NewLocalLibraryService(libraries []config.Library, logger *zap.Logger) media.LibraryService {
+	return &LocalLibraryService{
+		libraries: libraries,
+		logger:    logger,
+	}
+}
+
+// GetLibraries returns the configured libraries whose folder exists
+func (s *LocalLibraryService) GetLibraries(ctx context.Context) ([]model.Library, error) {
+	libraries := lo.Filter(s.libraries, func(library config.Library, _ int) bool {
+		info, err := os.Stat(library.Path)
+		if err != nil || !info.IsDir() {
+			s.logger.Warn("library folder not found",
+				zap.String("library", library.Name),
+				zap.String("path", library.Path),
+			)
+			return false
+		}
+		return true
+	})
+
+	return lo.Map(libraries, func(library config.Library, _ int) model.Library {
+		return model.Library{
+			ID:   library.Path,
+			Type: constant.MediaTypeMovie,
+			Name: library.Name,
+		}
+	}), nil
+}
+
+// RefreshLibrary has nothing to refresh, posters are read from disk on every run
+func (s *LocalLibraryService) RefreshLibrary(ctx context.Context, libraryID string, force bool) error {
+	if _, err := os.Stat(libraryID); err != nil {
+		return fmt.Errorf("unable to refresh library %s: %w", libraryID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package local_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	local "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/local/library"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type LocalLibraryServiceTestSuite struct {
+	suite.Suite
+	root string
+	ctx  context.Context
+}
+
+func (s *LocalLibraryServiceTestSuite) SetupTest() {
+	s.root = s.T().TempDir()
+	s.ctx = context.Background()
+}
+
+func TestLocalLibraryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalLibraryServiceTestSuite))
+}
+
+func (s *LocalLibraryServiceTestSuite) TestGetLibraries_SkipsMissingFolders() {
+	// Arrange
+	missing := filepath.Join(s.root, "missing")
+	service := local.NewLocalLibraryService([]config.Library{
+		{Name: "Movies", Path: s.root},
+		{Name: "Missing", Path: missing},
+	}, zap.NewNop())
+
+	// Act
+	libraries, err := service.GetLibraries(s.ctx)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Library{
+		{ID: s.root, Type: constant.MediaTypeMovie, Name: "Movies"},
+	}, libraries)
+}
+
+func (s *LocalLibraryServiceTestSuite) TestGetLibraries_Empty() {
+	// Arrange
+	service := local.NewLocalLibraryService([]config.Library{}, zap.NewNop())
+
+	// Act
+	libraries, err := service.GetLibraries(s.ctx)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(libraries)
+}
+
+func (s *LocalLibraryServiceTestSuite) TestRefreshLibrary() {
+	// Arrange
+	service := local.NewLocalLibraryService([]config.Library{}, zap.NewNop())
+
+	// Act & Assert
+	s.NoError(service.RefreshLibrary(s.ctx, s.root, true))
+	s.ErrorContains(service.RefreshLibrary(s.ctx, filepath.Join(s.root, "missing"), false), "unable to refresh library")
+}
@@ -0,0 +1,37 @@
+package local
+
+import "encoding/xml"
+
+// NFO is the subset of a Kodi-style movie .nfo file used to describe an item
+type NFO struct {
+	XMLName       xml.Name   `xml:"movie"`
+	Title         string     `xml:"title"`
+	OriginalTitle string     `xml:"originaltitle"`
+	Year          int        `xml:"year"`
+	DateAdded     string     `xml:"dateadded"`
+	Genres        []string   `xml:"genre"`
+	UniqueIDs     []UniqueID `xml:"uniqueid"`
+	Ratings       []Rating   `xml:"ratings>rating"`
+}
+
+type UniqueID struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type Rating struct {
+	Name  string  `xml:"name,attr"`
+	Max   float32 `xml:"max,attr"`
+	Value float32 `xml:"value"`
+	Votes int     `xml:"votes"`
+}
+
+// GetUniqueID returns the identifier of the given type, if any
+func (n *NFO) GetUniqueID(idType string) string {
+	for _, uniqueID := range n.UniqueIDs {
+		if uniqueID.Type == idType {
+			return uniqueID.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,126 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/ports"
+)
+
+// LocalPosterService handles posters stored next to the media files.
+// The poster found in the movie folder is copied to the "-original" file on the
+// first run, so the generated poster never becomes the source of a later run.
+type LocalPosterService struct {
+	logger  *zap.Logger
+	storage ports.PosterStorage
+}
+
+// NewLocalPosterService creates a new local poster service
+func NewLocalPosterService(logger *zap.Logger, storage ports.PosterStorage) media.PosterService {
+	return &LocalPosterService{
+		logger:  logger,
+		storage: storage,
+	}
+}
+
+func (s *LocalPosterService) GetPosterDiskPosition(ctx context.Context, item model.Item, config *config.Library) (string, error) {
+	s.logger.Debug("Getting poster disk position..",
+		zap.String("Item ID", item.ID),
+	)
+
+	filePos, err := s.findExistingPoster(item.Media, config)
+	if err != nil {
+		return "", err
+	}
+	if filePos != "" {
+		return filePos, nil
+	}
+
+	posterData, err := s.getPoster(item.Poster)
+	if err != nil {
+		return "", err
+	}
+
+	posterFileExt, err := media.GetExtensionByMimeType(http.DetectContentType(posterData))
+	if err != nil {
+		return "", err
+	}
+
+	return media.GetPosterFilePosition(item.Media, posterFileExt, config.Path)
+}
+
+func (s *LocalPosterService) EnsurePosterExists(ctx context.Context, item model.Item, config *config.Library) error {
+	s.logger.Debug("Ensuring poster exists..",
+		zap.String("Item ID", item.ID),
+	)
+
+	filePos, err := s.findExistingPoster(item.Media, config)
+	if err != nil {
+		return err
+	}
+	if filePos != "" {
+		return nil // Poster already exists
+	}
+
+	posterData, err := s.getPoster(item.Poster)
+	if err != nil {
+		return err
+	}
+
+	posterFileExt, err := media.GetExtensionByMimeType(http.DetectContentType(posterData))
+	if err != nil {
+		return err
+	}
+
+	filePos, err = media.GetPosterFilePosition(item.Media, posterFileExt, config.Path)
+	if err != nil {
+		return err
+	}
+
+	return s.storage.SavePoster(filePos, posterData)
+}
+
+// PublishPoster does nothing, the generated poster is already saved next to the media file
+func (s *LocalPosterService) PublishPoster(ctx context.Context, item model.Item, config *config.Library, posterFilePath string) error {
+	return nil
+}
+
+func (s *LocalPosterService) findExistingPoster(mediaModels []model.Media, config *config.Library) (string, error) {
+	supportedExt := []string{".jpeg", ".png"}
+
+	for _, ext := range supportedExt {
+		posterFilePos, err := media.GetPosterFilePosition(mediaModels, ext, config.Path)
+		if err != nil {
+			s.logger.Error("unable to find config dir",
+				zap.Any("media", mediaModels),
+				zap.String("library config path", config.Path),
+			)
+			return "", err
+		}
+
+		found, err := s.storage.CheckIfPosterExists(posterFilePos)
+		if err != nil {
+			return "", err
+		}
+
+		if found {
+			return posterFilePos, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (s *LocalPosterService) getPoster(posterPath string) ([]byte, error) {
+	if posterPath == "" {
+		return nil, fmt.Errorf("no poster available")
+	}
+
+	return s.storage.ReadPoster(posterPath)
+}
@@ -0,0 +1,142 @@
+package local_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	configmodel "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	localposter "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/local/poster"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	storagemock "github.com/zepollabot/media-rating-overlay/internal/ports/mocks"
+)
+
+var pngHeader = []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00, 0x00, 0x0D}
+
+const (
+	originalJpeg = "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg"
+	originalPng  = "/mnt/movies/Movie (2023)/Movie (2023)-original.png"
+	sourcePoster = "/mnt/movies/Movie (2023)/poster.jpg"
+)
+
+type LocalPosterServiceTestSuite struct {
+	suite.Suite
+	mockStorage *storagemock.PosterStorage
+	service     media.PosterService
+	item        model.Item
+	libConfig   *configmodel.Library
+	ctx         context.Context
+}
+
+func (s *LocalPosterServiceTestSuite) SetupTest() {
+	s.mockStorage = storagemock.NewPosterStorage(s.T())
+	s.service = localposter.NewLocalPosterService(zap.NewNop(), s.mockStorage)
+	s.item = model.Item{
+		ID:     "Movie (2023)/Movie (2023).mkv",
+		Poster: sourcePoster,
+		Media: []model.Media{
+			{File: []model.File{{Position: "/mnt/movies/Movie (2023)/Movie (2023).mkv"}}},
+		},
+	}
+	s.libConfig = &configmodel.Library{Path: "/mnt/movies"}
+	s.ctx = context.Background()
+}
+
+func (s *LocalPosterServiceTestSuite) TearDownTest() {
+	s.mockStorage.AssertExpectations(s.T())
+}
+
+func TestLocalPosterServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalPosterServiceTestSuite))
+}
+
+func (s *LocalPosterServiceTestSuite) TestGetPosterDiskPosition_ExistingPosterFound() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", originalJpeg).Return(true, nil).Once()
+
+	// Act
+	path, err := s.service.GetPosterDiskPosition(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(originalJpeg, path)
+}
+
+func (s *LocalPosterServiceTestSuite) TestGetPosterDiskPosition_FromSourcePoster() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", originalJpeg).Return(false, nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", originalPng).Return(false, nil).Once()
+	s.mockStorage.On("ReadPoster", sourcePoster).Return(pngHeader, nil).Once()
+
+	// Act
+	path, err := s.service.GetPosterDiskPosition(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(originalPng, path)
+}
+
+func (s *LocalPosterServiceTestSuite) TestEnsurePosterExists_AlreadyExists() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", originalJpeg).Return(false, nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", originalPng).Return(true, nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *LocalPosterServiceTestSuite) TestEnsurePosterExists_CopiesSourcePoster() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", originalJpeg).Return(false, nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", originalPng).Return(false, nil).Once()
+	s.mockStorage.On("ReadPoster", sourcePoster).Return(pngHeader, nil).Once()
+	s.mockStorage.On("SavePoster", originalPng, pngHeader).Return(nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *LocalPosterServiceTestSuite) TestEnsurePosterExists_ReadError() {
+	// Arrange
+	expectedErr := errors.New("read error")
+	s.mockStorage.On("CheckIfPosterExists", originalJpeg).Return(false, nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", originalPng).Return(false, nil).Once()
+	s.mockStorage.On("ReadPoster", sourcePoster).Return(nil, expectedErr).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.Equal(expectedErr, err)
+}
+
+func (s *LocalPosterServiceTestSuite) TestEnsurePosterExists_NoPoster() {
+	// Arrange
+	s.item.Poster = ""
+	s.mockStorage.On("CheckIfPosterExists", originalJpeg).Return(false, nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", originalPng).Return(false, nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(s.ctx, s.item, s.libConfig)
+
+	// Assert
+	s.EqualError(err, "no poster available")
+}
+
+func (s *LocalPosterServiceTestSuite) TestPublishPoster_NoOp() {
+	// Act
+	err := s.service.PublishPoster(s.ctx, s.item, s.libConfig, "/mnt/movies/Movie (2023)/Movie (2023)-poster.png")
+
+	// Assert
+	s.NoError(err)
+}
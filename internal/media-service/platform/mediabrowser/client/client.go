@@ -0,0 +1,140 @@
+package mediabrowser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// MediaBrowserClient implements the MediaClient interface for Emby and Jellyfin
+type MediaBrowserClient struct {
+	server     mediabrowser.Server
+	httpClient common.ServiceHTTPClient
+	apiKey     string
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewMediaBrowserClient creates a new client of the given MediaBrowser server
+func NewMediaBrowserClient(server mediabrowser.Server, serverUrl string, apiKey string, httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*MediaBrowserClient, error) {
+	if serverUrl == "" {
+		err := fmt.Errorf("%s.url is required", server.ConfigKey)
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	baseUrl, err := url.Parse(serverUrl)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error parsing %s URL", server.Name), zap.Error(err))
+		return nil, err
+	}
+
+	httpClient := NewMediaBrowserHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		logger.Error("error setting up logging", zap.Error(err))
+		return nil, err
+	}
+
+	return &MediaBrowserClient{
+		server:     server,
+		httpClient: httpClient,
+		apiKey:     apiKey,
+		baseUrl:    *baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+// DoWithResponse performs a request and returns the raw HTTP response
+func (c *MediaBrowserClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	if err := c.setupRequest(request); err != nil {
+		c.logger.Error("unable to setup request", zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("unable to perform request to %s API", c.server.Name),
+			zap.String("url", request.URL.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithMediaResponse performs a request and returns a parsed MediaBrowser response
+func (c *MediaBrowserClient) DoWithMediaResponse(request *http.Request) (media.MediaResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseMediaBrowserResponse(resp)
+}
+
+// setupRequest configures the request with common headers and authentication.
+// A Content-Type already set by the caller (e.g. for image uploads) is preserved.
+func (c *MediaBrowserClient) setupRequest(request *http.Request) error {
+	if request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	request.Header.Set("Accept", "application/json")
+	c.server.Authenticate(request, c.apiKey)
+
+	return nil
+}
+
+// parseMediaBrowserResponse handles the MediaBrowser API response parsing
+func (c *MediaBrowserClient) parseMediaBrowserResponse(resp *http.Response) (*mediabrowser.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		err := errors.New(model.NotAuthorized)
+		c.logger.Error(
+			fmt.Sprintf("your %s API key is invalid or revoked, please use a valid API key", c.server.Name),
+			zap.Error(err),
+		)
+		return nil, err
+	case http.StatusNotFound:
+		err := errors.New(model.NotFound)
+		c.logger.Error(fmt.Sprintf("%s resource not found", c.server.Name), zap.Error(err))
+		return nil, err
+	}
+
+	var mediaBrowserResponse mediabrowser.Response
+	if err := json.NewDecoder(resp.Body).Decode(&mediaBrowserResponse); err != nil {
+		c.logger.Error(fmt.Sprintf("unable to decode %s API response", c.server.Name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &mediaBrowserResponse, nil
+}
+
+// GetBaseUrl returns the base URL for the MediaBrowser server
+func (c *MediaBrowserClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient (primarily for testing)
+func (c *MediaBrowserClient) SetHttpClient(client common.HTTPClient) {
+	c.httpClient = client
+}
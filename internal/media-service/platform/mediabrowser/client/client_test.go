@@ -0,0 +1,234 @@
+package mediabrowser
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	httpclientmocks "github.com/zepollabot/media-rating-overlay/internal/httpclient/mocks"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const (
+	testApiKey  = "test-api-key"
+	testURL     = "http://localhost:8096"
+	logFilePath = "/tmp/test-mediabrowser-client.log"
+)
+
+// MediaBrowserClientTestSuite runs against each MediaBrowser server
+type MediaBrowserClientTestSuite struct {
+	suite.Suite
+	server           mediabrowser.Server
+	authHeaders      map[string]string
+	mockHTTPClient   *httpclientmocks.ServiceHTTPClient
+	client           *MediaBrowserClient
+	logger           *zap.Logger
+	httpClientConfig *config.HTTPClient
+}
+
+func (s *MediaBrowserClientTestSuite) SetupTest() {
+	s.logger = zap.NewNop()
+	s.mockHTTPClient = new(httpclientmocks.ServiceHTTPClient)
+
+	s.httpClientConfig = &config.HTTPClient{
+		Timeout:    10,
+		MaxRetries: 3,
+	}
+
+	var err error
+	s.client, err = NewMediaBrowserClient(s.server, testURL, testApiKey, s.httpClientConfig, logFilePath, s.logger)
+	s.Require().NoError(err)
+	s.client.httpClient = s.mockHTTPClient // Replace with mock
+}
+
+func (s *MediaBrowserClientTestSuite) TearDownTest() {
+	s.mockHTTPClient.AssertExpectations(s.T())
+}
+
+func TestEmbyClientTestSuite(t *testing.T) {
+	suite.Run(t, &MediaBrowserClientTestSuite{
+		server: mediabrowser.Emby,
+		authHeaders: map[string]string{
+			"X-Emby-Token":  testApiKey,
+			"X-Emby-Client": "media-rating-overlay",
+			"Authorization": "",
+		},
+	})
+}
+
+func TestJellyfinClientTestSuite(t *testing.T) {
+	suite.Run(t, &MediaBrowserClientTestSuite{
+		server: mediabrowser.Jellyfin,
+		authHeaders: map[string]string{
+			"Authorization": `MediaBrowser Client="media-rating-overlay", Device="media-rating-overlay", DeviceId="media-rating-overlay", Version="1.0.0", Token="test-api-key"`,
+			"X-Emby-Token":  "",
+		},
+	})
+}
+
+func (s *MediaBrowserClientTestSuite) TestNewMediaBrowserClient_Success() {
+	client, err := NewMediaBrowserClient(s.server, testURL, testApiKey, s.httpClientConfig, logFilePath, s.logger)
+	s.NoError(err)
+	s.NotNil(client)
+	s.Equal(testApiKey, client.apiKey)
+	s.Equal(testURL, client.GetBaseUrl().String())
+}
+
+func (s *MediaBrowserClientTestSuite) TestNewMediaBrowserClient_EmptyURL() {
+	client, err := NewMediaBrowserClient(s.server, "", testApiKey, s.httpClientConfig, logFilePath, s.logger)
+
+	s.Error(err)
+	s.Nil(client)
+	s.EqualError(err, s.server.ConfigKey+".url is required")
+}
+
+func (s *MediaBrowserClientTestSuite) TestNewMediaBrowserClient_InvalidURL() {
+	client, err := NewMediaBrowserClient(s.server, ":invalid-url", testApiKey, s.httpClientConfig, logFilePath, s.logger)
+
+	s.Error(err)
+	s.Nil(client)
+	s.Contains(err.Error(), "missing protocol scheme")
+}
+
+func (s *MediaBrowserClientTestSuite) TestDoWithResponse_SetsHeaders() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodGet, testURL+s.server.Path("/Items"), nil)
+	expectedResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		request := args.Get(0).(*http.Request)
+		s.Equal("application/json", request.Header.Get("Content-Type"))
+		s.Equal("application/json", request.Header.Get("Accept"))
+		for name, value := range s.authHeaders {
+			s.Equal(value, request.Header.Get(name), name)
+		}
+	}).Return(expectedResp, nil).Once()
+
+	// Act
+	resp, err := s.client.DoWithResponse(req)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(expectedResp, resp)
+}
+
+func (s *MediaBrowserClientTestSuite) TestDoWithResponse_KeepsContentType() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodPost, testURL+s.server.Path("/Items/1/Images/Primary"), nil)
+	req.Header.Set("Content-Type", "image/png")
+	expectedResp := &http.Response{
+		StatusCode: http.StatusNoContent,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		request := args.Get(0).(*http.Request)
+		s.Equal("image/png", request.Header.Get("Content-Type"))
+	}).Return(expectedResp, nil).Once()
+
+	// Act
+	_, err := s.client.DoWithResponse(req)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *MediaBrowserClientTestSuite) TestDoWithResponse_HTTPClientError() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodGet, testURL+s.server.Path("/Items"), nil)
+	expectedErr := errors.New("http client error")
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expectedErr).Once()
+
+	// Act
+	resp, err := s.client.DoWithResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.Equal(expectedErr, err)
+}
+
+func (s *MediaBrowserClientTestSuite) TestDoWithMediaResponse_Success() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodGet, testURL+s.server.Path("/Items"), nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body: io.NopCloser(strings.NewReader(`{
+			"Items": [{ "Id": "abc", "Name": "Test Movie", "Type": "Movie" }],
+			"TotalRecordCount": 1,
+			"StartIndex": 0
+		}`)),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.client.DoWithMediaResponse(req)
+
+	// Assert
+	s.NoError(err)
+	mediaBrowserResp, ok := resp.(*mediabrowser.Response)
+	s.Require().True(ok)
+	s.Equal(1, mediaBrowserResp.TotalRecordCount)
+	s.Require().Len(mediaBrowserResp.Items, 1)
+	s.Equal("abc", mediaBrowserResp.Items[0].ID)
+	s.Equal("Test Movie", mediaBrowserResp.Items[0].Name)
+}
+
+func (s *MediaBrowserClientTestSuite) TestDoWithMediaResponse_Unauthorized() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodGet, testURL+s.server.Path("/Items"), nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusUnauthorized,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.client.DoWithMediaResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.EqualError(err, model.NotAuthorized)
+}
+
+func (s *MediaBrowserClientTestSuite) TestDoWithMediaResponse_NotFound() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodGet, testURL+s.server.Path("/Items"), nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.client.DoWithMediaResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.EqualError(err, model.NotFound)
+}
+
+func (s *MediaBrowserClientTestSuite) TestDoWithMediaResponse_InvalidJSON() {
+	// Arrange
+	req, _ := http.NewRequest(http.MethodGet, testURL+s.server.Path("/Items"), nil)
+	httpResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{invalid")),
+	}
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(httpResp, nil).Once()
+
+	// Act
+	resp, err := s.client.DoWithMediaResponse(req)
+
+	// Assert
+	s.Nil(resp)
+	s.Error(err)
+}
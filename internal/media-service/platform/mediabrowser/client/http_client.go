@@ -0,0 +1,25 @@
+package mediabrowser
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// MediaBrowserHTTPClient implements the HTTPClient interface for MediaBrowser servers
+type MediaBrowserHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewMediaBrowserHTTPClient creates a new MediaBrowser HTTP client
+func NewMediaBrowserHTTPClient(timeout time.Duration, maxRetries int) *MediaBrowserHTTPClient {
+	return &MediaBrowserHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *MediaBrowserHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
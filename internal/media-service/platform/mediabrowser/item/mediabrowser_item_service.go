@@ -0,0 +1,195 @@
+package mediabrowser
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const itemFields = "Path,DateCreated,ProviderIds,OriginalTitle,CriticRating,CommunityRating,ProductionYear"
+
+type Clock interface {
+	Now() time.Time
+}
+
+// MediaBrowserItemService handles Emby and Jellyfin item operations
+type MediaBrowserItemService struct {
+	server         mediabrowser.Server
+	client         media.MediaClient
+	logger         *zap.Logger
+	filtersService media.FilterService
+	clock          Clock
+}
+
+// NewMediaBrowserItemService creates a new item service of the given MediaBrowser server
+func NewMediaBrowserItemService(server mediabrowser.Server, client media.MediaClient, logger *zap.Logger, filtersService media.FilterService, clock Clock) media.ItemService {
+	return &MediaBrowserItemService{
+		server:         server,
+		client:         client,
+		logger:         logger,
+		filtersService: filtersService,
+		clock:          clock,
+	}
+}
+
+// GetItems retrieves the movies of a specific library
+func (s *MediaBrowserItemService) GetItems(ctx context.Context, library model.Library, config *config.Library) ([]model.Item, error) {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(s.server.Path("/Items"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetItems"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Set("ParentId", library.ID)
+	q.Set("Recursive", "true")
+	q.Set("IncludeItemTypes", "Movie")
+	q.Set("Fields", itemFields)
+	req.URL.RawQuery = q.Encode()
+
+	filters := s.filtersService.ConvertConfigFiltersToRequestFilters(config)
+	s.filtersService.ApplyFiltersToRequest(req, filters)
+
+	response, err := s.client.DoWithMediaResponse(req)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("unable to perform request to %s Client", s.server.Name),
+			zap.String("method", "GetItems"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	mediaBrowserResponse, ok := response.(*mediabrowser.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to mediabrowser.Response",
+			zap.String("method", "GetItems"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	items := s.convertMediaBrowserItems(mediaBrowserResponse.Items)
+	if s.server.FilterAddedAtOnItems {
+		items = s.filterByAddedAt(items, config)
+	}
+
+	return items, nil
+}
+
+// filterByAddedAt applies the added_at filter, for servers that do not support it server side
+func (s *MediaBrowserItemService) filterByAddedAt(items []model.Item, config *config.Library) []model.Item {
+	if config.Filters.AddedAt == "" {
+		return items
+	}
+
+	after, ok := media.GetAddedAfter(config.Filters.AddedAt, s.clock.Now)
+	if !ok {
+		return items
+	}
+
+	return lo.Filter(items, func(item model.Item, _ int) bool {
+		return item.AddedAt.After(after)
+	})
+}
+
+// convertMediaBrowserItems converts MediaBrowser items to common Item model
+func (s *MediaBrowserItemService) convertMediaBrowserItems(items []mediabrowser.Entry) []model.Item {
+	var convertedItems []model.Item
+	lo.ForEach(items, func(entry mediabrowser.Entry, index int) {
+		addedAt := s.parseDate(entry.DateCreated)
+
+		convertedItems = append(convertedItems, model.Item{
+			ID:            entry.ID,
+			GUID:          entry.ID,
+			Title:         entry.Name,
+			OriginalTitle: entry.OriginalTitle,
+			Type:          s.convertItemType(entry.Type),
+			Year:          entry.ProductionYear,
+			Ratings:       s.buildRatings(entry),
+			AddedAt:       addedAt,
+			UpdatedAt:     addedAt,
+			Poster:        s.server.Path(fmt.Sprintf("/Items/%s/Images/Primary", entry.ID)),
+			Media:         s.convertMediaBrowserMedia(entry.Path),
+			IsEligible:    s.isEligibleForPoster(entry),
+		})
+	})
+	return convertedItems
+}
+
+// buildRatings maps the ratings the server already stores. CriticRating holds the
+// Rotten Tomatoes tomatometer (0-100); CommunityRating has no reliable source and
+// is left to the rating services.
+func (s *MediaBrowserItemService) buildRatings(entry mediabrowser.Entry) []model.Rating {
+	ratings := []model.Rating{}
+
+	if entry.CriticRating > 0 {
+		ratings = append(ratings, model.Rating{
+			Name:   constant.RatingServiceRottenTomatoes,
+			Type:   model.RatingServiceTypeCritic,
+			Rating: entry.CriticRating / 10,
+		})
+	}
+
+	return ratings
+}
+
+func (s *MediaBrowserItemService) parseDate(value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		s.logger.Debug("unable to parse date",
+			zap.String("value", value),
+			zap.Error(err),
+		)
+		return time.Time{}
+	}
+
+	return parsed.UTC()
+}
+
+func (s *MediaBrowserItemService) convertItemType(itemType string) string {
+	switch itemType {
+	case "Movie":
+		return constant.MediaTypeMovie
+	case "Series":
+		return constant.MediaTypeShow
+	default:
+		return strings.ToLower(itemType)
+	}
+}
+
+func (s *MediaBrowserItemService) isEligibleForPoster(entry mediabrowser.Entry) bool {
+	return entry.Type == "Movie" &&
+		entry.Path != "" &&
+		entry.LocationType != "Virtual"
+}
+
+func (s *MediaBrowserItemService) convertMediaBrowserMedia(path string) []model.Media {
+	if path == "" {
+		return []model.Media{}
+	}
+
+	return []model.Media{
+		{File: []model.File{{Position: path}}},
+	}
+}
@@ -0,0 +1,200 @@
+package mediabrowser_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	configmodel "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediamocks "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	mediabrowseritem "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/item"
+	mediabrowsermocks "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/item/mocks"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// MediaBrowserItemServiceTestSuite runs against each MediaBrowser server
+type MediaBrowserItemServiceTestSuite struct {
+	suite.Suite
+	server            mediabrowser.Server
+	mockMediaClient   *mediamocks.MediaClient
+	mockFilterService *mediamocks.FilterService
+	mockClock         *mediabrowsermocks.Clock
+	service           media.ItemService
+	baseURL           *url.URL
+}
+
+func (s *MediaBrowserItemServiceTestSuite) SetupTest() {
+	s.mockMediaClient = mediamocks.NewMediaClient(s.T())
+	s.mockFilterService = mediamocks.NewFilterService(s.T())
+	s.mockClock = mediabrowsermocks.NewClock(s.T())
+	s.service = mediabrowseritem.NewMediaBrowserItemService(s.server, s.mockMediaClient, zap.NewNop(), s.mockFilterService, s.mockClock)
+	s.baseURL, _ = url.Parse("http://localhost:8096")
+}
+
+func (s *MediaBrowserItemServiceTestSuite) TearDownTest() {
+	s.mockMediaClient.AssertExpectations(s.T())
+	s.mockFilterService.AssertExpectations(s.T())
+}
+
+func TestEmbyItemServiceTestSuite(t *testing.T) {
+	suite.Run(t, &MediaBrowserItemServiceTestSuite{server: mediabrowser.Emby})
+}
+
+func TestJellyfinItemServiceTestSuite(t *testing.T) {
+	suite.Run(t, &MediaBrowserItemServiceTestSuite{server: mediabrowser.Jellyfin})
+}
+
+func (s *MediaBrowserItemServiceTestSuite) TestGetItems_Success() {
+	// Arrange
+	library := model.Library{ID: "lib1"}
+	libConfig := &configmodel.Library{
+		Filters: configmodel.Filter{Genre: []string{"Action"}},
+	}
+	requestFilters := []model.Filter{{Name: "Genres", Value: "Action"}}
+	response := &mediabrowser.Response{
+		Items: []mediabrowser.Entry{
+			{
+				ID:             "m1",
+				Name:           "Movie One",
+				OriginalTitle:  "Film Un",
+				Type:           "Movie",
+				LocationType:   "FileSystem",
+				Path:           "/data/movies/Movie One (2020)/Movie One (2020).mkv",
+				ProductionYear: 2020,
+				CriticRating:   87,
+				DateCreated:    "2024-01-02T03:04:05.0000000Z",
+			},
+			{
+				ID:           "m2",
+				Name:         "Missing Movie",
+				Type:         "Movie",
+				LocationType: "Virtual",
+			},
+		},
+	}
+	addedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	s.mockMediaClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return(requestFilters).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), requestFilters).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", mock.MatchedBy(func(req *http.Request) bool {
+		q := req.URL.Query()
+		return req.Method == http.MethodGet &&
+			req.URL.Path == s.server.Path("/Items") &&
+			q.Get("ParentId") == "lib1" &&
+			q.Get("Recursive") == "true" &&
+			q.Get("IncludeItemTypes") == "Movie" &&
+			q.Get("Fields") != ""
+	})).Return(response, nil).Once()
+
+	// Act
+	items, err := s.service.GetItems(context.Background(), library, libConfig)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Equal(model.Item{
+		ID:            "m1",
+		GUID:          "m1",
+		Title:         "Movie One",
+		OriginalTitle: "Film Un",
+		Type:          constant.MediaTypeMovie,
+		Year:          2020,
+		Ratings: []model.Rating{
+			{Name: constant.RatingServiceRottenTomatoes, Type: model.RatingServiceTypeCritic, Rating: 8.7},
+		},
+		AddedAt:   addedAt,
+		UpdatedAt: addedAt,
+		Poster:    s.server.Path("/Items/m1/Images/Primary"),
+		Media: []model.Media{
+			{File: []model.File{{Position: "/data/movies/Movie One (2020)/Movie One (2020).mkv"}}},
+		},
+		IsEligible: true,
+	}, items[0])
+
+	s.Equal("m2", items[1].ID)
+	s.Empty(items[1].Ratings)
+	s.Empty(items[1].Media)
+	s.True(items[1].AddedAt.IsZero())
+	s.False(items[1].IsEligible)
+}
+
+func (s *MediaBrowserItemServiceTestSuite) TestGetItems_ClientError() {
+	// Arrange
+	libConfig := &configmodel.Library{}
+	expectedErr := errors.New("client error")
+
+	s.mockMediaClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{}).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return(nil, expectedErr).Once()
+
+	// Act
+	items, err := s.service.GetItems(context.Background(), model.Library{ID: "lib1"}, libConfig)
+
+	// Assert
+	s.Nil(items)
+	s.Equal(expectedErr, err)
+}
+
+func (s *MediaBrowserItemServiceTestSuite) TestGetItems_InvalidResponseType() {
+	// Arrange
+	libConfig := &configmodel.Library{}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{}).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return("invalid", nil).Once()
+
+	// Act
+	items, err := s.service.GetItems(context.Background(), model.Library{ID: "lib1"}, libConfig)
+
+	// Assert
+	s.Nil(items)
+	s.EqualError(err, "invalid response type")
+}
+
+func (s *MediaBrowserItemServiceTestSuite) TestGetItems_AddedAtFilter() {
+	// Arrange
+	libConfig := &configmodel.Library{
+		Filters: configmodel.Filter{AddedAt: "last_10_days"},
+	}
+	response := &mediabrowser.Response{
+		Items: []mediabrowser.Entry{
+			{ID: "recent", Name: "Recent", Type: "Movie", DateCreated: "2024-05-10T00:00:00.0000000Z"},
+			{ID: "old", Name: "Old", Type: "Movie", DateCreated: "2024-01-01T00:00:00.0000000Z"},
+		},
+	}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(s.baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{}).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return(response, nil).Once()
+	if s.server.FilterAddedAtOnItems {
+		s.mockClock.On("Now").Return(time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)).Once()
+	}
+
+	// Act
+	items, err := s.service.GetItems(context.Background(), model.Library{ID: "lib1"}, libConfig)
+
+	// Assert
+	s.Require().NoError(err)
+	if s.server.FilterAddedAtOnItems {
+		s.Require().Len(items, 1)
+		s.Equal("recent", items[0].ID)
+	} else {
+		// The filter service already asked the server for the recent items only
+		s.Len(items, 2)
+	}
+}
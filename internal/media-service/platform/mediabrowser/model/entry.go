@@ -0,0 +1,17 @@
+package mediabrowser
+
+type Entry struct {
+	ID              string            `json:"Id"`
+	Name            string            `json:"Name"`
+	OriginalTitle   string            `json:"OriginalTitle"`
+	Type            string            `json:"Type"`
+	CollectionType  string            `json:"CollectionType"`
+	LocationType    string            `json:"LocationType"`
+	Path            string            `json:"Path"`
+	ProductionYear  int               `json:"ProductionYear"`
+	CriticRating    float32           `json:"CriticRating"`
+	CommunityRating float32           `json:"CommunityRating"`
+	DateCreated     string            `json:"DateCreated"`
+	ImageTags       map[string]string `json:"ImageTags"`
+	ProviderIds     map[string]string `json:"ProviderIds"`
+}
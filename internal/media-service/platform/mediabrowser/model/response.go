@@ -0,0 +1,25 @@
+package mediabrowser
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Response is the result returned by the MediaBrowser endpoints: a query result for
+// items, or a plain array for Emby's /Library/VirtualFolders
+type Response struct {
+	Items            []Entry         `json:"Items"`
+	TotalRecordCount int             `json:"TotalRecordCount"`
+	StartIndex       int             `json:"StartIndex"`
+	VirtualFolders   []VirtualFolder `json:"-"`
+}
+
+func (r *Response) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		return json.Unmarshal(trimmed, &r.VirtualFolders)
+	}
+
+	type response Response
+	return json.Unmarshal(trimmed, (*response)(r))
+}
@@ -0,0 +1,54 @@
+package mediabrowser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ResponseTestSuite struct {
+	suite.Suite
+}
+
+func TestResponseTestSuite(t *testing.T) {
+	suite.Run(t, new(ResponseTestSuite))
+}
+
+func (s *ResponseTestSuite) TestUnmarshalJSON_QueryResult() {
+	// Arrange
+	data := []byte(`{"Items":[{"Id":"1","Name":"Movie","CriticRating":91}],"TotalRecordCount":1}`)
+
+	// Act
+	var response Response
+	err := json.Unmarshal(data, &response)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(1, response.TotalRecordCount)
+	s.Equal([]Entry{{ID: "1", Name: "Movie", CriticRating: 91}}, response.Items)
+	s.Empty(response.VirtualFolders)
+}
+
+func (s *ResponseTestSuite) TestUnmarshalJSON_VirtualFolders() {
+	// Arrange
+	data := []byte(` [{"ItemId":"4","Name":"Movies","CollectionType":"movies","Locations":["/mnt/movies"]}]`)
+
+	// Act
+	var response Response
+	err := json.Unmarshal(data, &response)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal([]VirtualFolder{{ID: "4", Name: "Movies", CollectionType: "movies", Locations: []string{"/mnt/movies"}}}, response.VirtualFolders)
+	s.Empty(response.Items)
+}
+
+func (s *ResponseTestSuite) TestUnmarshalJSON_Invalid() {
+	// Act
+	var response Response
+	err := json.Unmarshal([]byte(`{"Items": "nope"}`), &response)
+
+	// Assert
+	s.Error(err)
+}
@@ -0,0 +1,54 @@
+package mediabrowser
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const clientName = "media-rating-overlay"
+
+// Server describes a MediaBrowser server. Emby and Jellyfin both descend from
+// MediaBrowser and share its API, they differ in base path, auth headers and naming.
+type Server struct {
+	Name      string // Name is used in logs and errors, e.g. "Emby"
+	ConfigKey string // ConfigKey is the config section of the server, e.g. "emby"
+	BasePath  string // BasePath prefixes every API path
+	// FilterAddedAtOnItems is set when /Items has no query parameter for the added_at
+	// filter: the item service then filters the items once fetched
+	FilterAddedAtOnItems bool
+	authenticate         func(request *http.Request, apiKey string)
+}
+
+// Emby serves the API under /emby and authenticates through X-Emby-Token
+var Emby = Server{
+	Name:                 "Emby",
+	ConfigKey:            "emby",
+	BasePath:             "/emby",
+	FilterAddedAtOnItems: true,
+	authenticate: func(request *http.Request, apiKey string) {
+		request.Header.Set("X-Emby-Token", apiKey)
+		request.Header.Set("X-Emby-Client", clientName)
+	},
+}
+
+// Jellyfin authenticates through the MediaBrowser Authorization header
+var Jellyfin = Server{
+	Name:      "Jellyfin",
+	ConfigKey: "jellyfin",
+	authenticate: func(request *http.Request, apiKey string) {
+		request.Header.Set("Authorization", fmt.Sprintf(
+			`MediaBrowser Client="%s", Device="%s", DeviceId="%s", Version="1.0.0", Token="%s"`,
+			clientName, clientName, clientName, apiKey,
+		))
+	},
+}
+
+// Path returns the API path prefixed by the base path of the server
+func (s Server) Path(path string) string {
+	return s.BasePath + path
+}
+
+// Authenticate sets the auth headers of the server on the request
+func (s Server) Authenticate(request *http.Request, apiKey string) {
+	s.authenticate(request, apiKey)
+}
@@ -0,0 +1,9 @@
+package mediabrowser
+
+// VirtualFolder is a library as returned by /Library/VirtualFolders
+type VirtualFolder struct {
+	ID             string   `json:"ItemId"`
+	Name           string   `json:"Name"`
+	CollectionType string   `json:"CollectionType"`
+	Locations      []string `json:"Locations"`
+}
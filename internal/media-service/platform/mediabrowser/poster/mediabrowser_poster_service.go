@@ -0,0 +1,228 @@
+package mediabrowser
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/ports"
+)
+
+// newRequestWithContextFunc defines the signature for a function that creates an HTTP request.
+type newRequestWithContextFunc func(ctx context.Context, method, url string, body io.Reader) (*http.Request, error)
+
+// MediaBrowserPosterService handles Emby and Jellyfin poster operations.
+// The original poster is kept next to the media file, as for Plex, while the
+// generated one is uploaded as the item's primary image.
+type MediaBrowserPosterService struct {
+	server         mediabrowser.Server
+	client         media.MediaClient
+	logger         *zap.Logger
+	storage        ports.PosterStorage
+	NewRequestFunc newRequestWithContextFunc // Exported field for request creation
+}
+
+// NewMediaBrowserPosterService creates a new poster service of the given MediaBrowser server
+func NewMediaBrowserPosterService(server mediabrowser.Server, client media.MediaClient, logger *zap.Logger, storage ports.PosterStorage) media.PosterService {
+	return &MediaBrowserPosterService{
+		server:         server,
+		client:         client,
+		logger:         logger,
+		storage:        storage,
+		NewRequestFunc: http.NewRequestWithContext, // Default to the real function
+	}
+}
+
+func (s *MediaBrowserPosterService) GetPosterDiskPosition(ctx context.Context, item model.Item, config *config.Library) (string, error) {
+	s.logger.Debug("Getting poster disk position..",
+		zap.String("Item ID", item.ID),
+	)
+
+	filePos, err := s.findExistingPoster(item.Media, config)
+	if err != nil {
+		return "", err
+	}
+	if filePos != "" {
+		return filePos, nil
+	}
+
+	posterData, err := s.getPoster(ctx, item.Poster)
+	if err != nil {
+		return "", err
+	}
+
+	posterFileExt, err := media.GetExtensionByMimeType(http.DetectContentType(posterData))
+	if err != nil {
+		return "", err
+	}
+
+	return media.GetPosterFilePosition(item.Media, posterFileExt, config.Path)
+}
+
+func (s *MediaBrowserPosterService) EnsurePosterExists(ctx context.Context, item model.Item, config *config.Library) error {
+	s.logger.Debug("Ensuring poster exists..",
+		zap.String("Item ID", item.ID),
+	)
+
+	filePos, err := s.findExistingPoster(item.Media, config)
+	if err != nil {
+		return err
+	}
+	if filePos != "" {
+		return nil // Poster already exists
+	}
+
+	posterData, err := s.getPoster(ctx, item.Poster)
+	if err != nil {
+		return err
+	}
+
+	posterFileExt, err := media.GetExtensionByMimeType(http.DetectContentType(posterData))
+	if err != nil {
+		return err
+	}
+
+	filePos, err = media.GetPosterFilePosition(item.Media, posterFileExt, config.Path)
+	if err != nil {
+		return err
+	}
+
+	return s.storage.SavePoster(filePos, posterData)
+}
+
+// PublishPoster uploads the generated poster as the item's primary image
+func (s *MediaBrowserPosterService) PublishPoster(ctx context.Context, item model.Item, config *config.Library, posterFilePath string) error {
+	s.logger.Debug("Uploading poster..",
+		zap.String("Item ID", item.ID),
+		zap.String("posterFilePath", posterFilePath),
+	)
+
+	posterData, err := s.storage.ReadPoster(posterFilePath)
+	if err != nil {
+		return err
+	}
+
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(s.server.Path(fmt.Sprintf("/Items/%s/Images/Primary", item.ID)))
+
+	body := bytes.NewBufferString(base64.StdEncoding.EncodeToString(posterData))
+	req, err := s.NewRequestFunc(ctx, http.MethodPost, endpoint.String(), body)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "PublishPoster"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+	req.Header.Set("Content-Type", http.DetectContentType(posterData))
+
+	resp, err := s.client.DoWithResponse(req)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("unable to perform request to %s Client", s.server.Name),
+			zap.String("method", "PublishPoster"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	defer func(Body io.ReadCloser) {
+		if err := Body.Close(); err != nil {
+			s.logger.Error("unable to close response body")
+		}
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unable to upload poster for item %s, status code: %d", item.ID, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// RestorePoster uploads the original poster kept next to the media file as the item's primary image
+func (s *MediaBrowserPosterService) RestorePoster(ctx context.Context, item model.Item, config *config.Library, dryRun bool) (bool, error) {
+	originalPosterPath, err := s.findExistingPoster(item.Media, config)
+	if err != nil {
+		return false, err
+	}
+	if originalPosterPath == "" || dryRun {
+		return originalPosterPath != "", nil
+	}
+
+	return true, s.PublishPoster(ctx, item, config, originalPosterPath)
+}
+
+func (s *MediaBrowserPosterService) findExistingPoster(mediaModels []model.Media, config *config.Library) (string, error) {
+	supportedExt := []string{".jpeg", ".png"}
+
+	for _, ext := range supportedExt {
+		posterFilePos, err := media.GetPosterFilePosition(mediaModels, ext, config.Path)
+		if err != nil {
+			s.logger.Error("unable to find config dir",
+				zap.Any("media", mediaModels),
+				zap.String("library config path", config.Path),
+			)
+			return "", err
+		}
+
+		found, err := s.storage.CheckIfPosterExists(posterFilePos)
+		if err != nil {
+			return "", err
+		}
+
+		if found {
+			return posterFilePos, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (s *MediaBrowserPosterService) getPoster(ctx context.Context, posterURL string) ([]byte, error) {
+	s.logger.Debug("Getting poster..",
+		zap.String("posterURL", posterURL),
+	)
+
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(posterURL)
+
+	req, err := s.NewRequestFunc(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "getPoster"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	resp, err := s.client.DoWithResponse(req)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("unable to perform request to %s Client", s.server.Name),
+			zap.String("method", "getPoster"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		if err := Body.Close(); err != nil {
+			s.logger.Error("unable to close response body")
+		}
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to download poster %s, status code: %d", posterURL, resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
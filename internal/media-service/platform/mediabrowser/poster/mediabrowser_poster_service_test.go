@@ -0,0 +1,235 @@
+package mediabrowser_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	configmodel "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	mediamock "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	mediabrowser "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/model"
+	mediabrowserposter "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/mediabrowser/poster"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	storagemock "github.com/zepollabot/media-rating-overlay/internal/ports/mocks"
+)
+
+var pngHeader = []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00, 0x00, 0x0D}
+
+// MediaBrowserPosterServiceTestSuite runs against each MediaBrowser server
+type MediaBrowserPosterServiceTestSuite struct {
+	suite.Suite
+	server      mediabrowser.Server
+	mockClient  *mediamock.MediaClient
+	mockStorage *storagemock.PosterStorage
+	service     *mediabrowserposter.MediaBrowserPosterService
+	item        model.Item
+	libConfig   *configmodel.Library
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) SetupTest() {
+	s.mockClient = mediamock.NewMediaClient(s.T())
+	s.mockStorage = storagemock.NewPosterStorage(s.T())
+
+	service, ok := mediabrowserposter.NewMediaBrowserPosterService(s.server, s.mockClient, zap.NewNop(), s.mockStorage).(*mediabrowserposter.MediaBrowserPosterService)
+	s.Require().True(ok, "Failed to cast service to *mediabrowserposter.MediaBrowserPosterService")
+	s.service = service
+
+	baseURL, _ := url.Parse("http://mediabrowser.test:8096")
+	s.mockClient.On("GetBaseUrl").Maybe().Return(baseURL)
+
+	s.item = model.Item{
+		ID:     "m1",
+		Poster: s.server.Path("/Items/m1/Images/Primary"),
+		Media: []model.Media{
+			{File: []model.File{{Position: "/mnt/movies/Movie (2023)/Movie (2023).mkv"}}},
+		},
+	}
+	s.libConfig = &configmodel.Library{Path: "/mnt/movies"}
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TearDownTest() {
+	s.mockClient.AssertExpectations(s.T())
+	s.mockStorage.AssertExpectations(s.T())
+}
+
+func TestEmbyPosterServiceTestSuite(t *testing.T) {
+	suite.Run(t, &MediaBrowserPosterServiceTestSuite{server: mediabrowser.Emby})
+}
+
+func TestJellyfinPosterServiceTestSuite(t *testing.T) {
+	suite.Run(t, &MediaBrowserPosterServiceTestSuite{server: mediabrowser.Jellyfin})
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestGetPosterDiskPosition_ExistingPosterFound() {
+	// Arrange
+	expectedPath := "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg"
+	s.mockStorage.On("CheckIfPosterExists", expectedPath).Return(true, nil).Once()
+
+	// Act
+	path, err := s.service.GetPosterDiskPosition(context.Background(), s.item, s.libConfig)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), expectedPath, path)
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestGetPosterDiskPosition_DownloadsToDetectExtension() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", mock.AnythingOfType("string")).Return(false, nil).Twice()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodGet && req.URL.Path == s.server.Path("/Items/m1/Images/Primary")
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(pngHeader))}, nil).Once()
+
+	// Act
+	path, err := s.service.GetPosterDiskPosition(context.Background(), s.item, s.libConfig)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/mnt/movies/Movie (2023)/Movie (2023)-original.png", path)
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestEnsurePosterExists_AlreadyExists() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg").Return(true, nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(context.Background(), s.item, s.libConfig)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	s.mockStorage.AssertNotCalled(s.T(), "SavePoster", mock.Anything, mock.Anything)
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestEnsurePosterExists_DownloadsAndSaves() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", mock.AnythingOfType("string")).Return(false, nil).Twice()
+	s.mockClient.On("DoWithResponse", mock.AnythingOfType("*http.Request")).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(pngHeader))}, nil).Once()
+	s.mockStorage.On("SavePoster", "/mnt/movies/Movie (2023)/Movie (2023)-original.png", pngHeader).Return(nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(context.Background(), s.item, s.libConfig)
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestEnsurePosterExists_DownloadErrorStatus() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", mock.AnythingOfType("string")).Return(false, nil).Twice()
+	s.mockClient.On("DoWithResponse", mock.AnythingOfType("*http.Request")).
+		Return(&http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(bytes.NewReader(nil))}, nil).Once()
+
+	// Act
+	err := s.service.EnsurePosterExists(context.Background(), s.item, s.libConfig)
+
+	// Assert
+	assert.EqualError(s.T(), err, "unable to download poster "+s.server.Path("/Items/m1/Images/Primary")+", status code: 404")
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestEnsurePosterExists_PathOutsideLibrary() {
+	// Arrange
+	libConfig := &configmodel.Library{Path: "/other"}
+
+	// Act
+	err := s.service.EnsurePosterExists(context.Background(), s.item, libConfig)
+
+	// Assert
+	assert.EqualError(s.T(), err, "unable to find file")
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestPublishPoster_Success() {
+	// Arrange
+	posterPath := "/mnt/movies/Movie (2023)/Movie (2023)-poster.png"
+	s.mockStorage.On("ReadPoster", posterPath).Return(pngHeader, nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.Method == http.MethodPost &&
+			req.URL.Path == s.server.Path("/Items/m1/Images/Primary") &&
+			req.Header.Get("Content-Type") == "image/png" &&
+			string(body) == base64.StdEncoding.EncodeToString(pngHeader)
+	})).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(bytes.NewReader(nil))}, nil).Once()
+
+	// Act
+	err := s.service.PublishPoster(context.Background(), s.item, s.libConfig, posterPath)
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestPublishPoster_ReadError() {
+	// Arrange
+	expectedErr := errors.New("read error")
+	s.mockStorage.On("ReadPoster", "poster.png").Return(nil, expectedErr).Once()
+
+	// Act
+	err := s.service.PublishPoster(context.Background(), s.item, s.libConfig, "poster.png")
+
+	// Assert
+	assert.Equal(s.T(), expectedErr, err)
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestPublishPoster_ErrorStatus() {
+	// Arrange
+	s.mockStorage.On("ReadPoster", "poster.png").Return(pngHeader, nil).Once()
+	s.mockClient.On("DoWithResponse", mock.AnythingOfType("*http.Request")).
+		Return(&http.Response{StatusCode: http.StatusForbidden, Body: io.NopCloser(bytes.NewReader(nil))}, nil).Once()
+
+	// Act
+	err := s.service.PublishPoster(context.Background(), s.item, s.libConfig, "poster.png")
+
+	// Assert
+	assert.EqualError(s.T(), err, "unable to upload poster for item m1, status code: 403")
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestRestorePoster_UploadsOriginal() {
+	// Arrange
+	originalPath := "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg"
+	s.mockStorage.On("CheckIfPosterExists", originalPath).Return(true, nil).Once()
+	s.mockStorage.On("ReadPoster", originalPath).Return(pngHeader, nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPost && req.URL.Path == s.server.Path("/Items/m1/Images/Primary")
+	})).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(bytes.NewReader(nil))}, nil).Once()
+
+	// Act
+	restored, err := s.service.RestorePoster(context.Background(), s.item, s.libConfig, false)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), restored)
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestRestorePoster_DryRun() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", "/mnt/movies/Movie (2023)/Movie (2023)-original.jpeg").Return(true, nil).Once()
+
+	// Act
+	restored, err := s.service.RestorePoster(context.Background(), s.item, s.libConfig, true)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), restored)
+	s.mockClient.AssertNotCalled(s.T(), "DoWithResponse", mock.Anything)
+}
+
+func (s *MediaBrowserPosterServiceTestSuite) TestRestorePoster_NoOriginal() {
+	// Arrange
+	s.mockStorage.On("CheckIfPosterExists", mock.AnythingOfType("string")).Return(false, nil).Twice()
+
+	// Act
+	restored, err := s.service.RestorePoster(context.Background(), s.item, s.libConfig, false)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), restored)
+}
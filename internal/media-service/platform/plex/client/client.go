@@ -82,7 +82,10 @@ func (c *PlexClient) DoWithMediaResponse(request *http.Request) (media.MediaResp
 
 // setupRequest configures the request with common headers and authentication
 func (c *PlexClient) setupRequest(request *http.Request) error {
-	request.Header.Set("Content-Type", "application/json")
+	// Poster uploads carry their own image content type
+	if request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
 	request.Header.Set("Accept", "application/json")
 
 	q := request.URL.Query()
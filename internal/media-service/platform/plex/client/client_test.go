@@ -350,3 +350,18 @@ func (s *PlexClientTestSuite) TestPlexClient_setupRequest() {
 	s.Equal(testPlexToken, query.Get("X-Plex-Token"))
 	s.Equal("value", query.Get("existingParam")) // Ensure existing params are preserved
 }
+
+func (s *PlexClientTestSuite) TestPlexClient_setupRequest_KeepsContentType() {
+	// Arrange
+	req, err := http.NewRequest("POST", testPlexURL+"/library/metadata/1/posters", nil)
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "image/png")
+
+	// Act
+	err = s.plexClient.setupRequest(req)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Equal("image/png", req.Header.Get("Content-Type"))
+	s.Equal("application/json", req.Header.Get("Accept"))
+}
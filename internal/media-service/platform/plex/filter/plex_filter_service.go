@@ -2,7 +2,6 @@ package plex
 
 import (
 	"net/http"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -40,33 +39,12 @@ func (s *PlexFiltersService) ApplyFiltersToRequest(request *http.Request, filter
 }
 
 func (s *PlexFiltersService) buildAddedAtValue(addedAt string) string {
-	re := regexp.MustCompile(`last_(\d+)_(days|months|years)`)
-
-	if re.MatchString(addedAt) {
-		subMatchAll := re.FindStringSubmatch(addedAt)
-
-		value, _ := strconv.Atoi(subMatchAll[1])
-		period := subMatchAll[2]
-
-		var after time.Time
-
-		now := s.clock.Now()
-
-		switch period {
-		case "days":
-			after = now.AddDate(0, 0, -value)
-		case "months":
-			after = now.AddDate(0, -value, 0)
-		case "years":
-			after = now.AddDate(-value, 0, 0)
-		default:
-		}
-
-		sec := after.Unix()
-		return strconv.Itoa(int(sec))
+	after, ok := media.GetAddedAfter(addedAt, s.clock.Now)
+	if !ok {
+		return ""
 	}
 
-	return ""
+	return strconv.Itoa(int(after.Unix()))
 }
 
 func (s *PlexFiltersService) ConvertConfigFiltersToRequestFilters(config *config.Library) []model.Filter {
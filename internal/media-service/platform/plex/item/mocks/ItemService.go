@@ -25,6 +25,115 @@ func (_m *ItemService) EXPECT() *ItemService_Expecter {
 	return &ItemService_Expecter{mock: &_m.Mock}
 }
 
+// GetItem provides a mock function with given fields: ctx, itemID, _a2
+func (_m *ItemService) GetItem(ctx context.Context, itemID string, _a2 *config.Library) ([]model.Item, error) {
+	ret := _m.Called(ctx, itemID, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItem")
+	}
+
+	var r0 []model.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *config.Library) ([]model.Item, error)); ok {
+		return rf(ctx, itemID, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *config.Library) []model.Item); ok {
+		r0 = rf(ctx, itemID, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *config.Library) error); ok {
+		r1 = rf(ctx, itemID, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_GetItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItem'
+type ItemService_GetItem_Call struct {
+	*mock.Call
+}
+
+// GetItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - _a2 *config.Library
+func (_e *ItemService_Expecter) GetItem(ctx interface{}, itemID interface{}, _a2 interface{}) *ItemService_GetItem_Call {
+	return &ItemService_GetItem_Call{Call: _e.mock.On("GetItem", ctx, itemID, _a2)}
+}
+
+func (_c *ItemService_GetItem_Call) Run(run func(ctx context.Context, itemID string, _a2 *config.Library)) *ItemService_GetItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*config.Library))
+	})
+	return _c
+}
+
+func (_c *ItemService_GetItem_Call) Return(_a0 []model.Item, _a1 error) *ItemService_GetItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_GetItem_Call) RunAndReturn(run func(context.Context, string, *config.Library) ([]model.Item, error)) *ItemService_GetItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItemPages provides a mock function with given fields: ctx, library, _a2, handlePage
+func (_m *ItemService) GetItemPages(ctx context.Context, library model.Library, _a2 *config.Library, handlePage func([]model.Item) error) error {
+	ret := _m.Called(ctx, library, _a2, handlePage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemPages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Library, *config.Library, func([]model.Item) error) error); ok {
+		r0 = rf(ctx, library, _a2, handlePage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ItemService_GetItemPages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItemPages'
+type ItemService_GetItemPages_Call struct {
+	*mock.Call
+}
+
+// GetItemPages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - library model.Library
+//   - _a2 *config.Library
+//   - handlePage func([]model.Item) error
+func (_e *ItemService_Expecter) GetItemPages(ctx interface{}, library interface{}, _a2 interface{}, handlePage interface{}) *ItemService_GetItemPages_Call {
+	return &ItemService_GetItemPages_Call{Call: _e.mock.On("GetItemPages", ctx, library, _a2, handlePage)}
+}
+
+func (_c *ItemService_GetItemPages_Call) Run(run func(ctx context.Context, library model.Library, _a2 *config.Library, handlePage func([]model.Item) error)) *ItemService_GetItemPages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Library), args[2].(*config.Library), args[3].(func([]model.Item) error))
+	})
+	return _c
+}
+
+func (_c *ItemService_GetItemPages_Call) Return(_a0 error) *ItemService_GetItemPages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ItemService_GetItemPages_Call) RunAndReturn(run func(context.Context, model.Library, *config.Library, func([]model.Item) error) error) *ItemService_GetItemPages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetItems provides a mock function with given fields: ctx, library, _a2
 func (_m *ItemService) GetItems(ctx context.Context, library model.Library, _a2 *config.Library) ([]model.Item, error) {
 	ret := _m.Called(ctx, library, _a2)
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/samber/lo"
@@ -19,6 +21,8 @@ import (
 
 type ItemService interface {
 	GetItems(ctx context.Context, library model.Library, config *config.Library) ([]model.Item, error)
+	GetItemPages(ctx context.Context, library model.Library, config *config.Library, handlePage func(items []model.Item) error) error
+	GetItem(ctx context.Context, itemID string, config *config.Library) ([]model.Item, error)
 }
 
 // PlexItemService handles Plex item operations
@@ -37,10 +41,109 @@ func NewPlexItemService(client media.MediaClient, logger *zap.Logger, filtersSer
 	}
 }
 
-// GetItems retrieves items from a specific library
+// defaultPageSize is the number of items requested per page when the library
+// configuration does not set one
+const defaultPageSize = 500
+
+// GetItems retrieves all the items of a specific library.
+// For show libraries, each show is returned along with its seasons when enabled
+// in the library configuration.
 func (s *PlexItemService) GetItems(ctx context.Context, library model.Library, config *config.Library) ([]model.Item, error) {
+	var items []model.Item
+
+	err := s.GetItemPages(ctx, library, config, func(page []model.Item) error {
+		items = append(items, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// GetItemPages retrieves the items of a specific library using X-Plex-Container-Start and
+// X-Plex-Container-Size, handing each page over before requesting the next one
+func (s *PlexItemService) GetItemPages(ctx context.Context, library model.Library, config *config.Library, handlePage func(items []model.Item) error) error {
+	pageSize := config.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	filters := s.filtersService.ConvertConfigFiltersToRequestFilters(config)
+
+	for start := 0; ; start += pageSize {
+		container, err := s.getSectionPage(ctx, library.ID, filters, start, pageSize)
+		if err != nil {
+			return err
+		}
+
+		s.logger.Debug("Library page retrieved",
+			zap.String("library", library.ID),
+			zap.Int("start", start),
+			zap.Int("size", len(container.Entries)),
+			zap.Int("totalSize", container.TotalSize),
+		)
+
+		var items []model.Item
+		if library.Type == constant.MediaTypeShow {
+			items = s.convertPlexShows(ctx, container.Entries, config)
+		} else {
+			items = s.convertPlexItems(container.Entries)
+		}
+
+		if len(items) > 0 {
+			if err := handlePage(items); err != nil {
+				return err
+			}
+		}
+
+		if s.isLastPage(container, start, pageSize) {
+			return nil
+		}
+	}
+}
+
+// GetItem retrieves a single item by its rating key. A show is returned along with
+// its seasons when enabled in the library configuration
+func (s *PlexItemService) GetItem(ctx context.Context, itemID string, config *config.Library) ([]model.Item, error) {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(fmt.Sprintf("/library/metadata/%s", itemID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetItem"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// External identifiers allow exact rating lookups
+	q := req.URL.Query()
+	q.Set("includeGuids", "1")
+	req.URL.RawQuery = q.Encode()
+
+	entries, err := s.getEntries(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("item %s not found", itemID)
+	}
+
+	if entries[0].Type == constant.MediaTypeShow {
+		return s.convertPlexShows(ctx, entries[:1], config), nil
+	}
+
+	return s.convertPlexItems(entries[:1]), nil
+}
+
+func (s *PlexItemService) getSectionPage(ctx context.Context, libraryID string, filters []model.Filter, start int, pageSize int) (*plex.MediaContainer, error) {
 	baseUrl := s.client.GetBaseUrl()
-	endpoint := baseUrl.JoinPath(fmt.Sprintf("/library/sections/%s/all", library.ID))
+	endpoint := baseUrl.JoinPath(fmt.Sprintf("/library/sections/%s/all", libraryID))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
 	if err != nil {
@@ -52,9 +155,56 @@ func (s *PlexItemService) GetItems(ctx context.Context, library model.Library, c
 		return nil, err
 	}
 
-	filters := s.filtersService.ConvertConfigFiltersToRequestFilters(config)
 	s.filtersService.ApplyFiltersToRequest(req, filters)
 
+	// External identifiers allow exact rating lookups
+	q := req.URL.Query()
+	q.Set("includeGuids", "1")
+	req.URL.RawQuery = q.Encode()
+
+	req.Header.Set("X-Plex-Container-Start", strconv.Itoa(start))
+	req.Header.Set("X-Plex-Container-Size", strconv.Itoa(pageSize))
+
+	return s.getContainer(req)
+}
+
+// isLastPage relies on totalSize when Plex returns it, otherwise on a short page
+func (s *PlexItemService) isLastPage(container *plex.MediaContainer, start int, pageSize int) bool {
+	if len(container.Entries) < pageSize {
+		return true
+	}
+
+	return container.TotalSize > 0 && start+len(container.Entries) >= container.TotalSize
+}
+
+// getMetadata retrieves the entries of a /library/metadata endpoint
+func (s *PlexItemService) getMetadata(ctx context.Context, path string) ([]plex.Entry, error) {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "getMetadata"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return s.getEntries(req)
+}
+
+func (s *PlexItemService) getEntries(req *http.Request) ([]plex.Entry, error) {
+	container, err := s.getContainer(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return container.Entries, nil
+}
+
+func (s *PlexItemService) getContainer(req *http.Request) (*plex.MediaContainer, error) {
 	response, err := s.client.DoWithMediaResponse(req)
 	if err != nil {
 		s.logger.Error("unable to perform request to Plex Client",
@@ -72,7 +222,83 @@ func (s *PlexItemService) GetItems(ctx context.Context, library model.Library, c
 		return nil, fmt.Errorf("invalid response type")
 	}
 
-	return s.convertPlexItems(plexResponse.MediaContainer.Entries), nil
+	return &plexResponse.MediaContainer, nil
+}
+
+// convertPlexShows converts Plex shows, and optionally their seasons, to common Item model.
+// Shows have no media file, so their posters are placed in the show folder.
+func (s *PlexItemService) convertPlexShows(ctx context.Context, shows []plex.Entry, config *config.Library) []model.Item {
+	var convertedItems []model.Item
+	for _, show := range shows {
+		showPath := s.getShowPath(ctx, show.ID)
+
+		showItem := s.convertPlexItems([]plex.Entry{show})[0]
+		showItem.Media = s.buildShowMedia(showPath, plex.ShowPosterName)
+		showItem.IsEligible = s.isEligibleForShowPoster(show.GUID, showPath)
+		convertedItems = append(convertedItems, showItem)
+
+		// Season posters need the show folder as well
+		if !config.Seasons || showPath == "" {
+			continue
+		}
+
+		seasons, err := s.getMetadata(ctx, fmt.Sprintf("/library/metadata/%s/children", show.ID))
+		if err != nil {
+			s.logger.Warn("unable to retrieve seasons, skipped",
+				zap.String("show", show.Title),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		for _, season := range seasons {
+			if season.Type != constant.MediaTypeSeason {
+				continue
+			}
+
+			convertedItems = append(convertedItems, model.Item{
+				ID:            season.ID,
+				GUID:          season.GUID,
+				Title:         show.Title,
+				OriginalTitle: show.OriginalTitle,
+				Type:          season.Type,
+				Year:          show.Year,
+				Ratings:       showItem.Ratings,
+				ExternalIDs:   showItem.ExternalIDs,
+				AddedAt:       media.ConvertoTimestampToUTC(season.AddedAt),
+				UpdatedAt:     media.ConvertoTimestampToUTC(season.UpdatedAt),
+				Poster:        season.Poster,
+				Media:         s.buildShowMedia(showPath, plex.SeasonPosterName(season.Index)),
+				IsEligible:    s.isEligibleForShowPoster(season.GUID, showPath),
+			})
+		}
+	}
+	return convertedItems
+}
+
+// getShowPath returns the folder of a show, empty when it cannot be found
+func (s *PlexItemService) getShowPath(ctx context.Context, showID string) string {
+	entries, err := s.getMetadata(ctx, fmt.Sprintf("/library/metadata/%s", showID))
+	if err != nil || len(entries) == 0 || len(entries[0].Location) == 0 {
+		s.logger.Warn("unable to find show folder",
+			zap.String("Item ID", showID),
+			zap.Error(err),
+		)
+		return ""
+	}
+
+	return entries[0].Location[0].Path
+}
+
+// buildShowMedia builds a media pointing to a virtual file named posterName in the show folder
+func (s *PlexItemService) buildShowMedia(showPath string, posterName string) []model.Media {
+	if showPath == "" {
+		return []model.Media{}
+	}
+
+	return []model.Media{
+		{File: []model.File{{Position: filepath.Join(showPath, posterName)}}},
+	}
 }
 
 // convertPlexItems converts Plex items response to common Item model
@@ -81,22 +307,60 @@ func (s *PlexItemService) convertPlexItems(items []plex.Entry) []model.Item {
 	lo.ForEach(items, func(entry plex.Entry, index int) {
 
 		convertedItems = append(convertedItems, model.Item{
-			ID:         entry.ID,
-			GUID:       entry.GUID,
-			Title:      entry.Title,
-			Type:       entry.Type,
-			Year:       entry.Year,
-			Ratings:    s.buildRatings(entry),
-			AddedAt:    media.ConvertoTimestampToUTC(entry.AddedAt),
-			UpdatedAt:  media.ConvertoTimestampToUTC(entry.UpdatedAt),
-			Poster:     entry.Poster,
-			Media:      s.convertPlexMedia(entry.Media),
-			IsEligible: s.isEligibleForPoster(entry.Type, entry.GUID),
+			ID:            entry.ID,
+			GUID:          entry.GUID,
+			Title:         entry.Title,
+			OriginalTitle: entry.OriginalTitle,
+			Type:          entry.Type,
+			Year:          entry.Year,
+			Ratings:       s.buildRatings(entry),
+			AddedAt:       media.ConvertoTimestampToUTC(entry.AddedAt),
+			UpdatedAt:     media.ConvertoTimestampToUTC(entry.UpdatedAt),
+			Poster:        entry.Poster,
+			Media:         s.convertPlexMedia(entry.Media),
+			IsEligible:    s.isEligibleForPoster(entry.Type, entry.GUID),
+			ExternalIDs:   s.buildExternalIDs(entry),
 		})
 	})
 	return convertedItems
 }
 
+// legacyAgentGuids maps the legacy agents found in the guid field to their external IDs
+var legacyAgentGuids = regexp.MustCompile(`^com\.plexapp\.agents\.(imdb|themoviedb|thetvdb)://([^?/]+)`)
+
+// buildExternalIDs reads the Guid array, falling back on the guid of legacy agents
+func (s *PlexItemService) buildExternalIDs(entry plex.Entry) model.ExternalIDs {
+	externalIDs := model.ExternalIDs{}
+
+	for _, guid := range entry.Guids {
+		provider, id, found := strings.Cut(guid.ID, "://")
+		if !found {
+			continue
+		}
+		switch provider {
+		case "imdb":
+			externalIDs.IMDB = id
+		case "tmdb":
+			externalIDs.TMDB = id
+		case "tvdb":
+			externalIDs.TVDB = id
+		}
+	}
+
+	if matches := legacyAgentGuids.FindStringSubmatch(entry.GUID); matches != nil {
+		switch matches[1] {
+		case "imdb":
+			externalIDs.IMDB = lo.CoalesceOrEmpty(externalIDs.IMDB, matches[2])
+		case "themoviedb":
+			externalIDs.TMDB = lo.CoalesceOrEmpty(externalIDs.TMDB, matches[2])
+		case "thetvdb":
+			externalIDs.TVDB = lo.CoalesceOrEmpty(externalIDs.TVDB, matches[2])
+		}
+	}
+
+	return externalIDs
+}
+
 // TODO	 Move outside of media service, maybe during the creation or processing of the item
 func (s *PlexItemService) buildRatings(entry plex.Entry) []model.Rating {
 
@@ -166,7 +430,13 @@ func (s *PlexItemService) guessRatingService(audienceRatingImage string) string
 }
 
 func (s *PlexItemService) isEligibleForPoster(itemType string, itemGUID string) bool {
-	return itemType == "movie" &&
+	return itemType == constant.MediaTypeMovie &&
+		!strings.Contains(itemGUID, "local:")
+}
+
+// isEligibleForShowPoster applies to shows and seasons, whose poster needs the show folder
+func (s *PlexItemService) isEligibleForShowPoster(itemGUID string, showPath string) bool {
+	return showPath != "" &&
 		!strings.Contains(itemGUID, "local:")
 }
 
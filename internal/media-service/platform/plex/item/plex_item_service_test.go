@@ -77,6 +77,7 @@ func (s *PlexItemServiceTestSuite) TestGetItems_Success() {
 			ID:                  "102",
 			GUID:                "com.plexapp.agents.themoviedb://121?lang=en",
 			Title:               "The Lord of the Rings: The Two Towers",
+			OriginalTitle:       "Le Seigneur des anneaux : Les Deux Tours",
 			Type:                "movie",
 			Year:                2002,
 			AudienceRating:      9.5,
@@ -119,7 +120,7 @@ func (s *PlexItemServiceTestSuite) TestGetItems_Success() {
 		s.Equal(expectedEndpoint, req.URL.String())
 	})
 	s.mockMediaClient.On("DoWithMediaResponse", mock.MatchedBy(func(req *http.Request) bool {
-		return req.Method == http.MethodGet && req.URL.String() == expectedEndpoint
+		return req.Method == http.MethodGet && req.URL.String() == expectedEndpoint+"?includeGuids=1"
 	})).Return(plexResponse, nil)
 
 	// Act
@@ -147,10 +148,14 @@ func (s *PlexItemServiceTestSuite) TestGetItems_Success() {
 	s.Len(items[0].Media, 1)
 	s.Len(items[0].Media[0].File, 2)
 	s.Equal("1", items[0].Media[0].File[0].Position)
+	s.Equal(model.ExternalIDs{IMDB: "tt0120737"}, items[0].ExternalIDs)
 
 	// Item 2: TMDB
 	s.Equal("102", items[1].ID)
 	s.Equal("com.plexapp.agents.themoviedb://121?lang=en", items[1].GUID)
+	s.Equal(model.ExternalIDs{TMDB: "121"}, items[1].ExternalIDs)
+	s.Equal("Le Seigneur des anneaux : Les Deux Tours", items[1].OriginalTitle)
+	s.Empty(items[0].OriginalTitle)
 	s.True(items[1].IsEligible)
 	s.Len(items[1].Ratings, 1)
 	s.Equal(constant.RatingServiceTMDB, items[1].Ratings[0].Name)
@@ -224,7 +229,7 @@ func (s *PlexItemServiceTestSuite) TestGetItems_Error_ClientDo() {
 	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return(mockRequestFilters)
 	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), mockRequestFilters)
 	s.mockMediaClient.On("DoWithMediaResponse", mock.MatchedBy(func(req *http.Request) bool {
-		return req.Method == http.MethodGet && req.URL.String() == expectedEndpoint
+		return req.Method == http.MethodGet && req.URL.String() == expectedEndpoint+"?includeGuids=1"
 	})).Return(nil, expectedError)
 
 	// Act
@@ -253,7 +258,7 @@ func (s *PlexItemServiceTestSuite) TestGetItems_Error_InvalidResponseType() {
 	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return(mockRequestFilters)
 	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), mockRequestFilters)
 	s.mockMediaClient.On("DoWithMediaResponse", mock.MatchedBy(func(req *http.Request) bool {
-		return req.Method == http.MethodGet && req.URL.String() == expectedEndpoint
+		return req.Method == http.MethodGet && req.URL.String() == expectedEndpoint+"?includeGuids=1"
 	})).Return(invalidResponse, nil)
 
 	// Act
@@ -541,3 +546,340 @@ func (s *PlexItemServiceTestSuite) TestGetItems_TimestampConversion() {
 	s.Equal(expectedAddedAt, items[0].AddedAt)
 	s.True(items[0].UpdatedAt.IsZero(), "UpdatedAt should be zero time if Plex sends 0")
 }
+
+func (s *PlexItemServiceTestSuite) requestPath(path string) interface{} {
+	return mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Path == path
+	})
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItems_ShowsWithSeasons() {
+	// Arrange
+	ctx := context.Background()
+	library := model.Library{ID: "2", Type: constant.MediaTypeShow}
+	libConfig := &configmodel.Library{Seasons: true}
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	shows := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{
+			ID: "201", GUID: "plex://show/1", Title: "The Show", OriginalTitle: "Le Show", Type: "show", Year: 2019,
+			AudienceRating: 8.4, AudienceRatingImage: "imdb://image.rating",
+			Poster: "/library/metadata/201/thumb/1",
+		},
+		{ID: "202", GUID: "plex://show/2", Title: "Lost Show", Type: "show", Year: 2001},
+	}}}
+	showMetadata := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{ID: "201", Type: "show", Location: []plexmodel.Location{{Path: "/tv/The Show"}}},
+	}}}
+	seasons := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{ID: "301", GUID: "plex://season/0", Title: "Specials", Type: "season", Index: 0, Poster: "/library/metadata/301/thumb/1"},
+		{ID: "302", GUID: "plex://season/1", Title: "Season 1", Type: "season", Index: 1, Poster: "/library/metadata/302/thumb/1"},
+	}}}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{})
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.Anything, []model.Filter{})
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/sections/2/all")).Return(shows, nil).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/metadata/201")).Return(showMetadata, nil).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/metadata/201/children")).Return(seasons, nil).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/metadata/202")).Return(nil, errors.New("not found")).Once()
+
+	// Act
+	items, err := s.service.GetItems(ctx, library, libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(items, 4)
+
+	show := items[0]
+	s.Equal("201", show.ID)
+	s.Equal(constant.MediaTypeShow, show.Type)
+	s.True(show.IsEligible)
+	s.Equal([]model.Media{{File: []model.File{{Position: "/tv/The Show/show"}}}}, show.Media)
+	s.Len(show.Ratings, 1)
+
+	specials := items[1]
+	s.Equal("301", specials.ID)
+	s.Equal(constant.MediaTypeSeason, specials.Type)
+	s.Equal("The Show", specials.Title)
+	s.Equal("Le Show", specials.OriginalTitle)
+	s.Equal(2019, specials.Year)
+	s.Equal("/library/metadata/301/thumb/1", specials.Poster)
+	s.Equal(show.Ratings, specials.Ratings)
+	s.Equal([]model.Media{{File: []model.File{{Position: "/tv/The Show/season-specials"}}}}, specials.Media)
+	s.True(specials.IsEligible)
+
+	s.Equal([]model.Media{{File: []model.File{{Position: "/tv/The Show/season01"}}}}, items[2].Media)
+
+	lost := items[3]
+	s.Equal("202", lost.ID)
+	s.Empty(lost.Media)
+	s.False(lost.IsEligible, "Show without folder should not be eligible")
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItems_ShowsWithoutSeasons() {
+	// Arrange
+	ctx := context.Background()
+	library := model.Library{ID: "2", Type: constant.MediaTypeShow}
+	libConfig := &configmodel.Library{}
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	shows := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{ID: "201", GUID: "plex://show/1", Title: "The Show", Type: "show"},
+	}}}
+	showMetadata := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{ID: "201", Type: "show", Location: []plexmodel.Location{{Path: "/tv/The Show"}}},
+	}}}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{})
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.Anything, []model.Filter{})
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/sections/2/all")).Return(shows, nil).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/metadata/201")).Return(showMetadata, nil).Once()
+
+	// Act
+	items, err := s.service.GetItems(ctx, library, libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(items, 1)
+	s.Equal("201", items[0].ID)
+	s.True(items[0].IsEligible)
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItem_Movie() {
+	// Arrange
+	ctx := context.Background()
+	libConfig := &configmodel.Library{}
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	movie := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{
+			ID: "101", GUID: "plex://movie/1", Title: "The Movie", Type: "movie", Year: 2020,
+			Guids: []plexmodel.Guid{{ID: "imdb://tt0000101"}},
+		},
+	}}}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockMediaClient.On("DoWithMediaResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Path == "/library/metadata/101" && req.URL.Query().Get("includeGuids") == "1"
+	})).Return(movie, nil).Once()
+
+	// Act
+	items, err := s.service.GetItem(ctx, "101", libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(items, 1)
+	s.Equal("101", items[0].ID)
+	s.Equal("tt0000101", items[0].ExternalIDs.IMDB)
+	s.True(items[0].IsEligible)
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItem_ShowWithSeasons() {
+	// Arrange
+	ctx := context.Background()
+	libConfig := &configmodel.Library{Seasons: true}
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	showMetadata := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{ID: "201", GUID: "plex://show/1", Title: "The Show", Type: "show", Location: []plexmodel.Location{{Path: "/tv/The Show"}}},
+	}}}
+	seasons := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{ID: "302", GUID: "plex://season/1", Title: "Season 1", Type: "season", Index: 1},
+	}}}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/metadata/201")).Return(showMetadata, nil).Twice()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/metadata/201/children")).Return(seasons, nil).Once()
+
+	// Act
+	items, err := s.service.GetItem(ctx, "201", libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(items, 2)
+	s.Equal("201", items[0].ID)
+	s.Equal([]model.Media{{File: []model.File{{Position: "/tv/The Show/show"}}}}, items[0].Media)
+	s.Equal("302", items[1].ID)
+	s.Equal([]model.Media{{File: []model.File{{Position: "/tv/The Show/season01"}}}}, items[1].Media)
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItem_NotFound() {
+	// Arrange
+	ctx := context.Background()
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPath("/library/metadata/999")).
+		Return(&plexmodel.Response{MediaContainer: plexmodel.MediaContainer{}}, nil).Once()
+
+	// Act
+	items, err := s.service.GetItem(ctx, "999", &configmodel.Library{})
+
+	// Assert
+	s.EqualError(err, "item 999 not found")
+	s.Nil(items)
+}
+
+func (s *PlexItemServiceTestSuite) requestPage(start string, size string) interface{} {
+	return mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Path == "/library/sections/1/all" &&
+			req.Header.Get("X-Plex-Container-Start") == start &&
+			req.Header.Get("X-Plex-Container-Size") == size
+	})
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItemPages_PagesUntilTotalSize() {
+	// Arrange
+	ctx := context.Background()
+	library := model.Library{ID: "1", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{PageSize: 2}
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	firstPage := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{TotalSize: 4, Entries: []plexmodel.Entry{
+		{ID: "1", Type: "movie"}, {ID: "2", Type: "movie"},
+	}}}
+	secondPage := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{TotalSize: 4, Entries: []plexmodel.Entry{
+		{ID: "3", Type: "movie"}, {ID: "4", Type: "movie"},
+	}}}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{}).Return().Twice()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPage("0", "2")).Return(firstPage, nil).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPage("2", "2")).Return(secondPage, nil).Once()
+
+	var pages [][]string
+
+	// Act
+	err := s.service.GetItemPages(ctx, library, libConfig, func(items []model.Item) error {
+		pages = append(pages, []string{items[0].ID, items[1].ID})
+		return nil
+	})
+
+	// Assert
+	s.NoError(err)
+	s.Equal([][]string{{"1", "2"}, {"3", "4"}}, pages)
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItemPages_StopsOnShortPage() {
+	// Arrange
+	ctx := context.Background()
+	library := model.Library{ID: "1", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{PageSize: 2}
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	firstPage := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{ID: "1", Type: "movie"}, {ID: "2", Type: "movie"},
+	}}}
+	secondPage := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{ID: "3", Type: "movie"},
+	}}}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{}).Return().Twice()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPage("0", "2")).Return(firstPage, nil).Once()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPage("2", "2")).Return(secondPage, nil).Once()
+
+	// Act
+	items, err := s.service.GetItems(ctx, library, libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Len(items, 3)
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItemPages_DefaultPageSize() {
+	// Arrange
+	ctx := context.Background()
+	library := model.Library{ID: "1", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{}
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{}).Return().Once()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPage("0", "500")).Return(&plexmodel.Response{}, nil).Once()
+
+	// Act
+	err := s.service.GetItemPages(ctx, library, libConfig, func(items []model.Item) error {
+		s.Fail("no page expected for an empty library")
+		return nil
+	})
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItemPages_HandlerError() {
+	// Arrange
+	ctx := context.Background()
+	library := model.Library{ID: "1", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{PageSize: 1}
+	baseURL, _ := url.Parse("http://localhost:32400")
+	expectedErr := errors.New("processing error")
+
+	firstPage := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{TotalSize: 2, Entries: []plexmodel.Entry{
+		{ID: "1", Type: "movie"},
+	}}}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{}).Once()
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{}).Return().Once()
+	s.mockMediaClient.On("DoWithMediaResponse", s.requestPage("0", "1")).Return(firstPage, nil).Once()
+
+	// Act
+	err := s.service.GetItemPages(ctx, library, libConfig, func(items []model.Item) error {
+		return expectedErr
+	})
+
+	// Assert
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *PlexItemServiceTestSuite) TestGetItems_ExternalIDsFromGuids() {
+	// Arrange
+	ctx := context.Background()
+	library := model.Library{ID: "1", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{}
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	plexResponse := &plexmodel.Response{MediaContainer: plexmodel.MediaContainer{Entries: []plexmodel.Entry{
+		{
+			ID:   "101",
+			GUID: "plex://movie/5d776825880197001ec967c6",
+			Type: "movie",
+			Guids: []plexmodel.Guid{
+				{ID: "imdb://tt0120737"},
+				{ID: "tmdb://120"},
+				{ID: "tvdb://232"},
+				{ID: "invalid"},
+			},
+		},
+		{
+			// the Guid array wins over the legacy guid
+			ID:    "102",
+			GUID:  "com.plexapp.agents.imdb://tt0000001?lang=en",
+			Type:  "movie",
+			Guids: []plexmodel.Guid{{ID: "imdb://tt0167261"}},
+		},
+	}}}
+
+	s.mockMediaClient.On("GetBaseUrl").Return(baseURL)
+	s.mockFilterService.On("ConvertConfigFiltersToRequestFilters", libConfig).Return([]model.Filter{})
+	s.mockFilterService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{}).Return()
+	s.mockMediaClient.On("DoWithMediaResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Query().Get("includeGuids") == "1"
+	})).Return(plexResponse, nil).Once()
+
+	// Act
+	items, err := s.service.GetItems(ctx, library, libConfig)
+
+	// Assert
+	s.NoError(err)
+	s.Len(items, 2)
+	s.Equal(model.ExternalIDs{IMDB: "tt0120737", TMDB: "120", TVDB: "232"}, items[0].ExternalIDs)
+	s.Equal(model.ExternalIDs{IMDB: "tt0167261"}, items[1].ExternalIDs)
+}
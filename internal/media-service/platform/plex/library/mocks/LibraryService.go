@@ -80,6 +80,53 @@ func (_c *LibraryService_GetLibraries_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RefreshItem provides a mock function with given fields: ctx, itemID
+func (_m *LibraryService) RefreshItem(ctx context.Context, itemID string) error {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LibraryService_RefreshItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshItem'
+type LibraryService_RefreshItem_Call struct {
+	*mock.Call
+}
+
+// RefreshItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+func (_e *LibraryService_Expecter) RefreshItem(ctx interface{}, itemID interface{}) *LibraryService_RefreshItem_Call {
+	return &LibraryService_RefreshItem_Call{Call: _e.mock.On("RefreshItem", ctx, itemID)}
+}
+
+func (_c *LibraryService_RefreshItem_Call) Run(run func(ctx context.Context, itemID string)) *LibraryService_RefreshItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LibraryService_RefreshItem_Call) Return(_a0 error) *LibraryService_RefreshItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LibraryService_RefreshItem_Call) RunAndReturn(run func(context.Context, string) error) *LibraryService_RefreshItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshLibrary provides a mock function with given fields: ctx, libraryID, force
 func (_m *LibraryService) RefreshLibrary(ctx context.Context, libraryID string, force bool) error {
 	ret := _m.Called(ctx, libraryID, force)
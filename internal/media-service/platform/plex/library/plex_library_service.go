@@ -21,6 +21,7 @@ type newRequestWithContextFunc func(ctx context.Context, method, url string, bod
 type LibraryService interface {
 	GetLibraries(ctx context.Context) ([]model.Library, error)
 	RefreshLibrary(ctx context.Context, libraryID string, force bool) error
+	RefreshItem(ctx context.Context, itemID string) error
 }
 
 // PlexLibraryService handles Plex library operations
@@ -112,6 +113,33 @@ func (s *PlexLibraryService) RefreshLibrary(ctx context.Context, libraryID strin
 	return nil
 }
 
+// RefreshItem refreshes the metadata of a single item, e.g. to pick up its new poster
+func (s *PlexLibraryService) RefreshItem(ctx context.Context, itemID string) error {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(fmt.Sprintf("/library/metadata/%s/refresh", itemID))
+
+	req, err := s.NewRequestFunc(ctx, http.MethodPut, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "RefreshItem"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	_, err = s.client.DoWithResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to Plex Client",
+			zap.String("method", "RefreshItem"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
 // convertPlexLibraries converts Plex library response to common Library model
 func (s *PlexLibraryService) convertPlexLibraries(libraries []plex.Library) []model.Library {
 	var convertedLibraries []model.Library
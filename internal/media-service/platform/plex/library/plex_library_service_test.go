@@ -236,6 +236,40 @@ func (s *PlexLibraryServiceTestSuite) TestRefreshLibrary_PlexClientError() {
 	assert.Equal(s.T(), clientError, err)
 }
 
+func (s *PlexLibraryServiceTestSuite) TestRefreshItem_Success() {
+	// Arrange
+	ctx := context.Background()
+	baseURL, _ := url.Parse("http://localhost:32400")
+
+	s.mockClient.On("GetBaseUrl").Return(baseURL)
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPut &&
+			req.URL.String() == "http://localhost:32400/library/metadata/101/refresh"
+	})).Return(&http.Response{StatusCode: http.StatusOK}, nil)
+
+	// Act
+	err := s.service.RefreshItem(ctx, "101")
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *PlexLibraryServiceTestSuite) TestRefreshItem_PlexClientError() {
+	// Arrange
+	ctx := context.Background()
+	baseURL, _ := url.Parse("http://localhost:32400")
+	clientError := errors.New("plex client error on refresh")
+
+	s.mockClient.On("GetBaseUrl").Return(baseURL)
+	s.mockClient.On("DoWithResponse", mock.Anything).Return(nil, clientError)
+
+	// Act
+	err := s.service.RefreshItem(ctx, "101")
+
+	// Assert
+	assert.Equal(s.T(), clientError, err)
+}
+
 func (s *PlexLibraryServiceTestSuite) TestGetLibraries_NewRequestCreationError() {
 	// Arrange
 	ctx := context.Background()
@@ -0,0 +1,19 @@
+package plex
+
+import "fmt"
+
+// Show and season posters have no media file to sit next to, they are placed in
+// the show folder under these names. The generated posters are then copied to
+// the local asset names Plex looks up (show.png, season01.png, ...).
+const (
+	ShowPosterName           = "show"
+	SeasonSpecialsPosterName = "season-specials"
+)
+
+// SeasonPosterName returns the poster name of a season, season 0 being the specials
+func SeasonPosterName(index int) string {
+	if index == 0 {
+		return SeasonSpecialsPosterName
+	}
+	return fmt.Sprintf("season%02d", index)
+}
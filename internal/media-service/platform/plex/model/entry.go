@@ -1,8 +1,12 @@
 package plex
 
+// UploadedPosterPrefix prefixes the URL of the posters uploaded to Plex, in /library/metadata/{id}/posters
+const UploadedPosterPrefix = "upload://"
+
 type Entry struct {
 	ID                  string  `json:"ratingKey"`
 	GUID                string  `json:"guid"`
+	Guids               []Guid  `json:"Guid"`
 	Title               string  `json:"title"`
 	OriginalTitle       string  `json:"originalTitle"`
 	Type                string  `json:"type"`
@@ -15,4 +19,14 @@ type Entry struct {
 	UpdatedAt           int     `json:"updatedAt"`
 	Poster              string  `json:"thumb"`
 	Media               []Media `json:"Media"`
+	// Index is the season number, for seasons
+	Index    int        `json:"index"`
+	Location []Location `json:"Location"`
+	// Selected marks the current poster, in /library/metadata/{id}/posters
+	Selected bool `json:"selected"`
+}
+
+// Location is a folder of a show, only returned by /library/metadata/{id}
+type Location struct {
+	Path string `json:"path"`
 }
@@ -0,0 +1,7 @@
+package plex
+
+// Guid is an external identifier of an entry, such as imdb://tt0120737,
+// only returned when requesting includeGuids=1
+type Guid struct {
+	ID string `json:"id"`
+}
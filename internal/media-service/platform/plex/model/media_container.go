@@ -4,6 +4,7 @@ type MediaContainer struct {
 	ID        int       `json:"librarySectionID"`
 	Title     string    `json:"librarySectionTitle"`
 	Size      int       `json:"size"`
+	TotalSize int       `json:"totalSize"`
 	Entries   []Entry   `json:"Metadata"`
 	Libraries []Library `json:"Directory"`
 }
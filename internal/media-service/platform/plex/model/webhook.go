@@ -0,0 +1,19 @@
+package plex
+
+// WebhookPayload is the JSON sent in the payload field of the multipart Plex webhook requests
+type WebhookPayload struct {
+	Event    string          `json:"event"`
+	Metadata WebhookMetadata `json:"Metadata"`
+}
+
+// WebhookMetadata describes the item of a webhook event
+type WebhookMetadata struct {
+	ID                  string `json:"ratingKey"`
+	ParentID            string `json:"parentRatingKey"`
+	GrandparentID       string `json:"grandparentRatingKey"`
+	Type                string `json:"type"`
+	Title               string `json:"title"`
+	ParentTitle         string `json:"parentTitle"`
+	GrandparentTitle    string `json:"grandparentTitle"`
+	LibrarySectionTitle string `json:"librarySectionTitle"`
+}
@@ -1,17 +1,21 @@
 package plex
 
 import (
+	"bytes"
 	"context"
-	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"path/filepath"
 	"strings"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
 	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
+	plex "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/model"
 	"github.com/zepollabot/media-rating-overlay/internal/model"
 	"github.com/zepollabot/media-rating-overlay/internal/ports"
 )
@@ -19,20 +23,24 @@ import (
 // newRequestWithContextFunc defines the signature for a function that creates an HTTP request.
 type newRequestWithContextFunc func(ctx context.Context, method, url string, body io.Reader) (*http.Request, error)
 
-// PlexPosterService handles Plex poster operations
+// PlexPosterService handles Plex poster operations.
+// In upload mode posters are kept in the configured poster dir and sent through
+// the Plex API, so the library itself can be read-only.
 type PlexPosterService struct {
 	client         media.MediaClient
 	logger         *zap.Logger
 	storage        ports.PosterStorage
+	upload         config.PlexUpload
 	NewRequestFunc newRequestWithContextFunc // Exported field for request creation
 }
 
 // NewPlexPosterService creates a new Plex poster service
-func NewPlexPosterService(client media.MediaClient, logger *zap.Logger, storage ports.PosterStorage) media.PosterService {
+func NewPlexPosterService(client media.MediaClient, logger *zap.Logger, storage ports.PosterStorage, upload config.PlexUpload) media.PosterService {
 	return &PlexPosterService{
 		client:         client,
 		logger:         logger,
 		storage:        storage,
+		upload:         upload,
 		NewRequestFunc: http.NewRequestWithContext, // Default to the real function
 	}
 }
@@ -43,7 +51,7 @@ func (s *PlexPosterService) GetPosterDiskPosition(ctx context.Context, item mode
 	)
 
 	// First try to find an existing poster
-	filePos, err := s.findExistingPoster(item.Media, config)
+	filePos, err := s.findExistingPoster(item, config)
 	if err != nil {
 		return "", err
 	}
@@ -65,7 +73,7 @@ func (s *PlexPosterService) GetPosterDiskPosition(ctx context.Context, item mode
 	}
 
 	// Calculate the file position
-	return s.getFilePosition(item.Media, posterFileExt, config)
+	return s.getFilePosition(item, posterFileExt, config)
 }
 
 func (s *PlexPosterService) EnsurePosterExists(ctx context.Context, item model.Item, config *config.Library) error {
@@ -74,7 +82,7 @@ func (s *PlexPosterService) EnsurePosterExists(ctx context.Context, item model.I
 	)
 
 	// First try to find an existing poster
-	filePos, err := s.findExistingPoster(item.Media, config)
+	filePos, err := s.findExistingPoster(item, config)
 	if err != nil {
 		return err
 	}
@@ -95,7 +103,7 @@ func (s *PlexPosterService) EnsurePosterExists(ctx context.Context, item model.I
 		return err
 	}
 
-	filePos, err = s.getFilePosition(item.Media, posterFileExt, config)
+	filePos, err = s.getFilePosition(item, posterFileExt, config)
 	if err != nil {
 		return err
 	}
@@ -103,11 +111,255 @@ func (s *PlexPosterService) EnsurePosterExists(ctx context.Context, item model.I
 	return s.storage.SavePoster(filePos, posterData)
 }
 
-func (s *PlexPosterService) findExistingPoster(mediaModels []model.Media, config *config.Library) (string, error) {
+// PublishPoster does nothing for movies: the generated poster is saved next to the media
+// file and picked up as a local asset on the next library refresh.
+// Show and season posters are copied to the asset names Plex looks up in the show folder.
+// In upload mode the poster is uploaded and selected through the Plex API instead.
+func (s *PlexPosterService) PublishPoster(ctx context.Context, item model.Item, config *config.Library, posterFilePath string) error {
+	if s.upload.Enabled {
+		return s.uploadPoster(ctx, item, posterFilePath)
+	}
+
+	if item.Type != constant.MediaTypeShow && item.Type != constant.MediaTypeSeason {
+		return nil
+	}
+
+	assetPath := s.getShowAssetPath(posterFilePath)
+	if assetPath == posterFilePath {
+		return nil
+	}
+
+	s.logger.Debug("Copying poster to Plex local asset..",
+		zap.String("Item ID", item.ID),
+		zap.String("assetPath", assetPath),
+	)
+
+	posterData, err := s.storage.ReadPoster(posterFilePath)
+	if err != nil {
+		return err
+	}
+
+	return s.storage.SavePoster(assetPath, posterData)
+}
+
+// getShowAssetPath turns a generated poster path (e.g. season01-poster.png) into the
+// local asset name (season01.png). Specials already use Plex's season-specials-poster name.
+func (s *PlexPosterService) getShowAssetPath(posterFilePath string) string {
+	ext := filepath.Ext(posterFilePath)
+	name := strings.TrimSuffix(filepath.Base(posterFilePath), ext)
+	if name == plex.SeasonSpecialsPosterName+"-poster" {
+		return posterFilePath
+	}
+
+	return filepath.Join(filepath.Dir(posterFilePath), strings.TrimSuffix(name, "-poster")+ext)
+}
+
+// uploadPoster keeps the URL of the poster selected before the first upload, so it can be
+// restored later, then uploads the generated poster and selects it
+func (s *PlexPosterService) uploadPoster(ctx context.Context, item model.Item, posterFilePath string) error {
+	s.logger.Debug("Uploading poster..",
+		zap.String("Item ID", item.ID),
+		zap.String("posterFilePath", posterFilePath),
+	)
+
+	postersBefore, err := s.getPosters(ctx, item)
+	if err != nil {
+		return err
+	}
+
+	if err := s.keepOriginalPosterURL(item, postersBefore); err != nil {
+		return err
+	}
+
+	posterData, err := s.storage.ReadPoster(posterFilePath)
+	if err != nil {
+		return err
+	}
+
+	uploadPath := fmt.Sprintf("/library/metadata/%s/posters", item.ID)
+	if err := s.doRequest(ctx, http.MethodPost, uploadPath, nil, bytes.NewReader(posterData), http.DetectContentType(posterData)); err != nil {
+		return err
+	}
+
+	postersAfter, err := s.getPosters(ctx, item)
+	if err != nil {
+		return err
+	}
+
+	uploadedPoster, found := findUploadedPoster(postersBefore, postersAfter)
+	if !found {
+		return fmt.Errorf("uploaded poster of item %s not found among its posters", item.ID)
+	}
+
+	selectPath := fmt.Sprintf("/library/metadata/%s/poster", item.ID)
+	query := map[string]string{"url": uploadedPoster.ID}
+
+	return s.doRequest(ctx, http.MethodPut, selectPath, query, nil, "")
+}
+
+// findUploadedPoster returns the poster added by an upload. Uploading a poster already uploaded adds
+// none: the selected poster is then taken when it is an uploaded one
+func findUploadedPoster(postersBefore []plex.Entry, postersAfter []plex.Entry) (plex.Entry, bool) {
+	for _, poster := range postersAfter {
+		if !lo.ContainsBy(postersBefore, func(before plex.Entry) bool { return before.ID == poster.ID }) {
+			return poster, true
+		}
+	}
+
+	return lo.Find(postersAfter, func(poster plex.Entry) bool {
+		return poster.Selected && strings.HasPrefix(poster.ID, plex.UploadedPosterPrefix)
+	})
+}
+
+// keepOriginalPosterURL saves the URL of the currently selected poster, unless it was
+// already saved by a previous run: by then the selected poster is the uploaded one
+func (s *PlexPosterService) keepOriginalPosterURL(item model.Item, posters []plex.Entry) error {
+	urlFilePath := s.getOriginalPosterURLPath(item.ID)
+
+	found, err := s.storage.CheckIfPosterExists(urlFilePath)
+	if err != nil {
+		return err
+	}
+	if found {
+		return nil
+	}
+
+	for _, poster := range posters {
+		if poster.Selected {
+			return s.storage.SavePoster(urlFilePath, []byte(poster.ID))
+		}
+	}
+
+	s.logger.Warn("no selected poster found, the original poster URL is not kept",
+		zap.String("Item ID", item.ID),
+	)
+	return nil
+}
+
+// getPosters returns the posters of the item, the selected one being flagged
+func (s *PlexPosterService) getPosters(ctx context.Context, item model.Item) ([]plex.Entry, error) {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(fmt.Sprintf("/library/metadata/%s/posters", item.ID))
+
+	req, err := s.NewRequestFunc(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "getPosters"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	response, err := s.client.DoWithMediaResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to Plex Client",
+			zap.String("method", "getPosters"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	posters, ok := response.(*plex.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to Plex Response",
+			zap.String("method", "getPosters"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	return posters.MediaContainer.Entries, nil
+}
+
+// RestorePoster selects again the poster kept by keepOriginalPosterURL. Outside upload
+// mode posters are local assets, so there is nothing to restore through the API.
+func (s *PlexPosterService) RestorePoster(ctx context.Context, item model.Item, config *config.Library, dryRun bool) (bool, error) {
+	if !s.upload.Enabled {
+		return false, nil
+	}
+
+	urlFilePath := s.getOriginalPosterURLPath(item.ID)
+	found, err := s.storage.CheckIfPosterExists(urlFilePath)
+	if err != nil || !found {
+		return false, err
+	}
+
+	originalPosterURL, err := s.storage.ReadPoster(urlFilePath)
+	if err != nil {
+		return false, err
+	}
+	if dryRun {
+		return true, nil
+	}
+
+	s.logger.Debug("Selecting original poster..",
+		zap.String("Item ID", item.ID),
+		zap.String("url", string(originalPosterURL)),
+	)
+
+	selectPath := fmt.Sprintf("/library/metadata/%s/poster", item.ID)
+	query := map[string]string{"url": strings.TrimSpace(string(originalPosterURL))}
+
+	return true, s.doRequest(ctx, http.MethodPut, selectPath, query, nil, "")
+}
+
+// getOriginalPosterURLPath returns where the URL of the original poster of an item is
+// kept in upload mode
+func (s *PlexPosterService) getOriginalPosterURLPath(itemID string) string {
+	return filepath.Join(s.upload.PosterDir, itemID+"-original.url")
+}
+
+func (s *PlexPosterService) doRequest(ctx context.Context, method string, path string, query map[string]string, body io.Reader, contentType string) error {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(path)
+
+	req, err := s.NewRequestFunc(ctx, method, endpoint.String(), body)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "doRequest"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	q := req.URL.Query()
+	for key, value := range query {
+		q.Set(key, value)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.client.DoWithResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to Plex Client",
+			zap.String("method", "doRequest"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	defer func(Body io.ReadCloser) {
+		if err := Body.Close(); err != nil {
+			s.logger.Error("unable to close response body")
+		}
+	}(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unable to %s %s, status code: %d", method, path, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *PlexPosterService) findExistingPoster(item model.Item, config *config.Library) (string, error) {
 	supportedExt := []string{".jpeg", ".png"}
 
 	for _, ext := range supportedExt {
-		posterFilePos, err := s.getFilePosition(mediaModels, ext, config)
+		posterFilePos, err := s.getFilePosition(item, ext, config)
 		if err != nil {
 			return "", err
 		}
@@ -171,36 +423,24 @@ func (s *PlexPosterService) getPoster(ctx context.Context, posterURL string) ([]
 	return bytes, nil
 }
 
-func (s *PlexPosterService) getFilePosition(mediaModels []model.Media, posterFileExt string, config *config.Library) (string, error) {
+func (s *PlexPosterService) getFilePosition(item model.Item, posterFileExt string, config *config.Library) (string, error) {
 	s.logger.Debug("Getting file position..",
 		zap.String("posterFileExt", posterFileExt),
 		zap.String("library config path", config.Path),
 	)
 
-	var filePos string
-	for _, mediaModel := range mediaModels {
-		for _, file := range mediaModel.File {
-			fileName := media.GetFileNameWithoutExtTrimSuffix(filepath.Base(file.Position))
-			fileDir := filepath.Dir(file.Position)
-
-			_, after, found := strings.Cut(fileDir, config.Path)
-
-			if !found {
-				s.logger.Error("unable to find config dir",
-					zap.String("fileDir", fileDir),
-					zap.String("library config path", config.Path),
-				)
-				return "", errors.New("unable to find file")
-			}
-
-			posterFileName := fileName + "-original" + posterFileExt
-
-			if after != "" {
-				filePos = config.Path + after + "/" + posterFileName
-			} else {
-				filePos = config.Path + "/" + posterFileName
-			}
-		}
+	// Rating keys are unique across the server, so no need for a folder per library
+	if s.upload.Enabled {
+		return filepath.Join(s.upload.PosterDir, item.ID+"-original"+posterFileExt), nil
+	}
+
+	filePos, err := media.GetPosterFilePosition(item.Media, posterFileExt, config.Path)
+	if err != nil {
+		s.logger.Error("unable to find config dir",
+			zap.Any("media", item.Media),
+			zap.String("library config path", config.Path),
+		)
+		return "", err
 	}
 	return filePos, nil
 }
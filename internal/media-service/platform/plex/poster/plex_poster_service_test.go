@@ -15,7 +15,10 @@ import (
 	"go.uber.org/zap"
 
 	configmodel "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	media "github.com/zepollabot/media-rating-overlay/internal/media-service"
 	mediamock "github.com/zepollabot/media-rating-overlay/internal/media-service/mocks"
+	plex "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/model"
 	plexposter "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/poster"
 	"github.com/zepollabot/media-rating-overlay/internal/model"
 	storagemock "github.com/zepollabot/media-rating-overlay/internal/ports/mocks"
@@ -38,7 +41,7 @@ func (s *PlexPosterServiceTestSuite) SetupTest() {
 
 	// NewPlexPosterService returns media.PosterService, so we cast it to the concrete type
 	// to access NewRequestFunc for overriding in tests.
-	service, ok := plexposter.NewPlexPosterService(s.mockClient, s.logger, s.mockStorage).(*plexposter.PlexPosterService)
+	service, ok := plexposter.NewPlexPosterService(s.mockClient, s.logger, s.mockStorage, configmodel.PlexUpload{}).(*plexposter.PlexPosterService)
 	assert.True(s.T(), ok, "Failed to cast service to *plexposter.PlexPosterService")
 	s.service = service
 
@@ -56,6 +59,12 @@ func TestPlexPosterServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(PlexPosterServiceTestSuite))
 }
 
+// newUploadService returns a poster service in upload mode sharing the suite mocks
+func (s *PlexPosterServiceTestSuite) newUploadService() media.PosterService {
+	upload := configmodel.PlexUpload{Enabled: true, PosterDir: "/data/posters"}
+	return plexposter.NewPlexPosterService(s.mockClient, s.logger, s.mockStorage, upload)
+}
+
 func (s *PlexPosterServiceTestSuite) TestGetPosterDiskPosition_ExistingPosterFound() {
 	// Arrange
 	ctx := context.Background()
@@ -418,3 +427,326 @@ func (s *PlexPosterServiceTestSuite) TestEnsurePosterExists_PathNotInConfig() {
 	s.mockStorage.AssertNotCalled(s.T(), "SavePoster", mock.Anything, mock.Anything)
 	s.mockClient.AssertNotCalled(s.T(), "DoWithResponse", mock.AnythingOfType("*http.Request"))
 }
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_NoOp() {
+	// Arrange
+	item := model.Item{ID: "1"}
+	libConfig := &configmodel.Library{Path: "/mnt/movies"}
+
+	// Act
+	err := s.service.PublishPoster(context.Background(), item, libConfig, "/mnt/movies/Movie/Movie-poster.png")
+
+	// Assert
+	assert.NoError(s.T(), err)
+	s.mockClient.AssertNotCalled(s.T(), "DoWithResponse", mock.Anything)
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_ShowCopiedToLocalAsset() {
+	// Arrange
+	item := model.Item{ID: "10", Type: constant.MediaTypeShow}
+	libConfig := &configmodel.Library{Path: "/mnt/tv"}
+	posterData := []byte("poster")
+
+	s.mockStorage.On("ReadPoster", "/mnt/tv/Show/show-poster.png").Return(posterData, nil).Once()
+	s.mockStorage.On("SavePoster", "/mnt/tv/Show/show.png", posterData).Return(nil).Once()
+
+	// Act
+	err := s.service.PublishPoster(context.Background(), item, libConfig, "/mnt/tv/Show/show-poster.png")
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_SeasonCopiedToLocalAsset() {
+	// Arrange
+	item := model.Item{ID: "11", Type: constant.MediaTypeSeason}
+	libConfig := &configmodel.Library{Path: "/mnt/tv"}
+	posterData := []byte("poster")
+
+	s.mockStorage.On("ReadPoster", "/mnt/tv/Show/season01-poster.png").Return(posterData, nil).Once()
+	s.mockStorage.On("SavePoster", "/mnt/tv/Show/season01.png", posterData).Return(nil).Once()
+
+	// Act
+	err := s.service.PublishPoster(context.Background(), item, libConfig, "/mnt/tv/Show/season01-poster.png")
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_SeasonSpecialsAlreadyLocalAsset() {
+	// Arrange
+	item := model.Item{ID: "12", Type: constant.MediaTypeSeason}
+	libConfig := &configmodel.Library{Path: "/mnt/tv"}
+
+	// Act
+	err := s.service.PublishPoster(context.Background(), item, libConfig, "/mnt/tv/Show/season-specials-poster.png")
+
+	// Assert
+	assert.NoError(s.T(), err)
+	s.mockStorage.AssertNotCalled(s.T(), "ReadPoster", mock.Anything)
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_ShowReadError() {
+	// Arrange
+	item := model.Item{ID: "10", Type: constant.MediaTypeShow}
+	libConfig := &configmodel.Library{Path: "/mnt/tv"}
+	expectedErr := errors.New("read error")
+
+	s.mockStorage.On("ReadPoster", "/mnt/tv/Show/show-poster.png").Return(nil, expectedErr).Once()
+
+	// Act
+	err := s.service.PublishPoster(context.Background(), item, libConfig, "/mnt/tv/Show/show-poster.png")
+
+	// Assert
+	assert.ErrorIs(s.T(), err, expectedErr)
+}
+
+func (s *PlexPosterServiceTestSuite) TestGetPosterDiskPosition_UploadUsesPosterDir() {
+	// Arrange
+	service := s.newUploadService()
+	item := model.Item{ID: "42", Media: []model.Media{{File: []model.File{{Position: "/mnt/movies/Movie/Movie.mkv"}}}}}
+	libConfig := &configmodel.Library{Path: "/mnt/movies"}
+
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.jpeg").Return(true, nil).Once()
+
+	// Act
+	pos, err := service.GetPosterDiskPosition(context.Background(), item, libConfig)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/data/posters/42-original.jpeg", pos)
+}
+
+// postersResponse returns the /library/metadata/{id}/posters response listing the posters
+func postersResponse(posters ...plex.Entry) *plex.Response {
+	return &plex.Response{MediaContainer: plex.MediaContainer{Entries: posters}}
+}
+
+// okResponse returns an empty successful response
+func okResponse() *http.Response {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_UploadKeepsOriginalAndSelects() {
+	// Arrange
+	service := s.newUploadService()
+	item := model.Item{ID: "42", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{Path: "/mnt/movies"}
+	posterData := []byte("generated poster")
+	isGetPosters := func(req *http.Request) bool {
+		return req.Method == http.MethodGet && req.URL.Path == "/library/metadata/42/posters"
+	}
+
+	s.mockClient.On("DoWithMediaResponse", mock.MatchedBy(isGetPosters)).Return(postersResponse(
+		plex.Entry{ID: "upload://posters/old"},
+		plex.Entry{ID: "metadata://posters/com.plexapp.agents.themoviedb_abc", Selected: true},
+	), nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(false, nil).Once()
+	s.mockStorage.On("SavePoster", "/data/posters/42-original.url", []byte("metadata://posters/com.plexapp.agents.themoviedb_abc")).Return(nil).Once()
+	s.mockStorage.On("ReadPoster", "/data/posters/42-poster.png").Return(posterData, nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.Method == http.MethodPost && req.URL.Path == "/library/metadata/42/posters" && string(body) == string(posterData)
+	})).Return(okResponse(), nil).Once()
+	s.mockClient.On("DoWithMediaResponse", mock.MatchedBy(isGetPosters)).Return(postersResponse(
+		plex.Entry{ID: "upload://posters/old"},
+		plex.Entry{ID: "metadata://posters/com.plexapp.agents.themoviedb_abc"},
+		plex.Entry{ID: "upload://posters/new", Selected: true},
+	), nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPut && req.URL.Path == "/library/metadata/42/poster" &&
+			req.URL.Query().Get("url") == "upload://posters/new"
+	})).Return(okResponse(), nil).Once()
+
+	// Act
+	err := service.PublishPoster(context.Background(), item, libConfig, "/data/posters/42-poster.png")
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_UploadOriginalAlreadyKept() {
+	// Arrange
+	service := s.newUploadService()
+	item := model.Item{ID: "42", Type: constant.MediaTypeShow}
+	libConfig := &configmodel.Library{Path: "/mnt/tv"}
+	posterData := []byte("generated poster")
+
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).
+		Return(postersResponse(plex.Entry{ID: "upload://posters/old", Selected: true}), nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(true, nil).Once()
+	s.mockStorage.On("ReadPoster", "/data/posters/42-poster.png").Return(posterData, nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPost
+	})).Return(okResponse(), nil).Once()
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).
+		Return(postersResponse(plex.Entry{ID: "upload://posters/old"}, plex.Entry{ID: "upload://posters/new", Selected: true}), nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPut && req.URL.Query().Get("url") == "upload://posters/new"
+	})).Return(okResponse(), nil).Once()
+
+	// Act
+	err := service.PublishPoster(context.Background(), item, libConfig, "/data/posters/42-poster.png")
+
+	// Assert
+	assert.NoError(s.T(), err)
+	s.mockStorage.AssertNotCalled(s.T(), "SavePoster", mock.Anything, mock.Anything)
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_UploadSamePosterAgain() {
+	// Arrange
+	service := s.newUploadService()
+	item := model.Item{ID: "42", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{Path: "/mnt/movies"}
+	posters := postersResponse(
+		plex.Entry{ID: "metadata://posters/com.plexapp.agents.themoviedb_abc"},
+		plex.Entry{ID: "upload://posters/same", Selected: true},
+	)
+
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return(posters, nil).Twice()
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(true, nil).Once()
+	s.mockStorage.On("ReadPoster", "/data/posters/42-poster.png").Return([]byte("poster"), nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPost
+	})).Return(okResponse(), nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPut && req.URL.Query().Get("url") == "upload://posters/same"
+	})).Return(okResponse(), nil).Once()
+
+	// Act
+	err := service.PublishPoster(context.Background(), item, libConfig, "/data/posters/42-poster.png")
+
+	// Assert
+	assert.NoError(s.T(), err)
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_UploadedPosterNotFound() {
+	// Arrange
+	service := s.newUploadService()
+	item := model.Item{ID: "42", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{Path: "/mnt/movies"}
+	posters := postersResponse(plex.Entry{ID: "metadata://posters/com.plexapp.agents.themoviedb_abc", Selected: true})
+
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return(posters, nil).Twice()
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(true, nil).Once()
+	s.mockStorage.On("ReadPoster", "/data/posters/42-poster.png").Return([]byte("poster"), nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPost
+	})).Return(okResponse(), nil).Once()
+
+	// Act
+	err := service.PublishPoster(context.Background(), item, libConfig, "/data/posters/42-poster.png")
+
+	// Assert
+	assert.EqualError(s.T(), err, "uploaded poster of item 42 not found among its posters")
+	s.mockClient.AssertNotCalled(s.T(), "DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPut
+	}))
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_UploadErrorStatus() {
+	// Arrange
+	service := s.newUploadService()
+	item := model.Item{ID: "42", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{Path: "/mnt/movies"}
+
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return(postersResponse(), nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(true, nil).Once()
+	s.mockStorage.On("ReadPoster", "/data/posters/42-poster.png").Return([]byte("poster"), nil).Once()
+	s.mockClient.On("DoWithResponse", mock.AnythingOfType("*http.Request")).
+		Return(&http.Response{StatusCode: http.StatusForbidden, Body: io.NopCloser(strings.NewReader(""))}, nil).Once()
+
+	// Act
+	err := service.PublishPoster(context.Background(), item, libConfig, "/data/posters/42-poster.png")
+
+	// Assert
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "status code: 403")
+}
+
+func (s *PlexPosterServiceTestSuite) TestPublishPoster_UploadNoSelectedPoster() {
+	// Arrange
+	service := s.newUploadService()
+	item := model.Item{ID: "42", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{Path: "/mnt/movies"}
+
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).Return(postersResponse(), nil).Once()
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(false, nil).Once()
+	s.mockStorage.On("ReadPoster", "/data/posters/42-poster.png").Return([]byte("poster"), nil).Once()
+	s.mockClient.On("DoWithMediaResponse", mock.AnythingOfType("*http.Request")).
+		Return(postersResponse(plex.Entry{ID: "upload://posters/new", Selected: true}), nil).Once()
+	s.mockClient.On("DoWithResponse", mock.AnythingOfType("*http.Request")).Return(okResponse(), nil).Twice()
+
+	// Act
+	err := service.PublishPoster(context.Background(), item, libConfig, "/data/posters/42-poster.png")
+
+	// Assert
+	assert.NoError(s.T(), err)
+	s.mockStorage.AssertNotCalled(s.T(), "SavePoster", mock.Anything, mock.Anything)
+}
+
+func (s *PlexPosterServiceTestSuite) TestRestorePoster_UploadSelectsOriginal() {
+	// Arrange
+	restorer, ok := s.newUploadService().(media.PosterRestorer)
+	s.Require().True(ok)
+	item := model.Item{ID: "42", Type: constant.MediaTypeMovie}
+	libConfig := &configmodel.Library{Path: "/mnt/movies"}
+
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(true, nil).Once()
+	s.mockStorage.On("ReadPoster", "/data/posters/42-original.url").Return([]byte("metadata://posters/agent_123"), nil).Once()
+	s.mockClient.On("DoWithResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPut &&
+			req.URL.Path == "/library/metadata/42/poster" &&
+			req.URL.Query().Get("url") == "metadata://posters/agent_123"
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil).Once()
+
+	// Act
+	restored, err := restorer.RestorePoster(context.Background(), item, libConfig, false)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), restored)
+}
+
+func (s *PlexPosterServiceTestSuite) TestRestorePoster_UploadDryRun() {
+	// Arrange
+	restorer, ok := s.newUploadService().(media.PosterRestorer)
+	s.Require().True(ok)
+	item := model.Item{ID: "42", Type: constant.MediaTypeMovie}
+
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(true, nil).Once()
+	s.mockStorage.On("ReadPoster", "/data/posters/42-original.url").Return([]byte("metadata://posters/agent_123"), nil).Once()
+
+	// Act
+	restored, err := restorer.RestorePoster(context.Background(), item, &configmodel.Library{}, true)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), restored)
+	s.mockClient.AssertNotCalled(s.T(), "DoWithResponse", mock.Anything)
+}
+
+func (s *PlexPosterServiceTestSuite) TestRestorePoster_UploadNoOriginalKept() {
+	// Arrange
+	restorer, ok := s.newUploadService().(media.PosterRestorer)
+	s.Require().True(ok)
+	item := model.Item{ID: "42", Type: constant.MediaTypeMovie}
+
+	s.mockStorage.On("CheckIfPosterExists", "/data/posters/42-original.url").Return(false, nil).Once()
+
+	// Act
+	restored, err := restorer.RestorePoster(context.Background(), item, &configmodel.Library{}, false)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), restored)
+}
+
+func (s *PlexPosterServiceTestSuite) TestRestorePoster_LocalAssetsNoOp() {
+	// Act
+	restored, err := s.service.RestorePoster(context.Background(), model.Item{ID: "42"}, &configmodel.Library{}, false)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), restored)
+}
@@ -0,0 +1,99 @@
+package plex
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	plex "github.com/zepollabot/media-rating-overlay/internal/media-service/platform/plex/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// EventLibraryNew is sent by Plex when an item is added to a library
+const EventLibraryNew = "library.new"
+
+// maxPayloadMemory bounds the memory used by the multipart form, which includes a thumbnail
+const maxPayloadMemory = 10 << 20
+
+// PlexWebhookParser reads the events of the Plex webhooks
+type PlexWebhookParser struct {
+	logger *zap.Logger
+}
+
+// NewPlexWebhookParser creates a new Plex webhook parser
+func NewPlexWebhookParser(logger *zap.Logger) *PlexWebhookParser {
+	return &PlexWebhookParser{
+		logger: logger,
+	}
+}
+
+// Parse reads the payload of a webhook request. handled is false for the events other than
+// a new item, and for the items whose poster is not processed.
+// New seasons and episodes are reported as their show
+func (p *PlexWebhookParser) Parse(r *http.Request) (model.WebhookEvent, bool, error) {
+	data, err := p.readPayload(r)
+	if err != nil {
+		return model.WebhookEvent{}, false, err
+	}
+
+	var payload plex.WebhookPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return model.WebhookEvent{}, false, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+
+	if payload.Event != EventLibraryNew {
+		p.logger.Debug("webhook event ignored", zap.String("event", payload.Event))
+		return model.WebhookEvent{}, false, nil
+	}
+
+	event := model.WebhookEvent{
+		Event:       payload.Event,
+		LibraryName: payload.Metadata.LibrarySectionTitle,
+		Title:       payload.Metadata.Title,
+	}
+
+	switch payload.Metadata.Type {
+	case constant.MediaTypeMovie, constant.MediaTypeShow:
+		event.ItemID = payload.Metadata.ID
+	case constant.MediaTypeSeason:
+		event.ItemID = payload.Metadata.ParentID
+		event.Title = payload.Metadata.ParentTitle
+	case constant.MediaTypeEpisode:
+		event.ItemID = payload.Metadata.GrandparentID
+		event.Title = payload.Metadata.GrandparentTitle
+	}
+
+	if event.ItemID == "" {
+		p.logger.Debug("webhook item ignored",
+			zap.String("type", payload.Metadata.Type),
+			zap.String("title", payload.Metadata.Title),
+		)
+		return model.WebhookEvent{}, false, nil
+	}
+
+	return event, true, nil
+}
+
+// readPayload reads the payload field of the multipart form Plex sends, or the body when it is JSON
+func (p *PlexWebhookParser) readPayload(r *http.Request) ([]byte, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		return io.ReadAll(r.Body)
+	}
+
+	if err := r.ParseMultipartForm(maxPayloadMemory); err != nil {
+		return nil, fmt.Errorf("invalid webhook request: %w", err)
+	}
+
+	payload := r.FormValue("payload")
+	if payload == "" {
+		return nil, fmt.Errorf("invalid webhook request: payload missing")
+	}
+
+	return []byte(payload), nil
+}
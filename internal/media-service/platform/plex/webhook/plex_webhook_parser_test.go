@@ -0,0 +1,130 @@
+package plex
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type PlexWebhookParserTestSuite struct {
+	suite.Suite
+	parser *PlexWebhookParser
+}
+
+func (s *PlexWebhookParserTestSuite) SetupTest() {
+	s.parser = NewPlexWebhookParser(zap.NewNop())
+}
+
+func TestPlexWebhookParserTestSuite(t *testing.T) {
+	suite.Run(t, new(PlexWebhookParserTestSuite))
+}
+
+// newWebhookRequest builds a request the way Plex sends it, with the payload and a thumbnail
+func (s *PlexWebhookParserTestSuite) newWebhookRequest(payloadFile string) *http.Request {
+	payload, err := os.ReadFile(filepath.Join("testdata", payloadFile))
+	s.Require().NoError(err)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	s.Require().NoError(writer.WriteField("payload", string(payload)))
+	thumb, err := writer.CreateFormFile("thumb", "thumb.jpg")
+	s.Require().NoError(err)
+	_, err = thumb.Write([]byte("jpeg"))
+	s.Require().NoError(err)
+	s.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/plex", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func (s *PlexWebhookParserTestSuite) TestParse_NewMovie() {
+	// Act
+	event, handled, err := s.parser.Parse(s.newWebhookRequest("library_new_movie.json"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.True(handled)
+	s.Equal(model.WebhookEvent{Event: "library.new", LibraryName: "Film", ItemID: "1234", Title: "The Matrix"}, event)
+}
+
+func (s *PlexWebhookParserTestSuite) TestParse_NewEpisode_ReportedAsShow() {
+	// Act
+	event, handled, err := s.parser.Parse(s.newWebhookRequest("library_new_episode.json"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.True(handled)
+	s.Equal(model.WebhookEvent{Event: "library.new", LibraryName: "TV Shows", ItemID: "5678", Title: "The Show"}, event)
+}
+
+func (s *PlexWebhookParserTestSuite) TestParse_NewSeason_ReportedAsShow() {
+	// Act
+	event, handled, err := s.parser.Parse(s.newWebhookRequest("library_new_season.json"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.True(handled)
+	s.Equal(model.WebhookEvent{Event: "library.new", LibraryName: "TV Shows", ItemID: "5678", Title: "The Show"}, event)
+}
+
+func (s *PlexWebhookParserTestSuite) TestParse_OtherEvent_Ignored() {
+	// Act
+	_, handled, err := s.parser.Parse(s.newWebhookRequest("media_play.json"))
+
+	// Assert
+	s.NoError(err)
+	s.False(handled)
+}
+
+func (s *PlexWebhookParserTestSuite) TestParse_JSONBody() {
+	// Arrange
+	payload, err := os.ReadFile(filepath.Join("testdata", "library_new_movie.json"))
+	s.Require().NoError(err)
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/plex", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	event, handled, err := s.parser.Parse(req)
+
+	// Assert
+	s.Require().NoError(err)
+	s.True(handled)
+	s.Equal("1234", event.ItemID)
+}
+
+func (s *PlexWebhookParserTestSuite) TestParse_Invalid() {
+	testCases := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{name: "not a form", contentType: "text/plain", body: "hello"},
+		{name: "invalid JSON", contentType: "application/json", body: "{"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/plex", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+
+			// Act
+			_, handled, err := s.parser.Parse(req)
+
+			// Assert
+			s.Error(err)
+			s.False(handled)
+		})
+	}
+}
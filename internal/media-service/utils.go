@@ -1,10 +1,15 @@
 package media
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
 )
 
 var MimeTypes = map[string]string{
@@ -32,3 +37,67 @@ func GetExtensionByMimeType(mimeType string) (string, error) {
 
 	return ext, nil
 }
+
+// GetPosterFilePosition returns the path of the original poster, placed next to the
+// media file and relative to the library path seen by this application
+func GetPosterFilePosition(mediaModels []model.Media, posterFileExt string, libraryPath string) (string, error) {
+	var filePos string
+	for _, mediaModel := range mediaModels {
+		for _, file := range mediaModel.File {
+			fileName := GetFileNameWithoutExtTrimSuffix(filepath.Base(file.Position))
+			fileDir := filepath.Dir(file.Position)
+
+			_, after, found := strings.Cut(fileDir, libraryPath)
+			if !found {
+				return "", errors.New("unable to find file")
+			}
+
+			posterFileName := fileName + "-original" + posterFileExt
+
+			if after != "" {
+				filePos = libraryPath + after + "/" + posterFileName
+			} else {
+				filePos = libraryPath + "/" + posterFileName
+			}
+		}
+	}
+	return filePos, nil
+}
+
+var addedAtRegex = regexp.MustCompile(`last_(\d+)_(days|months|years)`)
+
+// AddedAfterPrefix prefixes an absolute added_at filter value, e.g. after_2025-01-02T03:04:05Z
+const AddedAfterPrefix = "after_"
+
+// GetAddedAfter converts an added_at filter value (e.g. last_30_days, or an RFC 3339 time
+// prefixed by AddedAfterPrefix) into the point in time items must have been added after.
+// now is only called for valid relative values
+func GetAddedAfter(addedAt string, now func() time.Time) (time.Time, bool) {
+	if after, found := strings.CutPrefix(addedAt, AddedAfterPrefix); found {
+		afterTime, err := time.Parse(time.RFC3339, after)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return afterTime, true
+	}
+
+	if !addedAtRegex.MatchString(addedAt) {
+		return time.Time{}, false
+	}
+
+	subMatchAll := addedAtRegex.FindStringSubmatch(addedAt)
+
+	value, _ := strconv.Atoi(subMatchAll[1])
+	period := subMatchAll[2]
+
+	switch period {
+	case "days":
+		return now().AddDate(0, 0, -value), true
+	case "months":
+		return now().AddDate(0, -value, 0), true
+	case "years":
+		return now().AddDate(-value, 0, 0), true
+	default:
+		return time.Time{}, false
+	}
+}
@@ -2,9 +2,12 @@ package media
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
 )
 
 type UtilsTestSuite struct {
@@ -41,3 +44,76 @@ func (s *UtilsTestSuite) TestGetExtensionByMimeType_NotFound() {
 	assert.EqualError(s.T(), err, expectedErrorMsg)
 	assert.Empty(s.T(), actualExtension)
 }
+
+func (s *UtilsTestSuite) TestGetPosterFilePosition_Success() {
+	// Arrange
+	mediaModels := []model.Media{
+		{File: []model.File{{Position: "/data/movies/Movie (2020)/Movie (2020).mkv"}}},
+	}
+
+	// Act
+	filePos, err := GetPosterFilePosition(mediaModels, ".jpeg", "/data/movies")
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/data/movies/Movie (2020)/Movie (2020)-original.jpeg", filePos)
+}
+
+func (s *UtilsTestSuite) TestGetPosterFilePosition_FileInLibraryRoot() {
+	// Arrange
+	mediaModels := []model.Media{
+		{File: []model.File{{Position: "/data/movies/Movie.mkv"}}},
+	}
+
+	// Act
+	filePos, err := GetPosterFilePosition(mediaModels, ".png", "/data/movies")
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/data/movies/Movie-original.png", filePos)
+}
+
+func (s *UtilsTestSuite) TestGetPosterFilePosition_PathNotInLibrary() {
+	// Arrange
+	mediaModels := []model.Media{
+		{File: []model.File{{Position: "/other/Movie/Movie.mkv"}}},
+	}
+
+	// Act
+	filePos, err := GetPosterFilePosition(mediaModels, ".jpeg", "/data/movies")
+
+	// Assert
+	assert.EqualError(s.T(), err, "unable to find file")
+	assert.Empty(s.T(), filePos)
+}
+
+func (s *UtilsTestSuite) TestGetAddedAfter() {
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	testCases := []struct {
+		name     string
+		addedAt  string
+		expected time.Time
+		ok       bool
+	}{
+		{"days", "last_10_days", now.AddDate(0, 0, -10), true},
+		{"months", "last_2_months", now.AddDate(0, -2, 0), true},
+		{"years", "last_1_years", now.AddDate(-1, 0, 0), true},
+		{"absolute", "after_2024-05-01T08:30:00Z", time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC), true},
+		{"invalid absolute", "after_yesterday", time.Time{}, false},
+		{"invalid", "yesterday", time.Time{}, false},
+		{"empty", "", time.Time{}, false},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// Act
+			after, ok := GetAddedAfter(tc.addedAt, clock)
+
+			// Assert
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, after)
+		})
+	}
+}
@@ -4,4 +4,6 @@ const (
 	InvalidToken  = "invalid token"
 	NotAuthorized = "you are not authorized to access that server"
 	NotFound      = "resource not found"
+	// NoRatingToShow tells an item has no rating shown on the posters of its library
+	NoRatingToShow = "no rating to show"
 )
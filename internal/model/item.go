@@ -5,15 +5,25 @@ import (
 )
 
 type Item struct {
-	ID         string
-	GUID       string
-	Title      string
-	Type       string
-	Year       int
-	Ratings    []Rating
-	AddedAt    time.Time
-	UpdatedAt  time.Time
-	Poster     string
-	Media      []Media
-	IsEligible bool
+	ID    string
+	GUID  string
+	Title string
+	// OriginalTitle is the title in the original language, when the media service knows it
+	OriginalTitle string
+	Type          string
+	Year          int
+	Ratings       []Rating
+	AddedAt       time.Time
+	UpdatedAt     time.Time
+	Poster        string
+	Media         []Media
+	IsEligible    bool
+	ExternalIDs   ExternalIDs
+}
+
+// ExternalIDs holds the identifiers of an item on the metadata providers
+type ExternalIDs struct {
+	IMDB string
+	TMDB string
+	TVDB string
 }
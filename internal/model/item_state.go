@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// ItemState records the inputs a poster was generated from, so that it is only
+// generated again when one of them changes
+type ItemState struct {
+	Ratings           []Rating  `json:"ratings"`
+	OverlayConfigHash string    `json:"overlay_config_hash"`
+	SourcePosterHash  string    `json:"source_poster_hash"`
+	OutputPath        string    `json:"output_path"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
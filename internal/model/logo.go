@@ -4,6 +4,8 @@ type Logo struct {
 	Image    Image
 	Text     Text
 	SumWidth int
+	// LowConfidence tells the logo is of a rating having too few votes, drawn dimmed
+	LowConfidence bool
 }
 
 // LogoDimensions represents the dimensions for a logo
@@ -15,8 +15,9 @@ type PosterConfig struct {
 	ImagePaths struct {
 		RottenTomatoes struct {
 			Critic struct {
-				Normal string
-				Low    string
+				Normal    string
+				Low       string
+				Certified string
 			}
 			Audience struct {
 				Normal string
@@ -33,6 +34,33 @@ type PosterConfig struct {
 				Normal string
 			}
 		}
+		Metacritic struct {
+			Critic struct {
+				High   string
+				Medium string
+				Low    string
+			}
+		}
+		Trakt struct {
+			Audience struct {
+				Normal string
+			}
+		}
+		Letterboxd struct {
+			Audience struct {
+				Normal string
+			}
+		}
+		AniList struct {
+			Audience struct {
+				Normal string
+			}
+		}
+		MyAnimeList struct {
+			Audience struct {
+				Normal string
+			}
+		}
 	}
 	VisualDebug bool
 }
@@ -48,10 +76,18 @@ func PosterConfigWithDefaultValues() *PosterConfig {
 
 	config.ImagePaths.RottenTomatoes.Critic.Normal = filepath.Join("internal", "processor", "image", "data", "RT_critic.png")
 	config.ImagePaths.RottenTomatoes.Critic.Low = filepath.Join("internal", "processor", "image", "data", "RT_critic_low.png")
+	config.ImagePaths.RottenTomatoes.Critic.Certified = filepath.Join("internal", "processor", "image", "data", "RT_critic_certified.png")
 	config.ImagePaths.RottenTomatoes.Audience.Normal = filepath.Join("internal", "processor", "image", "data", "RT_audience.png")
 	config.ImagePaths.RottenTomatoes.Audience.Low = filepath.Join("internal", "processor", "image", "data", "RT_audience_low.png")
 	config.ImagePaths.IMDB.Audience.Normal = filepath.Join("internal", "processor", "image", "data", "IMDb.png")
 	config.ImagePaths.TMDB.Audience.Normal = filepath.Join("internal", "processor", "image", "data", "TMDB.png")
+	config.ImagePaths.Metacritic.Critic.High = filepath.Join("internal", "processor", "image", "data", "Metacritic_high.png")
+	config.ImagePaths.Metacritic.Critic.Medium = filepath.Join("internal", "processor", "image", "data", "Metacritic_medium.png")
+	config.ImagePaths.Metacritic.Critic.Low = filepath.Join("internal", "processor", "image", "data", "Metacritic_low.png")
+	config.ImagePaths.Trakt.Audience.Normal = filepath.Join("internal", "processor", "image", "data", "Trakt.png")
+	config.ImagePaths.Letterboxd.Audience.Normal = filepath.Join("internal", "processor", "image", "data", "Letterboxd.png")
+	config.ImagePaths.AniList.Audience.Normal = filepath.Join("internal", "processor", "image", "data", "AniList.png")
+	config.ImagePaths.MyAnimeList.Audience.Normal = filepath.Join("internal", "processor", "image", "data", "MyAnimeList.png")
 
 	return config
 }
@@ -36,8 +36,10 @@ func (s *PosterConfigTestSuite) TestPosterConfigWithDefaultValues() {
 	s.T().Run("ImagePaths for RottenTomatoes Critic should have default values", func(t *testing.T) {
 		expectedNormal := filepath.Join("internal", "processor", "image", "data", "RT_critic.png")
 		expectedLow := filepath.Join("internal", "processor", "image", "data", "RT_critic_low.png")
+		expectedCertified := filepath.Join("internal", "processor", "image", "data", "RT_critic_certified.png")
 		assert.Equal(t, expectedNormal, cfg.ImagePaths.RottenTomatoes.Critic.Normal)
 		assert.Equal(t, expectedLow, cfg.ImagePaths.RottenTomatoes.Critic.Low)
+		assert.Equal(t, expectedCertified, cfg.ImagePaths.RottenTomatoes.Critic.Certified)
 	})
 
 	s.T().Run("ImagePaths for RottenTomatoes Audience should have default values", func(t *testing.T) {
@@ -57,6 +59,35 @@ func (s *PosterConfigTestSuite) TestPosterConfigWithDefaultValues() {
 		assert.Equal(t, expectedNormal, cfg.ImagePaths.TMDB.Audience.Normal)
 	})
 
+	s.T().Run("ImagePaths for Metacritic Critic should have default values", func(t *testing.T) {
+		expectedHigh := filepath.Join("internal", "processor", "image", "data", "Metacritic_high.png")
+		expectedMedium := filepath.Join("internal", "processor", "image", "data", "Metacritic_medium.png")
+		expectedLow := filepath.Join("internal", "processor", "image", "data", "Metacritic_low.png")
+		assert.Equal(t, expectedHigh, cfg.ImagePaths.Metacritic.Critic.High)
+		assert.Equal(t, expectedMedium, cfg.ImagePaths.Metacritic.Critic.Medium)
+		assert.Equal(t, expectedLow, cfg.ImagePaths.Metacritic.Critic.Low)
+	})
+
+	s.T().Run("ImagePaths for Trakt Audience should have default values", func(t *testing.T) {
+		expectedNormal := filepath.Join("internal", "processor", "image", "data", "Trakt.png")
+		assert.Equal(t, expectedNormal, cfg.ImagePaths.Trakt.Audience.Normal)
+	})
+
+	s.T().Run("ImagePaths for Letterboxd Audience should have default values", func(t *testing.T) {
+		expectedNormal := filepath.Join("internal", "processor", "image", "data", "Letterboxd.png")
+		assert.Equal(t, expectedNormal, cfg.ImagePaths.Letterboxd.Audience.Normal)
+	})
+
+	s.T().Run("ImagePaths for AniList Audience should have default values", func(t *testing.T) {
+		expectedNormal := filepath.Join("internal", "processor", "image", "data", "AniList.png")
+		assert.Equal(t, expectedNormal, cfg.ImagePaths.AniList.Audience.Normal)
+	})
+
+	s.T().Run("ImagePaths for MyAnimeList Audience should have default values", func(t *testing.T) {
+		expectedNormal := filepath.Join("internal", "processor", "image", "data", "MyAnimeList.png")
+		assert.Equal(t, expectedNormal, cfg.ImagePaths.MyAnimeList.Audience.Normal)
+	})
+
 	s.T().Run("VisualDebug should be false by default", func(t *testing.T) {
 		assert.False(t, cfg.VisualDebug)
 	})
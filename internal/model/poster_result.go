@@ -4,5 +4,6 @@ type PosterResult struct {
 	Title                      string
 	OriginalPosterDiskPosition string
 	OverlayPosterDiskPosition  string
+	Skipped                    bool
 	Err                        error
 }
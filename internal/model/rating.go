@@ -1,13 +1,60 @@
 package model
 
+import (
+	"slices"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+)
+
 const (
 	RatingServiceTypeCritic   = "critic"
 	RatingServiceTypeAudience = "audience"
 	RatingServiceTypeUser     = "user"
 )
 
+// DefaultRatingScale is the maximum of the ratings without an explicit scale
+const DefaultRatingScale = 10
+
 type Rating struct {
 	Name   string
 	Rating float32
 	Type   string
+	// Scale is the maximum value of Rating, e.g. 5 for a 0-5 rating. Zero means DefaultRatingScale
+	Scale float32
+	// Certified tells the rating earned the distinction of its platform, e.g. Rotten Tomatoes' Certified Fresh
+	Certified bool
+	// Votes is the number of votes the rating is computed from, zero when unknown
+	Votes int
+	// LowConfidence tells the rating has fewer votes than the minimum set for its platform
+	LowConfidence bool
+}
+
+// Normalized returns the rating on the 0-10 scale, whatever its scale
+func (r Rating) Normalized() float32 {
+	if r.Scale <= 0 || r.Scale == DefaultRatingScale {
+		return r.Rating
+	}
+	return r.Rating * DefaultRatingScale / r.Scale
+}
+
+// SelectRatings returns the ratings shown on the posters of the library: the ratings of its sources,
+// in the order of the sources, up to its maximum number of ratings. Every rating is kept, in order,
+// when the library selects no source
+func SelectRatings(ratings []Rating, library *config.Library) []Rating {
+	selected := ratings
+	if len(library.Ratings) > 0 {
+		selected = make([]Rating, 0, len(ratings))
+		for _, source := range library.Ratings {
+			for _, rating := range ratings {
+				if source.Matches(rating.Name, rating.Type) && !slices.Contains(selected, rating) {
+					selected = append(selected, rating)
+				}
+			}
+		}
+	}
+
+	if library.MaxRatings > 0 && len(selected) > library.MaxRatings {
+		selected = selected[:library.MaxRatings]
+	}
+	return selected
 }
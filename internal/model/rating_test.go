@@ -0,0 +1,108 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+)
+
+type RatingTestSuite struct {
+	suite.Suite
+}
+
+func TestRatingTestSuite(t *testing.T) {
+	suite.Run(t, new(RatingTestSuite))
+}
+
+func (s *RatingTestSuite) TestNormalized() {
+	testCases := []struct {
+		name     string
+		rating   Rating
+		expected float32
+	}{
+		{name: "implicit scale", rating: Rating{Rating: 7.8}, expected: 7.8},
+		{name: "default scale", rating: Rating{Rating: 7.8, Scale: DefaultRatingScale}, expected: 7.8},
+		{name: "0-5 scale", rating: Rating{Rating: 3.9, Scale: 5}, expected: 7.8},
+		{name: "0-100 scale", rating: Rating{Rating: 78, Scale: 100}, expected: 7.8},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, tc.rating.Normalized(), 0.0001)
+		})
+	}
+}
+
+func (s *RatingTestSuite) TestSelectRatings() {
+	ratings := []Rating{
+		{Name: "TMDB", Rating: 7.8, Type: RatingServiceTypeAudience},
+		{Name: "Rotten Tomatoes", Rating: 9.2, Type: RatingServiceTypeCritic},
+		{Name: "Rotten Tomatoes", Rating: 8.7, Type: RatingServiceTypeAudience},
+		{Name: "IMDB", Rating: 8.1, Type: RatingServiceTypeAudience},
+		{Name: "MyAnimeList", Rating: 8.16, Type: RatingServiceTypeAudience},
+	}
+
+	testCases := []struct {
+		name     string
+		library  config.Library
+		expected []Rating
+	}{
+		{
+			name:     "no selection",
+			library:  config.Library{},
+			expected: ratings,
+		},
+		{
+			name:     "maximum without selection",
+			library:  config.Library{MaxRatings: 2},
+			expected: ratings[:2],
+		},
+		{
+			name: "selection in order",
+			library: config.Library{Ratings: []config.RatingSource{
+				{Name: "IMDB"},
+				{Name: "Rotten Tomatoes", Type: RatingServiceTypeCritic},
+				{Name: "rotten tomatoes", Type: RatingServiceTypeAudience},
+			}},
+			expected: []Rating{ratings[3], ratings[1], ratings[2]},
+		},
+		{
+			name: "selection of both types",
+			library: config.Library{Ratings: []config.RatingSource{
+				{Name: "MyAnimeList"},
+				{Name: "Rotten Tomatoes"},
+			}},
+			expected: []Rating{ratings[4], ratings[1], ratings[2]},
+		},
+		{
+			name: "selection with maximum",
+			library: config.Library{
+				Ratings:    []config.RatingSource{{Name: "MyAnimeList"}, {Name: "TMDB"}, {Name: "IMDB"}},
+				MaxRatings: 2,
+			},
+			expected: []Rating{ratings[4], ratings[0]},
+		},
+		{
+			name: "source listed twice",
+			library: config.Library{Ratings: []config.RatingSource{
+				{Name: "Rotten Tomatoes", Type: RatingServiceTypeAudience},
+				{Name: "Rotten Tomatoes"},
+			}},
+			expected: []Rating{ratings[2], ratings[1]},
+		},
+		{
+			name:     "no rating of the selection",
+			library:  config.Library{Ratings: []config.RatingSource{{Name: "AniList"}}},
+			expected: []Rating{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SelectRatings(ratings, &tc.library))
+		})
+	}
+}
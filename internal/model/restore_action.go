@@ -0,0 +1,15 @@
+package model
+
+const (
+	RestoreActionDelete  = "delete"
+	RestoreActionRename  = "rename"
+	RestoreActionPublish = "publish"
+)
+
+// RestoreAction describes a change made, or to be made on a dry run, to put back
+// the posters items had before being processed
+type RestoreAction struct {
+	Type   string
+	Path   string
+	Target string
+}
@@ -4,4 +4,6 @@ type SearchResult struct {
 	ID    int
 	Title string
 	Vote  float64
+	// VoteCount is the number of votes of Vote
+	VoteCount int
 }
@@ -0,0 +1,10 @@
+package model
+
+// WebhookEvent is an item a media server notified about through a webhook
+type WebhookEvent struct {
+	Event       string
+	LibraryName string
+	// ItemID is the item to process, the show for a new season or episode
+	ItemID string
+	Title  string
+}
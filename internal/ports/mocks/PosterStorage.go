@@ -73,6 +73,64 @@ func (_c *PosterStorage_CheckIfPosterExists_Call) RunAndReturn(run func(string)
 	return _c
 }
 
+// ReadPoster provides a mock function with given fields: path
+func (_m *PosterStorage) ReadPoster(path string) ([]byte, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPoster")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]byte, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PosterStorage_ReadPoster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPoster'
+type PosterStorage_ReadPoster_Call struct {
+	*mock.Call
+}
+
+// ReadPoster is a helper method to define mock.On call
+//   - path string
+func (_e *PosterStorage_Expecter) ReadPoster(path interface{}) *PosterStorage_ReadPoster_Call {
+	return &PosterStorage_ReadPoster_Call{Call: _e.mock.On("ReadPoster", path)}
+}
+
+func (_c *PosterStorage_ReadPoster_Call) Run(run func(path string)) *PosterStorage_ReadPoster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PosterStorage_ReadPoster_Call) Return(_a0 []byte, _a1 error) *PosterStorage_ReadPoster_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PosterStorage_ReadPoster_Call) RunAndReturn(run func(string) ([]byte, error)) *PosterStorage_ReadPoster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SavePoster provides a mock function with given fields: path, data
 func (_m *PosterStorage) SavePoster(path string, data []byte) error {
 	ret := _m.Called(path, data)
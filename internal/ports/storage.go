@@ -3,4 +3,5 @@ package ports
 type PosterStorage interface {
 	CheckIfPosterExists(path string) (bool, error)
 	SavePoster(path string, data []byte) error
+	ReadPoster(path string) ([]byte, error)
 }
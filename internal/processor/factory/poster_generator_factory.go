@@ -17,6 +17,8 @@ type PosterGeneratorFactory struct {
 	Logger                 *zap.Logger
 	RatingPlatformServices []ratingModel.RatingService
 	VisualDebug            bool
+	// FileManager decides where the generated posters are written, next to the media files when nil
+	FileManager image.FileManager
 }
 
 func NewPosterGeneratorFactory(logger *zap.Logger, ratingPlatformServices []ratingModel.RatingService, visualDebug bool) *PosterGeneratorFactory {
@@ -29,7 +31,10 @@ func (f *PosterGeneratorFactory) Create() *poster.PosterGenerator {
 
 	textCreator := text.NewTextCreator(f.Logger, f.VisualDebug)
 	logoService := logo.NewLogoService(f.Logger, textCreator, defaultPosterConfig)
-	fileManager := file.NewFileManager(f.Logger)
+	fileManager := f.FileManager
+	if fileManager == nil {
+		fileManager = file.NewFileManager(f.Logger)
+	}
 	imageService := image.NewImageService(f.Logger, defaultPosterConfig, fileManager)
 	imageProcessor := image.NewImageService(f.Logger, defaultPosterConfig, fileManager)
 	overlayFactory := NewOverlayFactory(f.Logger, defaultPosterConfig)
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
+	"github.com/zepollabot/media-rating-overlay/internal/processor/file"
 	"github.com/zepollabot/media-rating-overlay/internal/processor/poster"
 	ratingModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/model"
 )
@@ -42,6 +43,19 @@ func (s *PosterGeneratorFactoryTestSuite) TestCreateWithVisualDebug() {
 	s.IsType(&poster.PosterGenerator{}, generator)
 }
 
+func (s *PosterGeneratorFactoryTestSuite) TestCreateWithFileManager() {
+	// Arrange
+	factory := NewPosterGeneratorFactory(s.logger, []ratingModel.RatingService{}, false)
+	factory.FileManager = file.NewPreviewFileManager(s.logger, s.T().TempDir())
+
+	// Act
+	generator := factory.Create()
+
+	// Assert
+	s.Require().NotNil(generator)
+	s.IsType(&poster.PosterGenerator{}, generator)
+}
+
 func TestPosterGeneratorFactorySuite(t *testing.T) {
 	suite.Run(t, new(PosterGeneratorFactoryTestSuite))
 }
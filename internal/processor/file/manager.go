@@ -57,6 +57,21 @@ func (m *FileManager) SavePoster(filePath string, data []byte) error {
 	return nil
 }
 
+func (m *FileManager) ReadPoster(filePath string) ([]byte, error) {
+	m.logger.Debug("Reading poster..",
+		zap.String("filePath", filePath),
+	)
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		m.logger.Error("unable to read file",
+			zap.String("filePath", filePath),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return data, nil
+}
+
 // GeneratePosterFilePath generates a poster file path from the original file path
 func (m *FileManager) GeneratePosterFilePath(filePath string, ext string) string {
 	m.logger.Debug("Generating poster file path..",
@@ -67,6 +67,24 @@ func (s *FileManagerTestSuite) TestSavePoster() {
 	s.Equal(testData, savedData)
 }
 
+func (s *FileManagerTestSuite) TestReadPoster() {
+	// Arrange
+	testFilePath := filepath.Join(s.tempDir, "test-poster.png")
+	testData := []byte("test poster data")
+	s.Require().NoError(os.WriteFile(testFilePath, testData, 0664))
+
+	// Act
+	data, err := s.manager.ReadPoster(testFilePath)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(testData, data)
+
+	// Test non-existent file
+	_, err = s.manager.ReadPoster(filepath.Join(s.tempDir, "non-existent.png"))
+	s.Error(err)
+}
+
 func (s *FileManagerTestSuite) TestGeneratePosterFilePath() {
 	// Arrange
 	originalPath := filepath.Join(s.tempDir, "test-original.jpg")
@@ -0,0 +1,83 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// PreviewFileManager keeps the libraries untouched on a dry run: posters are written
+// to the preview dir, mirroring their library path, and read from there first
+type PreviewFileManager struct {
+	*FileManager
+	previewDir string
+}
+
+// NewPreviewFileManager creates a file manager writing to the preview dir
+func NewPreviewFileManager(logger *zap.Logger, previewDir string) *PreviewFileManager {
+	return &PreviewFileManager{
+		FileManager: NewFileManager(logger),
+		previewDir:  previewDir,
+	}
+}
+
+// PreviewPath returns where a library file is written on a dry run
+func (m *PreviewFileManager) PreviewPath(filePath string) string {
+	previewDir := filepath.Clean(m.previewDir)
+	if strings.HasPrefix(filepath.Clean(filePath), previewDir+string(filepath.Separator)) {
+		return filePath
+	}
+
+	return filepath.Join(previewDir, filePath)
+}
+
+// ResolvePath returns the preview of a library file when there is one, the file itself otherwise
+func (m *PreviewFileManager) ResolvePath(filePath string) string {
+	previewPath := m.PreviewPath(filePath)
+	if _, err := os.Stat(previewPath); err == nil {
+		return previewPath
+	}
+
+	return filePath
+}
+
+func (m *PreviewFileManager) CheckIfPosterExists(filePath string) (bool, error) {
+	return m.FileManager.CheckIfPosterExists(m.ResolvePath(filePath))
+}
+
+func (m *PreviewFileManager) ReadPoster(filePath string) ([]byte, error) {
+	return m.FileManager.ReadPoster(m.ResolvePath(filePath))
+}
+
+func (m *PreviewFileManager) SavePoster(filePath string, data []byte) error {
+	previewPath := m.PreviewPath(filePath)
+	if err := os.MkdirAll(filepath.Dir(previewPath), 0775); err != nil {
+		m.logger.Error("unable to create preview dir",
+			zap.String("filePath", previewPath),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return m.FileManager.SavePoster(previewPath, data)
+}
+
+// GeneratePosterFilePath generates the poster file path in the preview dir, creating its folder
+func (m *PreviewFileManager) GeneratePosterFilePath(filePath string, ext string) string {
+	posterFilePath := m.PreviewPath(m.FileManager.GeneratePosterFilePath(filePath, ext))
+	if err := os.MkdirAll(filepath.Dir(posterFilePath), 0775); err != nil {
+		m.logger.Warn("unable to create preview dir",
+			zap.String("filePath", posterFilePath),
+			zap.Error(err),
+		)
+	}
+
+	return posterFilePath
+}
+
+// BackupExistingPoster does nothing, the posters in the library are never replaced on a dry run
+func (m *PreviewFileManager) BackupExistingPoster(filePath string) error {
+	return nil
+}
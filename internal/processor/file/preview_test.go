@@ -0,0 +1,61 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+)
+
+func (s *FileManagerTestSuite) TestPreviewFileManager() {
+	previewDir := filepath.Join(s.tempDir, "previews")
+	libraryDir := filepath.Join(s.tempDir, "movies")
+	manager := NewPreviewFileManager(s.logger, previewDir)
+	originalPath := filepath.Join(libraryDir, "Movie-original.jpeg")
+
+	s.Run("saves posters into the preview dir", func() {
+		err := manager.SavePoster(originalPath, []byte("original"))
+
+		s.Require().NoError(err)
+		s.NoFileExists(originalPath)
+		s.FileExists(filepath.Join(previewDir, originalPath))
+	})
+
+	s.Run("reads posters from the preview dir first", func() {
+		exists, err := manager.CheckIfPosterExists(originalPath)
+		s.Require().NoError(err)
+		s.True(exists)
+
+		data, err := manager.ReadPoster(originalPath)
+		s.Require().NoError(err)
+		s.Equal("original", string(data))
+		s.Equal(filepath.Join(previewDir, originalPath), manager.ResolvePath(originalPath))
+	})
+
+	s.Run("falls back to the library", func() {
+		libraryPosterPath := filepath.Join(libraryDir, "Other-original.jpeg")
+		s.Require().NoError(os.MkdirAll(libraryDir, 0775))
+		s.Require().NoError(os.WriteFile(libraryPosterPath, []byte("library"), 0664))
+
+		data, err := manager.ReadPoster(libraryPosterPath)
+		s.Require().NoError(err)
+		s.Equal("library", string(data))
+		s.Equal(libraryPosterPath, manager.ResolvePath(libraryPosterPath))
+	})
+
+	s.Run("generates poster paths in the preview dir", func() {
+		posterPath := manager.GeneratePosterFilePath(filepath.Join(libraryDir, "Other-original.jpeg"), ".png")
+		s.Equal(filepath.Join(previewDir, libraryDir, "Other-poster.png"), posterPath)
+		s.DirExists(filepath.Dir(posterPath))
+
+		// paths already in the preview dir are kept
+		previewPath := filepath.Join(previewDir, originalPath)
+		s.Equal(filepath.Join(previewDir, libraryDir, "Movie-poster.png"), manager.GeneratePosterFilePath(previewPath, ".png"))
+	})
+
+	s.Run("never backs up library posters", func() {
+		libraryPosterPath := filepath.Join(libraryDir, "Other-poster.jpg")
+		s.Require().NoError(os.WriteFile(libraryPosterPath, []byte("poster"), 0664))
+
+		s.NoError(manager.BackupExistingPoster(filepath.Join(libraryDir, "Other-poster.png")))
+		s.FileExists(libraryPosterPath)
+	})
+}
@@ -0,0 +1,147 @@
+package file
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+const (
+	generatedPosterSuffix = "-poster.png"
+	backupSuffix          = "-backup"
+)
+
+var originalPosterExts = []string{".jpeg", ".jpg", ".png"}
+
+// RestorePosters undoes the poster changes made in a library folder: generated posters,
+// and the copies made of them as show assets, are deleted and the posters renamed
+// by BackupExistingPoster are put back. Only posters with an "-original" sibling are
+// considered generated. On a dry run the actions are only returned.
+func (m *FileManager) RestorePosters(dir string, dryRun bool) ([]model.RestoreAction, error) {
+	m.logger.Debug("Restoring posters..",
+		zap.String("dir", dir),
+		zap.Bool("dryRun", dryRun),
+	)
+
+	var actions []model.RestoreAction
+	var backups []string
+
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		name := entry.Name()
+		switch {
+		case strings.HasSuffix(name, backupSuffix) && strings.Contains(name, "-poster."):
+			backups = append(backups, filePath)
+		case strings.HasSuffix(name, generatedPosterSuffix) && m.hasOriginalPoster(filePath):
+			actions = append(actions, m.getGeneratedPosterActions(filePath)...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		m.logger.Error("unable to walk library dir",
+			zap.String("dir", dir),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	for _, backup := range backups {
+		actions = append(actions, model.RestoreAction{
+			Type:   model.RestoreActionRename,
+			Path:   backup,
+			Target: strings.TrimSuffix(backup, backupSuffix),
+		})
+	}
+
+	if dryRun {
+		return actions, nil
+	}
+
+	for _, action := range actions {
+		if err := m.applyRestoreAction(action); err != nil {
+			return nil, &model.PosterError{
+				Stage: "restore_poster",
+				Err:   err,
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+// hasOriginalPoster tells whether the original poster a generated poster was made from is next to it
+func (m *FileManager) hasOriginalPoster(posterFilePath string) bool {
+	prefix := strings.TrimSuffix(posterFilePath, generatedPosterSuffix)
+	for _, ext := range originalPosterExts {
+		if _, err := os.Stat(prefix + "-original" + ext); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getGeneratedPosterActions deletes a generated poster, along with the show asset copied
+// from it (e.g. season01.png for season01-poster.png), when its content is the same
+func (m *FileManager) getGeneratedPosterActions(posterFilePath string) []model.RestoreAction {
+	actions := []model.RestoreAction{{Type: model.RestoreActionDelete, Path: posterFilePath}}
+
+	assetPath := strings.TrimSuffix(posterFilePath, generatedPosterSuffix) + ".png"
+	asset, err := os.ReadFile(assetPath)
+	if err != nil {
+		return actions
+	}
+
+	poster, err := os.ReadFile(posterFilePath)
+	if err != nil || !bytes.Equal(asset, poster) {
+		return actions
+	}
+
+	return append(actions, model.RestoreAction{Type: model.RestoreActionDelete, Path: assetPath})
+}
+
+func (m *FileManager) applyRestoreAction(action model.RestoreAction) error {
+	switch action.Type {
+	case model.RestoreActionDelete:
+		m.logger.Debug("Deleting generated poster..", zap.String("filePath", action.Path))
+		if err := os.Remove(action.Path); err != nil {
+			m.logger.Error("unable to delete generated poster",
+				zap.String("filePath", action.Path),
+				zap.Error(err),
+			)
+			return err
+		}
+	case model.RestoreActionRename:
+		if _, err := os.Stat(action.Target); err == nil {
+			m.logger.Warn("poster already exists, backup not restored",
+				zap.String("backupPosterFilePath", action.Path),
+				zap.String("posterPath", action.Target),
+			)
+			return nil
+		}
+
+		m.logger.Debug("Restoring backed up poster..", zap.String("backupPosterFilePath", action.Path))
+		if err := os.Rename(action.Path, action.Target); err != nil {
+			m.logger.Error("unable to restore backed up poster",
+				zap.String("backupPosterFilePath", action.Path),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// writeFiles creates the given files, relative to the temp dir, with their content
+func (s *FileManagerTestSuite) writeFiles(files map[string]string) {
+	for name, content := range files {
+		filePath := filepath.Join(s.tempDir, name)
+		s.Require().NoError(os.MkdirAll(filepath.Dir(filePath), 0775))
+		s.Require().NoError(os.WriteFile(filePath, []byte(content), 0664))
+	}
+}
+
+func (s *FileManagerTestSuite) TestRestorePosters() {
+	// Arrange
+	s.writeFiles(map[string]string{
+		"Movie (2023)/Movie (2023).mkv":               "video",
+		"Movie (2023)/Movie (2023)-original.jpeg":     "original",
+		"Movie (2023)/Movie (2023)-poster.png":        "generated",
+		"Movie (2023)/Movie (2023)-poster.jpg-backup": "backup",
+		"Show/season01-original.jpeg":                 "original",
+		"Show/season01-poster.png":                    "generated season",
+		"Show/season01.png":                           "generated season",
+		"Show/show-original.jpeg":                     "original",
+		"Show/show-poster.png":                        "generated show",
+		"Show/show.png":                               "custom show poster",
+		"Other (2020)/Other (2020)-poster.png":        "not generated",
+	})
+
+	// Act
+	actions, err := s.manager.RestorePosters(s.tempDir, false)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Len(actions, 5)
+	s.NoFileExists(filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.png"))
+	s.NoFileExists(filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.jpg-backup"))
+	s.FileExists(filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.jpg"))
+	s.FileExists(filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-original.jpeg"))
+	s.NoFileExists(filepath.Join(s.tempDir, "Show/season01-poster.png"))
+	s.NoFileExists(filepath.Join(s.tempDir, "Show/season01.png"))
+	s.NoFileExists(filepath.Join(s.tempDir, "Show/show-poster.png"))
+	s.FileExists(filepath.Join(s.tempDir, "Show/show.png"))
+	s.FileExists(filepath.Join(s.tempDir, "Other (2020)/Other (2020)-poster.png"))
+}
+
+func (s *FileManagerTestSuite) TestRestorePosters_DryRun() {
+	// Arrange
+	s.writeFiles(map[string]string{
+		"Movie (2023)/Movie (2023)-original.jpeg":     "original",
+		"Movie (2023)/Movie (2023)-poster.png":        "generated",
+		"Movie (2023)/Movie (2023)-poster.jpg-backup": "backup",
+	})
+
+	// Act
+	actions, err := s.manager.RestorePosters(s.tempDir, true)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal([]model.RestoreAction{
+		{Type: model.RestoreActionDelete, Path: filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.png")},
+		{
+			Type:   model.RestoreActionRename,
+			Path:   filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.jpg-backup"),
+			Target: filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.jpg"),
+		},
+	}, actions)
+	s.FileExists(filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.png"))
+	s.FileExists(filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.jpg-backup"))
+}
+
+func (s *FileManagerTestSuite) TestRestorePosters_BackupTargetExists() {
+	// Arrange
+	s.writeFiles(map[string]string{
+		"Movie (2023)/Movie (2023)-poster.jpg":        "current",
+		"Movie (2023)/Movie (2023)-poster.jpg-backup": "backup",
+	})
+
+	// Act
+	_, err := s.manager.RestorePosters(s.tempDir, false)
+
+	// Assert
+	s.Require().NoError(err)
+	content, err := os.ReadFile(filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.jpg"))
+	s.Require().NoError(err)
+	s.Equal("current", string(content))
+	s.FileExists(filepath.Join(s.tempDir, "Movie (2023)/Movie (2023)-poster.jpg-backup"))
+}
+
+func (s *FileManagerTestSuite) TestRestorePosters_MissingDir() {
+	// Act
+	_, err := s.manager.RestorePosters(filepath.Join(s.tempDir, "missing"), false)
+
+	// Assert
+	s.Error(err)
+}
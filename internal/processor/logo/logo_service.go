@@ -2,7 +2,9 @@ package logo
 
 import (
 	"errors"
+	"image"
 	"image/color"
+	"image/draw"
 
 	"github.com/fogleman/gg"
 	"go.uber.org/zap"
@@ -11,6 +13,9 @@ import (
 	text "github.com/zepollabot/media-rating-overlay/internal/processor/text"
 )
 
+// lowConfidenceOpacity is the opacity of the logos of the ratings having too few votes
+const lowConfidenceOpacity = 0x80
+
 type TextCreatorInterface interface {
 	CreateContext(
 		areaWidth float64,
@@ -101,8 +106,13 @@ func (s *LogoService) PositionLogos(
 		singleLogoAreaContext.DrawImage(logo.Image.Context.Image(), centeringMargin, 0)
 		singleLogoAreaContext.DrawImage(logo.Text.Context.Image(), marginToApplyLeftToText, 0)
 
+		singleLogoImage := singleLogoAreaContext.Image()
+		if logo.LowConfidence {
+			singleLogoImage = dimImage(singleLogoImage)
+		}
+
 		logoAreaContext.DrawImage(
-			singleLogoAreaContext.Image(),
+			singleLogoImage,
 			startMargin,
 			0,
 		)
@@ -113,6 +123,13 @@ func (s *LogoService) PositionLogos(
 	return logoAreaContext, nil
 }
 
+// dimImage returns a copy of the image drawn at the opacity of the low confidence logos
+func dimImage(img image.Image) image.Image {
+	dimmed := image.NewRGBA(img.Bounds())
+	draw.DrawMask(dimmed, dimmed.Bounds(), img, img.Bounds().Min, image.NewUniform(color.Alpha{A: lowConfidenceOpacity}), image.Point{}, draw.Over)
+	return dimmed
+}
+
 // chooseFontSize chooses the smallest font size among logos
 func (s *LogoService) chooseFontSize(logos []*model.Logo) float64 {
 	fontSize := 0.0
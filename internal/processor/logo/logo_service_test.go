@@ -148,6 +148,42 @@ func (s *LogoServiceSuite) TestPositionLogos_Success_VisualDebug() {
 	s.Equal(uint32(expectedColor.A)*0x101, a, "Alpha component does not match")
 }
 
+func (s *LogoServiceSuite) TestPositionLogos_LowConfidenceDimmed() {
+	// Arrange
+	areaWidth := 300.0
+	areaHeight := 50.0
+
+	imageContext := gg.NewContext(50, 50)
+	imageContext.SetColor(color.NRGBA{R: 255, G: 0, B: 0, A: 255}) // Red
+	imageContext.Clear()
+	textContext := gg.NewContext(100, 50)
+
+	logos := []*model.Logo{
+		{
+			Image: model.Image{Context: imageContext},
+			Text: model.Text{
+				Value:            "TMDB",
+				Points:           12.0,
+				HorizontalMargin: 5.0,
+			},
+			LowConfidence: true,
+		},
+	}
+
+	s.mockTextCreator.On("CreateContext", areaWidth, areaHeight, 5.0, 12.0, logos[0].Text.Value, text_processor.FontPath).
+		Return(textContext, nil).Once()
+
+	// Act
+	resultContext, err := s.logoService.PositionLogos(logos, areaWidth, areaHeight, false)
+
+	// Assert
+	s.NoError(err)
+	s.Require().NotNil(resultContext)
+	// The logo is centered: (300 - 150) / 2 = 75 of margin before the image
+	_, _, _, a := resultContext.Image().At(80, 10).RGBA()
+	s.Equal(uint32(lowConfidenceOpacity)*0x101, a, "the logo should be drawn dimmed")
+}
+
 func (s *LogoServiceSuite) TestPositionLogos_NoLogos() {
 	// Arrange
 	logos := []*model.Logo{}
@@ -2,6 +2,7 @@ package poster
 
 import (
 	"context"
+	"errors"
 	"image"
 
 	"github.com/fogleman/gg"
@@ -68,8 +69,17 @@ func (m *PosterGenerator) ApplyLogos(
 	item model.Item,
 ) (string, error) {
 
+	// Select the ratings shown on the posters of the library, in display order
+	ratings := model.SelectRatings(item.Ratings, config)
+	if len(ratings) == 0 {
+		m.logger.Debug("No rating to show, poster not generated",
+			zap.String("Item ID", item.ID),
+		)
+		return filePath, errors.New(model.NoRatingToShow)
+	}
+
 	// Calculate number of logos
-	estimatedNumberOfLogos := len(item.Ratings)
+	estimatedNumberOfLogos := len(ratings)
 	m.logger.Debug("estimated number of logos to be applied",
 		zap.Int("estimatedNumberOfLogos", estimatedNumberOfLogos),
 	)
@@ -102,7 +112,7 @@ func (m *PosterGenerator) ApplyLogos(
 		zap.Any("logoDimensions", logoDimensions),
 	)
 
-	logos := m.buildLogos(ctx, item, logoDimensions)
+	logos := m.buildLogos(ctx, item, ratings, logoDimensions)
 
 	// Position logos
 	logoAreaContext, err := m.logoService.PositionLogos(logos, logoAreaWidth, logoAreaHeight, m.visualDebug)
@@ -135,10 +145,11 @@ func (m *PosterGenerator) ApplyLogos(
 	return posterFilePath, nil
 }
 
-func (m *PosterGenerator) buildLogos(ctx context.Context, item model.Item, logoDimensions model.LogoDimensions) []*model.Logo {
+// buildLogos returns the logos of the ratings of the item, in the order of the ratings
+func (m *PosterGenerator) buildLogos(ctx context.Context, item model.Item, ratings []model.Rating, logoDimensions model.LogoDimensions) []*model.Logo {
 	logos := make([]*model.Logo, 0)
 
-	for _, rating := range item.Ratings {
+	for _, rating := range ratings {
 		ratingService, ok := lo.Find(m.ratingPlatformServices, func(rs ratingModel.RatingService) bool {
 			return rs.Name == rating.Name
 		})
@@ -162,6 +173,9 @@ func (m *PosterGenerator) buildLogos(ctx context.Context, item model.Item, logoD
 			continue
 		}
 
+		for _, logo := range serviceLogos {
+			logo.LowConfidence = rating.LowConfidence
+		}
 		logos = append(logos, serviceLogos...)
 	}
 
@@ -162,6 +162,83 @@ func (s *PosterGeneratorTestSuite) TestApplyLogos_SaveImageError() {
 	s.imageProcessor.AssertExpectations(s.T())
 }
 
+func (s *PosterGeneratorTestSuite) TestApplyLogos_LibraryRatingSelection() {
+	// Arrange
+	filePath := "test.jpg"
+	item := model.Item{
+		ID: "test-id",
+		Ratings: []model.Rating{
+			{Name: "service1", Rating: 8.5, Type: model.RatingServiceTypeAudience},
+			{Name: "service2", Rating: 9.0, Type: model.RatingServiceTypeCritic},
+			{Name: "service2", Rating: 8.0, Type: model.RatingServiceTypeAudience},
+			{Name: "service3", Rating: 7.0, Type: model.RatingServiceTypeAudience},
+		},
+	}
+	libraryConfig := &config.Library{
+		Overlay: config.Overlay{Height: 0.2},
+		Ratings: []config.RatingSource{
+			{Name: "service3"},
+			{Name: "service2", Type: model.RatingServiceTypeAudience},
+			{Name: "service1"},
+		},
+		MaxRatings: 2,
+	}
+
+	ratingServices := make([]ratingModel.RatingService, 0)
+	for _, name := range []string{"service1", "service2", "service3"} {
+		logoService := rating_service_mocks.NewLogoService(s.T())
+		logoService.EXPECT().GetLogos(mock.Anything, mock.Anything, name, mock.Anything).RunAndReturn(
+			func(_ context.Context, ratings []model.Rating, _ string, _ model.LogoDimensions) ([]*model.Logo, error) {
+				return []*model.Logo{{Text: model.Text{Value: name + " " + ratings[0].Type}}}, nil
+			},
+		).Maybe()
+		ratingServices = append(ratingServices, ratingModel.RatingService{Name: name, LogoService: logoService})
+	}
+	s.generator.ratingPlatformServices = ratingServices
+
+	var positionedLogos []string
+	s.overlayService.EXPECT().CreateDrawContextWithOverlay(filePath, item, libraryConfig).Return(gg.NewContext(100, 100), nil)
+	s.logoService.EXPECT().PositionLogos(mock.Anything, mock.Anything, mock.Anything, false).RunAndReturn(
+		func(logos []*model.Logo, _ float64, _ float64, _ bool) (*gg.Context, error) {
+			for _, logo := range logos {
+				positionedLogos = append(positionedLogos, logo.Text.Value)
+			}
+			return gg.NewContext(100, 100), nil
+		},
+	)
+	s.imageProcessor.EXPECT().SaveImage(mock.Anything, filePath).Return(filePath, nil)
+
+	// Act
+	_, err := s.generator.ApplyLogos(context.Background(), filePath, libraryConfig, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal([]string{"service3 audience", "service2 audience"}, positionedLogos)
+}
+
+func (s *PosterGeneratorTestSuite) TestApplyLogos_NoSelectedRating() {
+	// Arrange
+	filePath := "test.jpg"
+	item := model.Item{
+		ID: "test-id",
+		Ratings: []model.Rating{
+			{Name: "service1", Rating: 8.5, Type: model.RatingServiceTypeAudience},
+		},
+	}
+	libraryConfig := &config.Library{
+		Overlay: config.Overlay{Height: 0.2},
+		Ratings: []config.RatingSource{{Name: "service2"}},
+	}
+	// No expectation is set: the overlay, logos and image services must not be called
+
+	// Act
+	resultPath, err := s.generator.ApplyLogos(context.Background(), filePath, libraryConfig, item)
+
+	// Assert
+	s.EqualError(err, model.NoRatingToShow)
+	s.Equal(filePath, resultPath)
+}
+
 func (s *PosterGeneratorTestSuite) TestBuildLogos_RatingServiceFound() {
 	// Arrange
 	ctx := context.Background()
@@ -185,7 +262,7 @@ func (s *PosterGeneratorTestSuite) TestBuildLogos_RatingServiceFound() {
 	s.generator.ratingPlatformServices = []ratingModel.RatingService{ratingServiceInstance}
 
 	// Act
-	logos := s.generator.buildLogos(ctx, item, logoDimensions)
+	logos := s.generator.buildLogos(ctx, item, item.Ratings, logoDimensions)
 
 	// Assert
 	s.Len(logos, 1)
@@ -225,7 +302,7 @@ func (s *PosterGeneratorTestSuite) TestBuildLogos_GetLogosError() {
 	s.generator.ratingPlatformServices = []ratingModel.RatingService{ratingServiceInstance1, ratingServiceInstance2}
 
 	// Act
-	logos := s.generator.buildLogos(ctx, item, logoDimensions)
+	logos := s.generator.buildLogos(ctx, item, item.Ratings, logoDimensions)
 
 	// Assert
 	s.Len(logos, 1) // Expecting one logo as the first call to GetLogos fails
@@ -6,6 +6,7 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
 	"github.com/zepollabot/media-rating-overlay/internal/model"
 	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service/model"
 )
@@ -13,25 +14,33 @@ import (
 // RatingBuilderService implements RatingBuilder interface
 type RatingBuilderService struct {
 	ratingPlatformServices []rating.RatingService
+	votes                  *config.Votes
 	logger                 *zap.Logger
 }
 
-func NewRatingBuilderService(ratingPlatformServices []rating.RatingService, logger *zap.Logger) *RatingBuilderService {
+func NewRatingBuilderService(ratingPlatformServices []rating.RatingService, votes *config.Votes, logger *zap.Logger) *RatingBuilderService {
 	return &RatingBuilderService{
 		ratingPlatformServices: ratingPlatformServices,
+		votes:                  votes,
 		logger:                 logger,
 	}
 }
 
-func (s *RatingBuilderService) BuildRatings(ctx context.Context, item *model.Item) error {
+// BuildRatings adds to the item the ratings of the services applying to its library
+func (s *RatingBuilderService) BuildRatings(ctx context.Context, item *model.Item, libraryName string) error {
 	s.logger.Debug("Building ratings..",
 		zap.String("Item ID", item.ID),
 	)
 
 	for _, ratingService := range s.ratingPlatformServices {
-		if lo.ContainsBy(item.Ratings, func(rating model.Rating) bool {
-			return rating.Name == ratingService.Name
-		}) {
+		if !ratingService.AppliesTo(libraryName) {
+			s.logger.Debug("Rating service not enabled for the library",
+				zap.String("Item ID", item.ID),
+				zap.String("Rating Service", ratingService.Name),
+				zap.String("Library", libraryName),
+			)
+			continue
+		} else if hasRating(item, ratingService.Name) {
 			s.logger.Debug("Rating already exists",
 				zap.String("Item ID", item.ID),
 				zap.String("Rating Service", ratingService.Name),
@@ -44,13 +53,62 @@ func (s *RatingBuilderService) BuildRatings(ctx context.Context, item *model.Ite
 				zap.String("Rating Service", ratingService.Name),
 			)
 
-			rating, err := ratingService.PlatformService.GetRating(ctx, *item)
+			ratings, err := ratingService.PlatformService.GetRatings(ctx, *item)
 			if err != nil {
 				return err
 			}
-			item.Ratings = append(item.Ratings, rating)
+
+			// A service can provide the ratings of other platforms too, e.g. an aggregator:
+			// the ratings already known are kept
+			for _, rating := range ratings {
+				if hasRatingOfType(item, rating.Name, rating.Type) {
+					s.logger.Debug("Rating already exists",
+						zap.String("Item ID", item.ID),
+						zap.String("Rating", rating.Name),
+						zap.String("Type", rating.Type),
+						zap.String("Rating Service", ratingService.Name),
+					)
+					continue
+				}
+				if s.isLowConfidence(rating) {
+					if s.votes.LowConfidence == config.LowConfidenceHide {
+						s.logger.Debug("Rating hidden, not enough votes",
+							zap.String("Item ID", item.ID),
+							zap.String("Rating", rating.Name),
+							zap.Int("Votes", rating.Votes),
+							zap.Int("Min Votes", s.votes.MinVotesOf(rating.Name)),
+						)
+						continue
+					}
+					rating.LowConfidence = true
+				}
+				item.Ratings = append(item.Ratings, rating)
+			}
 		}
 	}
 
 	return nil
 }
+
+// isLowConfidence reports whether the rating has fewer votes than the minimum set for its platform,
+// a rating with unknown votes being always trusted
+func (s *RatingBuilderService) isLowConfidence(rating model.Rating) bool {
+	if s.votes == nil || rating.Votes == 0 {
+		return false
+	}
+	return rating.Votes < s.votes.MinVotesOf(rating.Name)
+}
+
+func hasRating(item *model.Item, name string) bool {
+	return lo.ContainsBy(item.Ratings, func(rating model.Rating) bool {
+		return rating.Name == name
+	})
+}
+
+// hasRatingOfType reports whether the item has the rating of the given name and type, a platform
+// providing both a critic and an audience rating, e.g. Rotten Tomatoes
+func hasRatingOfType(item *model.Item, name string, ratingType string) bool {
+	return lo.ContainsBy(item.Ratings, func(rating model.Rating) bool {
+		return rating.Name == name && rating.Type == ratingType
+	})
+}
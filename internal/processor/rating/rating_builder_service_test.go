@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
 	"github.com/zepollabot/media-rating-overlay/internal/model"
 	ratingmocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/mocks"
 	ratingmodel "github.com/zepollabot/media-rating-overlay/internal/rating-service/model"
@@ -38,7 +39,7 @@ func (s *RatingBuilderServiceTestSuite) SetupSuite() {
 		},
 	}
 
-	s.service = NewRatingBuilderService(ratingServices, s.logger)
+	s.service = NewRatingBuilderService(ratingServices, config.DefaultVotes(), s.logger)
 }
 
 func (s *RatingBuilderServiceTestSuite) TearDownTest() {
@@ -56,8 +57,8 @@ func (s *RatingBuilderServiceTestSuite) TestBuildRatings_NewRatings() {
 	expectedRating2 := model.Rating{Name: "service2", Rating: 9.0, Type: model.RatingServiceTypeAudience}
 
 	s.mockPlatformService1.EXPECT().
-		GetRating(mock.Anything, *item).
-		Return(expectedRating1, nil).
+		GetRatings(mock.Anything, *item).
+		Return([]model.Rating{expectedRating1}, nil).
 		Once()
 
 	updatedItem := &model.Item{
@@ -66,12 +67,12 @@ func (s *RatingBuilderServiceTestSuite) TestBuildRatings_NewRatings() {
 	}
 
 	s.mockPlatformService2.EXPECT().
-		GetRating(mock.Anything, *updatedItem).
-		Return(expectedRating2, nil).
+		GetRatings(mock.Anything, *updatedItem).
+		Return([]model.Rating{expectedRating2}, nil).
 		Once()
 
 	// Act
-	err := s.service.BuildRatings(context.Background(), item)
+	err := s.service.BuildRatings(context.Background(), item, "Movies")
 
 	// Assert
 	s.Require().NoError(err)
@@ -90,12 +91,12 @@ func (s *RatingBuilderServiceTestSuite) TestBuildRatings_ExistingRating() {
 	expectedRating2 := model.Rating{Name: "service2", Rating: 9.0, Type: model.RatingServiceTypeAudience}
 
 	s.mockPlatformService2.EXPECT().
-		GetRating(mock.Anything, *item).
-		Return(expectedRating2, nil).
+		GetRatings(mock.Anything, *item).
+		Return([]model.Rating{expectedRating2}, nil).
 		Once()
 
 	// Act
-	err := s.service.BuildRatings(context.Background(), item)
+	err := s.service.BuildRatings(context.Background(), item, "Movies")
 
 	// Assert
 	s.Require().NoError(err)
@@ -104,6 +105,56 @@ func (s *RatingBuilderServiceTestSuite) TestBuildRatings_ExistingRating() {
 	s.Contains(item.Ratings, expectedRating2)
 }
 
+func (s *RatingBuilderServiceTestSuite) TestBuildRatings_MultipleRatings() {
+	// Arrange
+	existingRating := model.Rating{Name: "other", Rating: 7.0, Type: model.RatingServiceTypeAudience}
+	item := &model.Item{
+		ID:      "test-id",
+		Ratings: []model.Rating{existingRating},
+	}
+	expectedRating1 := model.Rating{Name: "service1", Rating: 8.5, Type: model.RatingServiceTypeCritic}
+	expectedRating2 := model.Rating{Name: "service2", Rating: 9.0, Type: model.RatingServiceTypeAudience}
+	duplicatedRating := model.Rating{Name: "other", Rating: 6.0, Type: model.RatingServiceTypeAudience}
+	otherTypeRating := model.Rating{Name: "other", Rating: 6.5, Type: model.RatingServiceTypeCritic}
+
+	s.mockPlatformService1.EXPECT().
+		GetRatings(mock.Anything, *item).
+		Return([]model.Rating{expectedRating1, expectedRating2, duplicatedRating, otherTypeRating}, nil).
+		Once()
+
+	// Act
+	err := s.service.BuildRatings(context.Background(), item, "Movies")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal([]model.Rating{existingRating, expectedRating1, expectedRating2, otherTypeRating}, item.Ratings)
+}
+
+func (s *RatingBuilderServiceTestSuite) TestBuildRatings_NoRating() {
+	// Arrange
+	item := &model.Item{
+		ID:      "test-id",
+		Ratings: []model.Rating{},
+	}
+
+	s.mockPlatformService1.EXPECT().
+		GetRatings(mock.Anything, *item).
+		Return(nil, nil).
+		Once()
+
+	s.mockPlatformService2.EXPECT().
+		GetRatings(mock.Anything, *item).
+		Return(nil, nil).
+		Once()
+
+	// Act
+	err := s.service.BuildRatings(context.Background(), item, "Movies")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Empty(item.Ratings)
+}
+
 func (s *RatingBuilderServiceTestSuite) TestBuildRatings_ServiceError() {
 	// Arrange
 	item := &model.Item{
@@ -112,18 +163,91 @@ func (s *RatingBuilderServiceTestSuite) TestBuildRatings_ServiceError() {
 	}
 
 	s.mockPlatformService1.EXPECT().
-		GetRating(mock.Anything, *item).
-		Return(model.Rating{}, assert.AnError).
+		GetRatings(mock.Anything, *item).
+		Return(nil, assert.AnError).
 		Once()
 
 	// Act
-	err := s.service.BuildRatings(context.Background(), item)
+	err := s.service.BuildRatings(context.Background(), item, "Movies")
 
 	// Assert
 	s.Error(err)
 	s.Empty(item.Ratings)
 }
 
+func (s *RatingBuilderServiceTestSuite) TestBuildRatings_LibraryRestriction() {
+	// Arrange
+	animeService := ratingmocks.NewRatingPlatformService(s.T())
+	service := NewRatingBuilderService([]ratingmodel.RatingService{
+		{Name: "anime", PlatformService: animeService, Libraries: []string{"Anime"}},
+	}, config.DefaultVotes(), s.logger)
+	movie := &model.Item{ID: "movie-id"}
+	anime := &model.Item{ID: "anime-id"}
+	animeService.EXPECT().
+		GetRatings(mock.Anything, *anime).
+		Return([]model.Rating{{Name: "anime", Rating: 8.4, Type: model.RatingServiceTypeAudience}}, nil).
+		Once()
+
+	// Act
+	movieErr := service.BuildRatings(context.Background(), movie, "Movies")
+	animeErr := service.BuildRatings(context.Background(), anime, "Anime")
+
+	// Assert
+	s.NoError(movieErr)
+	s.Empty(movie.Ratings, "the service should not be asked for the items of other libraries")
+	s.NoError(animeErr)
+	s.Equal([]model.Rating{{Name: "anime", Rating: 8.4, Type: model.RatingServiceTypeAudience}}, anime.Ratings)
+}
+
+func (s *RatingBuilderServiceTestSuite) TestBuildRatings_LowConfidenceHidden() {
+	// Arrange
+	platformService := ratingmocks.NewRatingPlatformService(s.T())
+	service := NewRatingBuilderService([]ratingmodel.RatingService{
+		{Name: "TMDB", PlatformService: platformService},
+	}, &config.Votes{MinVotes: map[string]int{"TMDB": 100, "IMDB": 1000}, LowConfidence: config.LowConfidenceHide}, s.logger)
+	item := &model.Item{ID: "test-id"}
+	platformService.EXPECT().
+		GetRatings(mock.Anything, *item).
+		Return([]model.Rating{
+			{Name: "TMDB", Rating: 9.5, Type: model.RatingServiceTypeAudience, Votes: 12},
+			{Name: "IMDB", Rating: 7.1, Type: model.RatingServiceTypeAudience, Votes: 1500},
+			{Name: "Metacritic", Rating: 81, Type: model.RatingServiceTypeCritic},
+		}, nil).
+		Once()
+
+	// Act
+	err := service.BuildRatings(context.Background(), item, "Movies")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal([]model.Rating{
+		{Name: "IMDB", Rating: 7.1, Type: model.RatingServiceTypeAudience, Votes: 1500},
+		{Name: "Metacritic", Rating: 81, Type: model.RatingServiceTypeCritic},
+	}, item.Ratings, "the rating with too few votes should be hidden, the one with unknown votes kept")
+}
+
+func (s *RatingBuilderServiceTestSuite) TestBuildRatings_LowConfidenceDimmed() {
+	// Arrange
+	platformService := ratingmocks.NewRatingPlatformService(s.T())
+	service := NewRatingBuilderService([]ratingmodel.RatingService{
+		{Name: "TMDB", PlatformService: platformService},
+	}, &config.Votes{MinVotes: map[string]int{"TMDB": 100}, LowConfidence: config.LowConfidenceDim}, s.logger)
+	item := &model.Item{ID: "test-id"}
+	platformService.EXPECT().
+		GetRatings(mock.Anything, *item).
+		Return([]model.Rating{{Name: "TMDB", Rating: 9.5, Type: model.RatingServiceTypeAudience, Votes: 12}}, nil).
+		Once()
+
+	// Act
+	err := service.BuildRatings(context.Background(), item, "Movies")
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal([]model.Rating{
+		{Name: "TMDB", Rating: 9.5, Type: model.RatingServiceTypeAudience, Votes: 12, LowConfidence: true},
+	}, item.Ratings)
+}
+
 func TestRatingBuilderServiceSuite(t *testing.T) {
 	suite.Run(t, new(RatingBuilderServiceTestSuite))
 }
@@ -0,0 +1,135 @@
+package state
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// JSONStore keeps the state of the processed items in a JSON file,
+// keyed by media service and item ID
+type JSONStore struct {
+	filePath string
+	logger   *zap.Logger
+	mu       sync.RWMutex
+	items    map[string]model.ItemState
+}
+
+// NewJSONStore creates a store, loading the state file when it exists
+func NewJSONStore(filePath string, logger *zap.Logger) (*JSONStore, error) {
+	store := &JSONStore{
+		filePath: filePath,
+		logger:   logger,
+		items:    map[string]model.ItemState{},
+	}
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		logger.Info("No state file found, every item will be processed", zap.String("filePath", filePath))
+		return store, nil
+	} else if err != nil {
+		logger.Error("unable to read state file",
+			zap.String("filePath", filePath),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &store.items); err != nil {
+		logger.Error("unable to decode state file",
+			zap.String("filePath", filePath),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Key builds the key of an item of a media service
+func Key(mediaServiceName string, itemID string) string {
+	return mediaServiceName + "/" + itemID
+}
+
+// Get returns the state of an item, if recorded
+func (s *JSONStore) Get(key string) (model.ItemState, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	itemState, found := s.items[key]
+	return itemState, found
+}
+
+// Set records the state of an item
+func (s *JSONStore) Set(key string, itemState model.ItemState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[key] = itemState
+}
+
+// Delete forgets the state of an item, so it is processed again on the next run
+func (s *JSONStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.items, key)
+}
+
+// Save writes the state file, replacing it only once fully written
+func (s *JSONStore) Save() error {
+	s.mu.RLock()
+	data, err := json.MarshalIndent(s.items, "", "  ")
+	s.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.filePath), 0775); err != nil {
+		s.logger.Error("unable to create state dir",
+			zap.String("filePath", s.filePath),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	tmpFilePath := s.filePath + ".tmp"
+	if err := os.WriteFile(tmpFilePath, data, 0664); err != nil {
+		s.logger.Error("unable to write state file",
+			zap.String("filePath", tmpFilePath),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return os.Rename(tmpFilePath, s.filePath)
+}
+
+// HashFile returns the SHA-256 of a file content
+func HashFile(filePath string) (string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// HashValue returns the SHA-256 of the JSON encoding of a value
+func HashValue(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,111 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type JSONStoreTestSuite struct {
+	suite.Suite
+	logger   *zap.Logger
+	filePath string
+}
+
+func (s *JSONStoreTestSuite) SetupTest() {
+	s.logger = zap.NewNop()
+	s.filePath = filepath.Join(s.T().TempDir(), "state", "state.json")
+}
+
+func TestJSONStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(JSONStoreTestSuite))
+}
+
+func (s *JSONStoreTestSuite) TestNewJSONStore_NoFile() {
+	// Act
+	store, err := NewJSONStore(s.filePath, s.logger)
+
+	// Assert
+	s.Require().NoError(err)
+	_, found := store.Get(Key("plex", "1"))
+	s.False(found)
+}
+
+func (s *JSONStoreTestSuite) TestNewJSONStore_InvalidFile() {
+	// Arrange
+	s.Require().NoError(os.MkdirAll(filepath.Dir(s.filePath), 0775))
+	s.Require().NoError(os.WriteFile(s.filePath, []byte("not json"), 0664))
+
+	// Act
+	store, err := NewJSONStore(s.filePath, s.logger)
+
+	// Assert
+	s.Error(err)
+	s.Nil(store)
+}
+
+func (s *JSONStoreTestSuite) TestSaveAndLoad() {
+	// Arrange
+	itemState := model.ItemState{
+		Ratings:           []model.Rating{{Name: "TMDB", Rating: 7.5, Type: model.RatingServiceTypeAudience}},
+		OverlayConfigHash: "overlay-hash",
+		SourcePosterHash:  "poster-hash",
+		OutputPath:        "/posters/1/poster.overlay.jpg",
+		UpdatedAt:         time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	store, err := NewJSONStore(s.filePath, s.logger)
+	s.Require().NoError(err)
+	store.Set(Key("plex", "1"), itemState)
+
+	// Act
+	err = store.Save()
+
+	// Assert
+	s.Require().NoError(err)
+	s.NoFileExists(s.filePath + ".tmp")
+
+	loaded, err := NewJSONStore(s.filePath, s.logger)
+	s.Require().NoError(err)
+	loadedState, found := loaded.Get(Key("plex", "1"))
+	s.True(found)
+	s.Equal(itemState, loadedState)
+	_, found = loaded.Get(Key("jellyfin", "1"))
+	s.False(found)
+}
+
+func (s *JSONStoreTestSuite) TestHashFile() {
+	s.T().Run("same content gives same hash", func(t *testing.T) {
+		dir := t.TempDir()
+		first := filepath.Join(dir, "first.jpg")
+		second := filepath.Join(dir, "second.jpg")
+		s.Require().NoError(os.WriteFile(first, []byte("poster"), 0664))
+		s.Require().NoError(os.WriteFile(second, []byte("poster"), 0664))
+
+		firstHash, err := HashFile(first)
+		s.Require().NoError(err)
+		secondHash, err := HashFile(second)
+		s.Require().NoError(err)
+
+		s.Equal(firstHash, secondHash)
+	})
+
+	s.T().Run("missing file", func(t *testing.T) {
+		_, err := HashFile(filepath.Join(t.TempDir(), "missing.jpg"))
+		s.Error(err)
+	})
+}
+
+func (s *JSONStoreTestSuite) TestHashValue() {
+	first, err := HashValue(map[string]int{"height": 10})
+	s.Require().NoError(err)
+	second, err := HashValue(map[string]int{"height": 12})
+	s.Require().NoError(err)
+
+	s.NotEqual(first, second)
+}
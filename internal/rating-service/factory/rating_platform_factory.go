@@ -2,20 +2,48 @@
 package factory
 
 import (
+	"fmt"
+
 	"go.uber.org/zap"
 
 	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
 	"github.com/zepollabot/media-rating-overlay/internal/constant"
 	ratingModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/model"
+	clientAnilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/client"
+	searchAnilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/search"
+	serviceAnilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/service"
+	datasetImdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/dataset"
+	serviceImdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/service"
+	clientLetterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/client"
+	searchLetterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/search"
+	serviceLetterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/service"
+	clientMdblist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/client"
+	searchMdblist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/search"
+	serviceMdblist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/service"
+	clientMyanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/client"
+	searchMyanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/search"
+	serviceMyanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/service"
+	clientOmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/client"
+	searchOmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/search"
+	serviceOmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/service"
+	clientRottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/client"
+	searchRottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/search"
+	serviceRottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/service"
 	clientTmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/client"
 	filterTmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/filter"
 	tmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/search"
 	serviceTmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/service"
+	clientTrakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/client"
+	searchTrakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/search"
+	serviceTrakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/service"
 )
 
 type RatingServiceBaseFactory struct {
 	Logger *zap.Logger
 	Config *config.Config
+	// omdbSearchService is shared by the services of the ratings found on OMDb,
+	// so that each item is requested once
+	omdbSearchService *searchOmdb.OMDbSearchService
 }
 
 func NewRatingServiceBaseFactory(logger *zap.Logger, config *config.Config) *RatingServiceBaseFactory {
@@ -50,6 +78,8 @@ func (f *RatingServiceBaseFactory) BuildTMDBComponents() (ratingModel.RatingServ
 	return tmdbService, nil
 }
 
+// BuildRottenTomatoesComponents returns the Rotten Tomatoes components, reading the ratings from the
+// Rotten Tomatoes pages when enabled, from OMDb otherwise
 func (f *RatingServiceBaseFactory) BuildRottenTomatoesComponents() (ratingModel.RatingService, error) {
 	f.Logger.Info("Building Rotten Tomatoes rating service")
 
@@ -57,12 +87,29 @@ func (f *RatingServiceBaseFactory) BuildRottenTomatoesComponents() (ratingModel.
 		Name: constant.RatingServiceRottenTomatoes,
 	}
 
-	// Rotten Tomatoes Platform Service is not yet implemented
+	if f.Config.RottenTomatoes.Enabled {
+		ratingClient, err := clientRottenTomatoes.NewRottenTomatoesClient(&f.Config.HTTPClient, f.Config.Logger.LogFilePath, f.Logger)
+		if err != nil {
+			f.Logger.Error("error creating Rotten Tomatoes client", zap.Error(err))
+			return ratingModel.RatingService{}, err
+		}
+		searchService := searchRottenTomatoes.NewRottenTomatoesSearchService(ratingClient, f.Logger)
+		rottenTomatoesService.PlatformService = serviceRottenTomatoes.NewRottenTomatoesRatingPlatformService(f.Logger, searchService)
+	} else if f.Config.OMDb.Enabled {
+		ratingPlatformService, err := f.buildOMDbRatingPlatformService(constant.RatingServiceRottenTomatoes)
+		if err != nil {
+			return ratingModel.RatingService{}, err
+		}
+		rottenTomatoesService.PlatformService = ratingPlatformService
+	}
+
 	f.Logger.Info("Rotten Tomatoes rating service initialized")
 
 	return rottenTomatoesService, nil
 }
 
+// BuildIMDBComponents returns the IMDB components, reading the ratings from the IMDb datasets
+// when enabled, from OMDb otherwise
 func (f *RatingServiceBaseFactory) BuildIMDBComponents() (ratingModel.RatingService, error) {
 	f.Logger.Info("Building IMDB rating service")
 
@@ -70,8 +117,185 @@ func (f *RatingServiceBaseFactory) BuildIMDBComponents() (ratingModel.RatingServ
 		Name: constant.RatingServiceIMDB,
 	}
 
-	// IMDB Platform Service is not yet implemented
+	if f.Config.IMDB.Enabled {
+		store, err := datasetImdb.LoadStore(f.Config.IMDB.DatasetPath)
+		if err != nil {
+			f.Logger.Error("error loading IMDb dataset",
+				zap.String("datasetPath", f.Config.IMDB.DatasetPath),
+				zap.Error(err),
+			)
+			return ratingModel.RatingService{}, fmt.Errorf("unable to load the IMDb dataset, run the refresh-imdb command first: %w", err)
+		}
+		f.Logger.Info("IMDb dataset loaded", zap.Int("titles", store.Len()))
+		imdbService.PlatformService = serviceImdb.NewIMDbDatasetRatingPlatformService(f.Logger, store)
+	} else if f.Config.OMDb.Enabled {
+		ratingPlatformService, err := f.buildOMDbRatingPlatformService(constant.RatingServiceIMDB)
+		if err != nil {
+			return ratingModel.RatingService{}, err
+		}
+		imdbService.PlatformService = ratingPlatformService
+	}
+
 	f.Logger.Info("IMDB rating service initialized")
 
 	return imdbService, nil
 }
+
+func (f *RatingServiceBaseFactory) BuildMetacriticComponents() (ratingModel.RatingService, error) {
+	f.Logger.Info("Building Metacritic rating service")
+
+	metacriticService := ratingModel.RatingService{
+		Name: constant.RatingServiceMetacritic,
+	}
+
+	if f.Config.OMDb.Enabled {
+		ratingPlatformService, err := f.buildOMDbRatingPlatformService(constant.RatingServiceMetacritic)
+		if err != nil {
+			return ratingModel.RatingService{}, err
+		}
+		metacriticService.PlatformService = ratingPlatformService
+	}
+
+	f.Logger.Info("Metacritic rating service initialized")
+
+	return metacriticService, nil
+}
+
+// BuildTraktComponents returns Trakt-specific components without the logo service
+func (f *RatingServiceBaseFactory) BuildTraktComponents() (ratingModel.RatingService, error) {
+	f.Logger.Info("Building Trakt rating service components")
+
+	traktService := ratingModel.RatingService{
+		Name: constant.RatingServiceTrakt,
+	}
+
+	if f.Config.Trakt.Enabled {
+		ratingClient, err := clientTrakt.NewTraktClient(&f.Config.Trakt, &f.Config.HTTPClient, f.Config.Logger.LogFilePath, f.Logger)
+		if err != nil {
+			f.Logger.Error("error creating Trakt client", zap.Error(err))
+			return ratingModel.RatingService{}, err
+		}
+		searchService := searchTrakt.NewTraktSearchService(ratingClient, f.Logger)
+		ratingPlatformService := serviceTrakt.NewTraktRatingPlatformService(f.Logger, searchService)
+
+		f.Logger.Info("Trakt rating service initialized")
+		traktService.PlatformService = ratingPlatformService
+	}
+
+	return traktService, nil
+}
+
+// BuildLetterboxdComponents returns Letterboxd-specific components without the logo service
+func (f *RatingServiceBaseFactory) BuildLetterboxdComponents() (ratingModel.RatingService, error) {
+	f.Logger.Info("Building Letterboxd rating service components")
+
+	letterboxdService := ratingModel.RatingService{
+		Name: constant.RatingServiceLetterboxd,
+	}
+
+	if f.Config.Letterboxd.Enabled {
+		ratingClient, err := clientLetterboxd.NewLetterboxdClient(&f.Config.HTTPClient, f.Config.Logger.LogFilePath, f.Logger)
+		if err != nil {
+			f.Logger.Error("error creating Letterboxd client", zap.Error(err))
+			return ratingModel.RatingService{}, err
+		}
+		searchService := searchLetterboxd.NewLetterboxdSearchService(ratingClient, f.Logger)
+		ratingPlatformService := serviceLetterboxd.NewLetterboxdRatingPlatformService(f.Logger, searchService)
+
+		f.Logger.Info("Letterboxd rating service initialized")
+		letterboxdService.PlatformService = ratingPlatformService
+	}
+
+	return letterboxdService, nil
+}
+
+// BuildAniListComponents returns AniList-specific components without the logo service.
+// The service applies to the libraries of its configuration only, when set
+func (f *RatingServiceBaseFactory) BuildAniListComponents() (ratingModel.RatingService, error) {
+	f.Logger.Info("Building AniList rating service components")
+
+	aniListService := ratingModel.RatingService{
+		Name:      constant.RatingServiceAniList,
+		Libraries: f.Config.AniList.Libraries,
+	}
+
+	if f.Config.AniList.Enabled {
+		ratingClient, err := clientAnilist.NewAniListClient(&f.Config.HTTPClient, f.Config.Logger.LogFilePath, f.Logger)
+		if err != nil {
+			f.Logger.Error("error creating AniList client", zap.Error(err))
+			return ratingModel.RatingService{}, err
+		}
+		searchService := searchAnilist.NewAniListSearchService(ratingClient, f.Logger)
+		ratingPlatformService := serviceAnilist.NewAniListRatingPlatformService(f.Logger, searchService)
+
+		f.Logger.Info("AniList rating service initialized")
+		aniListService.PlatformService = ratingPlatformService
+	}
+
+	return aniListService, nil
+}
+
+// BuildMyAnimeListComponents returns MyAnimeList-specific components without the logo service.
+// The service applies to the libraries of its configuration only, when set
+func (f *RatingServiceBaseFactory) BuildMyAnimeListComponents() (ratingModel.RatingService, error) {
+	f.Logger.Info("Building MyAnimeList rating service components")
+
+	myAnimeListService := ratingModel.RatingService{
+		Name:      constant.RatingServiceMyAnimeList,
+		Libraries: f.Config.MyAnimeList.Libraries,
+	}
+
+	if f.Config.MyAnimeList.Enabled {
+		ratingClient, err := clientMyanimelist.NewMyAnimeListClient(&f.Config.MyAnimeList, &f.Config.HTTPClient, f.Config.Logger.LogFilePath, f.Logger)
+		if err != nil {
+			f.Logger.Error("error creating MyAnimeList client", zap.Error(err))
+			return ratingModel.RatingService{}, err
+		}
+		searchService := searchMyanimelist.NewMyAnimeListSearchService(ratingClient, f.Logger)
+		ratingPlatformService := serviceMyanimelist.NewMyAnimeListRatingPlatformService(f.Logger, searchService)
+
+		f.Logger.Info("MyAnimeList rating service initialized")
+		myAnimeListService.PlatformService = ratingPlatformService
+	}
+
+	return myAnimeListService, nil
+}
+
+// BuildMDBListComponents returns the MDBList components. MDBList has no logo: its ratings are
+// named after their platforms, and drawn with the logos of these
+func (f *RatingServiceBaseFactory) BuildMDBListComponents() (ratingModel.RatingService, error) {
+	f.Logger.Info("Building MDBList rating service components")
+
+	mdblistService := ratingModel.RatingService{
+		Name: constant.RatingServiceMDBList,
+	}
+
+	if f.Config.MDBList.Enabled {
+		ratingClient, err := clientMdblist.NewMDBListClient(&f.Config.MDBList, &f.Config.HTTPClient, f.Config.Logger.LogFilePath, f.Logger)
+		if err != nil {
+			f.Logger.Error("error creating MDBList client", zap.Error(err))
+			return ratingModel.RatingService{}, err
+		}
+		searchService := searchMdblist.NewMDBListSearchService(ratingClient, f.Logger)
+		ratingPlatformService := serviceMdblist.NewMDBListRatingPlatformService(f.Logger, searchService)
+
+		f.Logger.Info("MDBList rating service initialized")
+		mdblistService.PlatformService = ratingPlatformService
+	}
+
+	return mdblistService, nil
+}
+
+// buildOMDbRatingPlatformService returns the service providing the ratingName rating from OMDb
+func (f *RatingServiceBaseFactory) buildOMDbRatingPlatformService(ratingName string) (*serviceOmdb.OMDbRatingPlatformService, error) {
+	if f.omdbSearchService == nil {
+		ratingClient, err := clientOmdb.NewOMDbClient(&f.Config.OMDb, &f.Config.HTTPClient, f.Config.Logger.LogFilePath, f.Logger)
+		if err != nil {
+			f.Logger.Error("error creating OMDb client", zap.Error(err))
+			return nil, err
+		}
+		f.omdbSearchService = searchOmdb.NewOMDbSearchService(ratingClient, f.Logger)
+	}
+
+	return serviceOmdb.NewOMDbRatingPlatformService(f.Logger, f.omdbSearchService, ratingName)
+}
@@ -1,6 +1,7 @@
 package factory_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	// "github.com/stretchr/testify/assert" // Removed unused import
@@ -12,6 +13,9 @@ import (
 	"github.com/zepollabot/media-rating-overlay/internal/constant"
 	"github.com/zepollabot/media-rating-overlay/internal/rating-service/factory"
 	ratingModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/model"
+	"github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/dataset"
+	serviceImdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/service"
+	serviceRottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/service"
 )
 
 type RatingServiceBaseFactorySuite struct {
@@ -99,6 +103,184 @@ func (s *RatingServiceBaseFactorySuite) TestBuildTMDBComponents_WhenEnabled_Clie
 	s.Nil(tmdbService.PlatformService, "PlatformService should be nil on error")
 }
 
+func (s *RatingServiceBaseFactorySuite) TestBuildTraktComponents_WhenDisabled() {
+	// Arrange
+	f := s.baseFactory
+
+	// Act
+	traktService, err := f.BuildTraktComponents()
+
+	// Assert
+	s.NoError(err, "BuildTraktComponents should not return an error when Trakt is disabled")
+	s.Equal(constant.RatingServiceTrakt, traktService.Name, "Service name should be Trakt")
+	s.Nil(traktService.PlatformService, "PlatformService should be nil when Trakt is disabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildTraktComponents_WhenEnabled() {
+	// Arrange
+	s.config.Trakt = configModel.Trakt{Enabled: true, ClientID: "traktclientid"}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	traktService, err := f.BuildTraktComponents()
+
+	// Assert
+	s.NoError(err, "BuildTraktComponents should not return an error when Trakt is enabled with valid config")
+	s.Equal(constant.RatingServiceTrakt, traktService.Name, "Service name should be Trakt")
+	s.NotNil(traktService.PlatformService, "PlatformService should not be nil when Trakt is enabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildTraktComponents_WhenEnabled_ClientCreationError() {
+	// Arrange
+	s.config.Trakt = configModel.Trakt{Enabled: true, ClientID: "traktclientid"}
+	s.config.Logger.LogFilePath = "" // Induce an error in common.SetupLogging
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	traktService, err := f.BuildTraktComponents()
+
+	// Assert
+	s.Error(err, "BuildTraktComponents should return an error when Trakt client creation fails")
+	s.Equal(ratingModel.RatingService{}, traktService, "Returned service should be empty on client creation error")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildLetterboxdComponents_WhenDisabled() {
+	// Arrange
+	f := s.baseFactory
+
+	// Act
+	letterboxdService, err := f.BuildLetterboxdComponents()
+
+	// Assert
+	s.NoError(err, "BuildLetterboxdComponents should not return an error when Letterboxd is disabled")
+	s.Equal(constant.RatingServiceLetterboxd, letterboxdService.Name, "Service name should be Letterboxd")
+	s.Nil(letterboxdService.PlatformService, "PlatformService should be nil when Letterboxd is disabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildLetterboxdComponents_WhenEnabled() {
+	// Arrange
+	s.config.Letterboxd = configModel.Letterboxd{Enabled: true}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	letterboxdService, err := f.BuildLetterboxdComponents()
+
+	// Assert
+	s.NoError(err, "BuildLetterboxdComponents should not return an error when Letterboxd is enabled")
+	s.Equal(constant.RatingServiceLetterboxd, letterboxdService.Name, "Service name should be Letterboxd")
+	s.NotNil(letterboxdService.PlatformService, "PlatformService should not be nil when Letterboxd is enabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildAniListComponents_WhenDisabled() {
+	// Arrange
+	f := s.baseFactory
+
+	// Act
+	aniListService, err := f.BuildAniListComponents()
+
+	// Assert
+	s.NoError(err, "BuildAniListComponents should not return an error when AniList is disabled")
+	s.Equal(constant.RatingServiceAniList, aniListService.Name, "Service name should be AniList")
+	s.Nil(aniListService.PlatformService, "PlatformService should be nil when AniList is disabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildAniListComponents_WhenEnabled() {
+	// Arrange
+	s.config.AniList = configModel.AniList{Enabled: true, Libraries: []string{"Anime"}}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	aniListService, err := f.BuildAniListComponents()
+
+	// Assert
+	s.NoError(err, "BuildAniListComponents should not return an error when AniList is enabled")
+	s.Equal(constant.RatingServiceAniList, aniListService.Name, "Service name should be AniList")
+	s.NotNil(aniListService.PlatformService, "PlatformService should not be nil when AniList is enabled")
+	s.Equal([]string{"Anime"}, aniListService.Libraries, "Libraries should be the configured ones")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildMyAnimeListComponents_WhenDisabled() {
+	// Arrange
+	f := s.baseFactory
+
+	// Act
+	myAnimeListService, err := f.BuildMyAnimeListComponents()
+
+	// Assert
+	s.NoError(err, "BuildMyAnimeListComponents should not return an error when MyAnimeList is disabled")
+	s.Equal(constant.RatingServiceMyAnimeList, myAnimeListService.Name, "Service name should be MyAnimeList")
+	s.Nil(myAnimeListService.PlatformService, "PlatformService should be nil when MyAnimeList is disabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildMyAnimeListComponents_WhenEnabled() {
+	// Arrange
+	s.config.MyAnimeList = configModel.MyAnimeList{Enabled: true, ClientID: "malclientid", Libraries: []string{"Anime"}}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	myAnimeListService, err := f.BuildMyAnimeListComponents()
+
+	// Assert
+	s.NoError(err, "BuildMyAnimeListComponents should not return an error when MyAnimeList is enabled with valid config")
+	s.Equal(constant.RatingServiceMyAnimeList, myAnimeListService.Name, "Service name should be MyAnimeList")
+	s.NotNil(myAnimeListService.PlatformService, "PlatformService should not be nil when MyAnimeList is enabled")
+	s.Equal([]string{"Anime"}, myAnimeListService.Libraries, "Libraries should be the configured ones")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildMyAnimeListComponents_WhenEnabled_ClientCreationError() {
+	// Arrange
+	s.config.MyAnimeList = configModel.MyAnimeList{Enabled: true}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	myAnimeListService, err := f.BuildMyAnimeListComponents()
+
+	// Assert
+	s.Error(err, "BuildMyAnimeListComponents should return an error when the client ID is missing")
+	s.Equal(ratingModel.RatingService{}, myAnimeListService, "Returned service should be empty on client creation error")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildMDBListComponents_WhenDisabled() {
+	// Arrange
+	f := s.baseFactory
+
+	// Act
+	mdblistService, err := f.BuildMDBListComponents()
+
+	// Assert
+	s.NoError(err, "BuildMDBListComponents should not return an error when MDBList is disabled")
+	s.Equal(constant.RatingServiceMDBList, mdblistService.Name, "Service name should be MDBList")
+	s.Nil(mdblistService.PlatformService, "PlatformService should be nil when MDBList is disabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildMDBListComponents_WhenEnabled() {
+	// Arrange
+	s.config.MDBList = configModel.MDBList{Enabled: true, ApiKey: "mdblistapikey"}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	mdblistService, err := f.BuildMDBListComponents()
+
+	// Assert
+	s.NoError(err, "BuildMDBListComponents should not return an error when MDBList is enabled with valid config")
+	s.Equal(constant.RatingServiceMDBList, mdblistService.Name, "Service name should be MDBList")
+	s.NotNil(mdblistService.PlatformService, "PlatformService should not be nil when MDBList is enabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildMDBListComponents_WhenEnabled_ClientCreationError() {
+	// Arrange
+	s.config.MDBList = configModel.MDBList{Enabled: true, ApiKey: "mdblistapikey"}
+	s.config.Logger.LogFilePath = "" // Induce an error in common.SetupLogging
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	mdblistService, err := f.BuildMDBListComponents()
+
+	// Assert
+	s.Error(err, "BuildMDBListComponents should return an error when MDBList client creation fails")
+	s.Equal(ratingModel.RatingService{}, mdblistService, "Returned service should be empty on client creation error")
+}
+
 func (s *RatingServiceBaseFactorySuite) TestBuildRottenTomatoesComponents() {
 	// Arrange
 	f := s.baseFactory // Use the factory initialized in SetupTest
@@ -112,6 +294,21 @@ func (s *RatingServiceBaseFactorySuite) TestBuildRottenTomatoesComponents() {
 	s.Nil(rottenTomatoesService.PlatformService, "PlatformService should be nil for RottenTomatoes")
 }
 
+func (s *RatingServiceBaseFactorySuite) TestBuildRottenTomatoesComponents_WhenEnabled() {
+	// Arrange
+	s.config.RottenTomatoes = configModel.RottenTomatoes{Enabled: true}
+	s.config.OMDb = configModel.OMDb{Enabled: true, ApiKey: "omdbapikey"}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	rottenTomatoesService, err := f.BuildRottenTomatoesComponents()
+
+	// Assert
+	s.NoError(err, "BuildRottenTomatoesComponents should not return an error when Rotten Tomatoes is enabled")
+	s.Equal(constant.RatingServiceRottenTomatoes, rottenTomatoesService.Name, "Service name should be RottenTomatoes")
+	s.IsType(&serviceRottenTomatoes.RottenTomatoesRatingPlatformService{}, rottenTomatoesService.PlatformService, "the Rotten Tomatoes pages should be preferred over OMDb")
+}
+
 func (s *RatingServiceBaseFactorySuite) TestBuildIMDBComponents() {
 	// Arrange
 	f := s.baseFactory // Use the factory initialized in SetupTest
@@ -124,3 +321,79 @@ func (s *RatingServiceBaseFactorySuite) TestBuildIMDBComponents() {
 	s.Equal(constant.RatingServiceIMDB, imdbService.Name, "Service name should be IMDB")
 	s.Nil(imdbService.PlatformService, "PlatformService should be nil for IMDB")
 }
+
+func (s *RatingServiceBaseFactorySuite) TestBuildIMDBComponents_WithDataset() {
+	// Arrange
+	datasetPath := filepath.Join(s.T().TempDir(), "imdb.gob.gz")
+	s.Require().NoError(dataset.NewStore([]dataset.Title{{ID: "tt0133093", Type: "movie", Rating: 8.7}}).Save(datasetPath))
+	s.config.IMDB = configModel.IMDB{Enabled: true, DatasetPath: datasetPath}
+	s.config.OMDb = configModel.OMDb{Enabled: true, ApiKey: "omdbapikey"}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	imdbService, err := f.BuildIMDBComponents()
+
+	// Assert
+	s.NoError(err, "BuildIMDBComponents should not return an error when the dataset exists")
+	s.Equal(constant.RatingServiceIMDB, imdbService.Name, "Service name should be IMDB")
+	s.IsType(&serviceImdb.IMDbDatasetRatingPlatformService{}, imdbService.PlatformService, "The dataset should be preferred over OMDb")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildIMDBComponents_WithDataset_Missing() {
+	// Arrange
+	s.config.IMDB = configModel.IMDB{Enabled: true, DatasetPath: filepath.Join(s.T().TempDir(), "missing.gob.gz")}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	imdbService, err := f.BuildIMDBComponents()
+
+	// Assert
+	s.ErrorContains(err, "run the refresh-imdb command")
+	s.Equal(ratingModel.RatingService{}, imdbService, "Returned service should be empty on dataset loading error")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildMetacriticComponents() {
+	// Arrange
+	f := s.baseFactory
+
+	// Act
+	metacriticService, err := f.BuildMetacriticComponents()
+
+	// Assert
+	s.NoError(err, "BuildMetacriticComponents should not return an error")
+	s.Equal(constant.RatingServiceMetacritic, metacriticService.Name, "Service name should be Metacritic")
+	s.Nil(metacriticService.PlatformService, "PlatformService should be nil for Metacritic when OMDb is disabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildOMDbComponents_WhenEnabled() {
+	// Arrange
+	s.config.OMDb = configModel.OMDb{Enabled: true, ApiKey: "omdbkey"}
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	imdbService, imdbErr := f.BuildIMDBComponents()
+	rottenTomatoesService, rottenTomatoesErr := f.BuildRottenTomatoesComponents()
+	metacriticService, metacriticErr := f.BuildMetacriticComponents()
+
+	// Assert
+	s.NoError(imdbErr, "BuildIMDBComponents should not return an error when OMDb is enabled")
+	s.NotNil(imdbService.PlatformService, "PlatformService should be set for IMDB when OMDb is enabled")
+	s.NoError(rottenTomatoesErr, "BuildRottenTomatoesComponents should not return an error when OMDb is enabled")
+	s.NotNil(rottenTomatoesService.PlatformService, "PlatformService should be set for RottenTomatoes when OMDb is enabled")
+	s.NoError(metacriticErr, "BuildMetacriticComponents should not return an error when OMDb is enabled")
+	s.NotNil(metacriticService.PlatformService, "PlatformService should be set for Metacritic when OMDb is enabled")
+}
+
+func (s *RatingServiceBaseFactorySuite) TestBuildOMDbComponents_WhenEnabled_ClientCreationError() {
+	// Arrange
+	s.config.OMDb = configModel.OMDb{Enabled: true, ApiKey: "omdbkey"}
+	s.config.Logger.LogFilePath = "" // Induce an error in common.SetupLogging by providing an empty LogFilePath
+	f := factory.NewRatingServiceBaseFactory(s.logger, s.config)
+
+	// Act
+	imdbService, err := f.BuildIMDBComponents()
+
+	// Assert
+	s.Error(err, "BuildIMDBComponents should return an error when the OMDb client creation fails")
+	s.Equal(ratingModel.RatingService{}, imdbService, "Returned service should be empty on client creation error")
+}
@@ -27,8 +27,10 @@ type SearchService interface {
 	GetResults(ctx context.Context, item model.Item) ([]model.SearchResult, error)
 }
 
+// RatingPlatformService provides the ratings of an item: a single one for most platforms, the ratings
+// of several platforms for the aggregators answering them in one request. No rating is not an error
 type RatingPlatformService interface {
-	GetRating(ctx context.Context, item model.Item) (model.Rating, error)
+	GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error)
 }
 
 type LogoService interface {
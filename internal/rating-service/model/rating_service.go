@@ -1,9 +1,20 @@
 package model
 
-import "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+import (
+	"slices"
+
+	"github.com/zepollabot/media-rating-overlay/internal/rating-service"
+)
 
 type RatingService struct {
 	Name            string
 	PlatformService rating.RatingPlatformService
 	LogoService     rating.LogoService
+	// Libraries restricts the service to the named libraries. Every library when empty
+	Libraries []string
+}
+
+// AppliesTo reports whether the service rates the items of the library
+func (s RatingService) AppliesTo(libraryName string) bool {
+	return len(s.Libraries) == 0 || slices.Contains(s.Libraries, libraryName)
 }
@@ -0,0 +1,129 @@
+package anilist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	anilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/model"
+)
+
+// AniListClient implements the RatingClient interface for the AniList GraphQL API,
+// which needs no authentication for public data
+type AniListClient struct {
+	httpClient common.ServiceHTTPClient
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewAniListClient creates a new AniList client
+func NewAniListClient(httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*AniListClient, error) {
+	baseUrl := url.URL{
+		Scheme: "https",
+		Host:   "graphql.anilist.co",
+	}
+
+	httpClient := NewAniListHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		return nil, err
+	}
+
+	return &AniListClient{
+		httpClient: httpClient,
+		baseUrl:    baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+func (c *AniListClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	c.setupRequest(request)
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("unable to perform request to AniList API",
+			zap.String("url", request.URL.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithRatingResponse performs a request and returns a parsed AniList response
+func (c *AniListClient) DoWithRatingResponse(request *http.Request) (rating.RatingResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseAniListResponse(resp)
+}
+
+// setupRequest configures the request with common headers
+func (c *AniListClient) setupRequest(request *http.Request) {
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+}
+
+// parseAniListResponse handles the AniList API response parsing. GraphQL errors come with
+// a 200 or a 400 status code, their messages are returned as the error
+func (c *AniListClient) parseAniListResponse(resp *http.Response) (*anilist.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.New(model.NotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest {
+		return nil, fmt.Errorf("unexpected AniList API status code %d", resp.StatusCode)
+	}
+
+	var aniListResponse anilist.Response
+	err := json.NewDecoder(resp.Body).Decode(&aniListResponse)
+	if err != nil {
+		c.logger.Error("unable to decode AniList API response",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if len(aniListResponse.Errors) > 0 {
+		return nil, fmt.Errorf("AniList API error: %s", aniListResponse.Errors[0].Message)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected AniList API status code %d", resp.StatusCode)
+	}
+
+	return &aniListResponse, nil
+}
+
+func (c *AniListClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient with the provided one
+// Method used primarily for testing
+func (c *AniListClient) SetHttpClient(client common.ServiceHTTPClient) {
+	c.httpClient = client
+}
+
+// SetBaseUrl replaces the AniList API base URL with the provided one
+// Method used primarily for testing, against a local server
+func (c *AniListClient) SetBaseUrl(baseUrl url.URL) {
+	c.baseUrl = baseUrl
+}
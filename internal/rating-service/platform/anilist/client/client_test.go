@@ -0,0 +1,143 @@
+package anilist_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	aniListClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/client"
+	aniListModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/model"
+)
+
+type AniListClientTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	handler http.HandlerFunc
+	client  *aniListClient.AniListClient
+}
+
+func (s *AniListClientTestSuite) SetupTest() {
+	s.handler = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+
+	client, err := aniListClient.NewAniListClient(&config.HTTPClient{Timeout: 5 * time.Second, MaxRetries: 0}, os.DevNull, zap.NewNop())
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+	s.client = client
+}
+
+func (s *AniListClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestAniListClientTestSuite(t *testing.T) {
+	suite.Run(t, new(AniListClientTestSuite))
+}
+
+func (s *AniListClientTestSuite) newRequest() *http.Request {
+	body, err := json.Marshal(aniListModel.Request{Query: "query { Page { media { id } } }", Variables: map[string]any{"search": "Akira"}})
+	s.Require().NoError(err)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.client.GetBaseUrl().String(), bytes.NewReader(body))
+	s.Require().NoError(err)
+	return req
+}
+
+func (s *AniListClientTestSuite) TestDoWithRatingResponse() {
+	// Arrange
+	var capturedRequest *http.Request
+	var capturedBody aniListModel.Request
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		capturedRequest = r
+		_ = json.NewDecoder(r.Body).Decode(&capturedBody)
+		_, _ = w.Write([]byte(`{"data": {"Page": {"media": [{"id": 47, "format": "MOVIE", "title": {"romaji": "AKIRA", "english": "Akira", "native": "AKIRA"}, "synonyms": [], "startDate": {"year": 1988}, "averageScore": 78}]}}}`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	averageScore := 78
+	expected := &aniListModel.Response{}
+	expected.Data.Page.Media = []aniListModel.Media{
+		{
+			ID:           47,
+			Format:       "MOVIE",
+			Title:        aniListModel.Title{Romaji: "AKIRA", English: "Akira", Native: "AKIRA"},
+			Synonyms:     []string{},
+			StartDate:    aniListModel.FuzzyDate{Year: 1988},
+			AverageScore: &averageScore,
+		},
+	}
+	s.Equal(expected, response)
+	s.Equal(http.MethodPost, capturedRequest.Method)
+	s.Equal("application/json", capturedRequest.Header.Get("Content-Type"))
+	s.Equal("application/json", capturedRequest.Header.Get("Accept"))
+	s.Equal("Akira", capturedBody.Variables["search"])
+}
+
+func (s *AniListClientTestSuite) TestDoWithRatingResponse_Errors() {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedError string
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, expectedError: model.NotFound},
+		{name: "unexpected status", statusCode: http.StatusTooManyRequests, expectedError: "unexpected AniList API status code 429"},
+		{
+			name:          "graphql error",
+			statusCode:    http.StatusBadRequest,
+			body:          `{"data": null, "errors": [{"message": "Validation error", "status": 400}]}`,
+			expectedError: "AniList API error: Validation error",
+		},
+		{name: "bad request without error", statusCode: http.StatusBadRequest, body: `{}`, expectedError: "unexpected AniList API status code 400"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}
+
+			// Act
+			response, err := s.client.DoWithRatingResponse(s.newRequest())
+
+			// Assert
+			s.EqualError(err, tc.expectedError)
+			s.Nil(response)
+		})
+	}
+}
+
+func (s *AniListClientTestSuite) TestDoWithRatingResponse_InvalidBody() {
+	// Arrange
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest())
+
+	// Assert
+	s.Error(err)
+	s.Nil(response)
+}
@@ -0,0 +1,25 @@
+package anilist
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// AniListHTTPClient implements the HTTPClient interface for AniList
+type AniListHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewAniListHTTPClient creates a new AniList HTTP client
+func NewAniListHTTPClient(timeout time.Duration, maxRetries int) *AniListHTTPClient {
+	return &AniListHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *AniListHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
@@ -0,0 +1,69 @@
+package anilist
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type LogoCreator interface {
+	CreateLogo(imagePath string, text string, dimensions model.LogoDimensions) (*model.Logo, error)
+}
+
+// AniListLogoService implements the LogoService interface for AniList
+type AniListLogoService struct {
+	logger      *zap.Logger
+	config      *model.PosterConfig
+	logoCreator LogoCreator
+}
+
+// NewAniListLogoService creates a new AniList logo service
+func NewAniListLogoService(
+	logger *zap.Logger,
+	config *model.PosterConfig,
+	logoCreator LogoCreator,
+) *AniListLogoService {
+	return &AniListLogoService{
+		logger:      logger,
+		config:      config,
+		logoCreator: logoCreator,
+	}
+}
+
+// GetLogos gets logos for an AniList item, showing the average score as a percentage, as AniList does
+func (s *AniListLogoService) GetLogos(
+	ctx context.Context,
+	ratings []model.Rating,
+	itemID string,
+	dimensions model.LogoDimensions,
+) ([]*model.Logo, error) {
+	logos := make([]*model.Logo, 0)
+
+	s.logger.Debug("Build AniList logos..",
+		zap.String("Item ID", itemID),
+	)
+
+	for _, rating := range ratings {
+		if rating.Rating > 0.0 {
+			rating := decimal.NewFromFloat32(rating.Normalized()*10).Round(0).StringFixedBank(0) + "%"
+
+			logo, err := s.logoCreator.CreateLogo(
+				s.config.ImagePaths.AniList.Audience.Normal,
+				rating,
+				dimensions,
+			)
+
+			if err != nil {
+				s.logger.Debug("Error creating AniList logo", zap.Error(err))
+				return nil, err
+			}
+
+			logos = append(logos, logo)
+		}
+	}
+
+	return logos, nil
+}
@@ -0,0 +1,83 @@
+package anilist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/logo/mocks"
+)
+
+type AniListLogoServiceTestSuite struct {
+	suite.Suite
+	mockLogoCreator *mocks.LogoCreator
+	config          *model.PosterConfig
+	service         *AniListLogoService
+}
+
+func (s *AniListLogoServiceTestSuite) SetupTest() {
+	s.mockLogoCreator = new(mocks.LogoCreator)
+
+	s.config = &model.PosterConfig{}
+	s.config.ImagePaths.AniList.Audience.Normal = "path/to/anilist.png"
+
+	s.service = NewAniListLogoService(zap.NewNop(), s.config, s.mockLogoCreator)
+}
+
+func (s *AniListLogoServiceTestSuite) TearDownTest() {
+	s.mockLogoCreator.AssertExpectations(s.T())
+}
+
+func TestAniListLogoServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AniListLogoServiceTestSuite))
+}
+
+func (s *AniListLogoServiceTestSuite) TestGetLogos() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 84, Scale: 100}}
+	dimensions := model.LogoDimensions{}
+	expectedLogo := &model.Logo{}
+
+	s.mockLogoCreator.On("CreateLogo", "path/to/anilist.png", "84%", dimensions).Return(expectedLogo, nil).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(logos, 1)
+	s.Equal(expectedLogo, logos[0])
+}
+
+func (s *AniListLogoServiceTestSuite) TestGetLogos_RatingIsZero() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 0.0}}
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", model.LogoDimensions{})
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(logos)
+	s.Len(logos, 0)
+}
+
+func (s *AniListLogoServiceTestSuite) TestGetLogos_CreateLogoError() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 70, Scale: 100}}
+	dimensions := model.LogoDimensions{}
+	expectedError := errors.New("logo creation failed")
+
+	s.mockLogoCreator.On("CreateLogo", "path/to/anilist.png", "70%", dimensions).Return(nil, expectedError).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.Equal(expectedError, err)
+	s.Nil(logos)
+}
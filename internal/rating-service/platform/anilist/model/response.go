@@ -0,0 +1,52 @@
+package anilist
+
+// Request is a GraphQL request to the AniList API
+type Request struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+// Response is the AniList response to a media search
+type Response struct {
+	Data struct {
+		Page Page `json:"Page"`
+	} `json:"data"`
+	// Errors are set instead of the data when the request is refused
+	Errors []Error `json:"errors"`
+}
+
+// Page holds the media found by a search
+type Page struct {
+	Media []Media `json:"media"`
+}
+
+// Media is an anime listed on AniList
+type Media struct {
+	ID int `json:"id"`
+	// Format is the format of the anime, e.g. TV, MOVIE or ONA
+	Format    string    `json:"format"`
+	Title     Title     `json:"title"`
+	Synonyms  []string  `json:"synonyms"`
+	StartDate FuzzyDate `json:"startDate"`
+	// AverageScore is the weighted average score of the users, on a 0-100 scale. It is null
+	// until the anime has enough scores
+	AverageScore *int `json:"averageScore"`
+}
+
+// Title holds the titles of an anime, the English one is null when there is none
+type Title struct {
+	Romaji  string `json:"romaji"`
+	English string `json:"english"`
+	Native  string `json:"native"`
+}
+
+// FuzzyDate is a date with unknown parts, zero when unknown
+type FuzzyDate struct {
+	Year int `json:"year"`
+}
+
+// Error is an error of the AniList GraphQL API
+type Error struct {
+	Message string `json:"message"`
+	Status  int    `json:"status"`
+}
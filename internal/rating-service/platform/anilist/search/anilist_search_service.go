@@ -0,0 +1,157 @@
+package anilist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	anilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/model"
+)
+
+// searchQuery searches the anime by title, among the formats of the item
+const searchQuery = `query ($search: String, $formats: [MediaFormat]) {
+  Page(perPage: 10) {
+    media(search: $search, type: ANIME, format_in: $formats) {
+      id
+      format
+      title { romaji english native }
+      synonyms
+      startDate { year }
+      averageScore
+    }
+  }
+}`
+
+// mediaFormats maps the media types to the AniList formats they can be found under
+var mediaFormats = map[string][]string{
+	constant.MediaTypeMovie:  {"MOVIE"},
+	constant.MediaTypeShow:   {"TV", "TV_SHORT", "ONA"},
+	constant.MediaTypeSeason: {"TV", "TV_SHORT", "ONA"},
+}
+
+// AniListSearchService looks the anime up on AniList
+type AniListSearchService struct {
+	client rating.RatingClient
+	logger *zap.Logger
+}
+
+func NewAniListSearchService(client rating.RatingClient, logger *zap.Logger) *AniListSearchService {
+	return &AniListSearchService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetMedia returns the AniList anime of the item, searched by its title then by its original title,
+// and matched by title, alternate titles and year. Seasons are searched as their show. It returns nil
+// when the item is not an anime known to AniList
+func (s *AniListSearchService) GetMedia(ctx context.Context, item model.Item) (*anilist.Media, error) {
+	formats, ok := mediaFormats[item.Type]
+	if !ok {
+		s.logger.Debug("media type not supported by AniList",
+			zap.String("Item ID", item.ID),
+			zap.String("Type", item.Type),
+		)
+		return nil, nil
+	}
+
+	for _, title := range searchTitles(item) {
+		response, err := s.search(ctx, title, formats)
+		if err != nil {
+			return nil, err
+		}
+
+		if media := findMedia(response.Data.Page.Media, item); media != nil {
+			return media, nil
+		}
+	}
+
+	s.logger.Debug("item not found on AniList",
+		zap.String("Item ID", item.ID),
+		zap.String("Title", item.Title),
+		zap.Int("Year", item.Year),
+	)
+	return nil, nil
+}
+
+// search requests the anime of the given formats matching title
+func (s *AniListSearchService) search(ctx context.Context, title string, formats []string) (*anilist.Response, error) {
+	body, err := json.Marshal(anilist.Request{
+		Query:     searchQuery,
+		Variables: map[string]any{"search": title, "formats": formats},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := *s.client.GetBaseUrl()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetMedia"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	response, err := s.client.DoWithRatingResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to AniList Client",
+			zap.String("method", "GetMedia"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result, ok := response.(*anilist.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to AniList Response",
+			zap.String("method", "GetMedia"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	return result, nil
+}
+
+// searchTitles returns the titles the item is searched by, its original title being often
+// the romanized or native title of an anime
+func searchTitles(item model.Item) []string {
+	titles := []string{item.Title}
+	if item.OriginalTitle != "" && rating.NormalizeTitle(item.OriginalTitle) != rating.NormalizeTitle(item.Title) {
+		titles = append(titles, item.OriginalTitle)
+	}
+	return lo.Filter(titles, func(title string, _ int) bool {
+		return rating.NormalizeTitle(title) != ""
+	})
+}
+
+// findMedia returns the first media having one of the titles of the item, and the same
+// start year when both are known
+func findMedia(media []anilist.Media, item model.Item) *anilist.Media {
+	itemTitles := lo.Map(searchTitles(item), func(title string, _ int) string {
+		return rating.NormalizeTitle(title)
+	})
+
+	for i, candidate := range media {
+		if item.Year > 0 && candidate.StartDate.Year > 0 && item.Year != candidate.StartDate.Year {
+			continue
+		}
+
+		titles := append([]string{candidate.Title.Romaji, candidate.Title.English, candidate.Title.Native}, candidate.Synonyms...)
+		if lo.SomeBy(titles, func(title string) bool {
+			return title != "" && lo.Contains(itemTitles, rating.NormalizeTitle(title))
+		}) {
+			return &media[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package anilist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	aniListClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/client"
+	aniListModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/model"
+)
+
+// searchResults are the anime returned by the local server, by search
+var searchResults = map[string]string{
+	"Cowboy Bebop": `{"data": {"Page": {"media": [
+		{"id": 5, "format": "MOVIE", "title": {"romaji": "Cowboy Bebop: Tengoku no Tobira", "english": "Cowboy Bebop: The Movie"}, "startDate": {"year": 2001}, "averageScore": 82},
+		{"id": 1, "format": "TV", "title": {"romaji": "Cowboy Bebop", "english": "Cowboy Bebop", "native": "カウボーイビバップ"}, "startDate": {"year": 1998}, "averageScore": 86}
+	]}}}`,
+	"Spirited Away": `{"data": {"Page": {"media": []}}}`,
+	"Sen to Chihiro no Kamikakushi": `{"data": {"Page": {"media": [
+		{"id": 199, "format": "MOVIE", "title": {"romaji": "Sen to Chihiro no Kamikakushi", "english": "Spirited Away", "native": "千と千尋の神隠し"}, "startDate": {"year": 2001}, "averageScore": 86}
+	]}}}`,
+}
+
+// AniListSearchServiceTestSuite runs the search service and the AniList client against a local server
+type AniListSearchServiceTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []aniListModel.Request
+	service  *AniListSearchService
+	ctx      context.Context
+}
+
+func TestAniListSearchServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AniListSearchServiceTestSuite))
+}
+
+func (s *AniListSearchServiceTestSuite) SetupTest() {
+	s.requests = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request aniListModel.Request
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		s.requests = append(s.requests, request)
+
+		body, ok := searchResults[request.Variables["search"].(string)]
+		if !ok {
+			body = `{"data": {"Page": {"media": []}}}`
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+
+	client, err := aniListClient.NewAniListClient(&config.HTTPClient{Timeout: 5 * time.Second}, os.DevNull, zap.NewNop())
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+
+	s.service = NewAniListSearchService(client, zap.NewNop())
+	s.ctx = context.Background()
+}
+
+func (s *AniListSearchServiceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *AniListSearchServiceTestSuite) TestGetMedia() {
+	// Arrange
+	item := model.Item{ID: "1234", Title: "Cowboy Bebop", Type: constant.MediaTypeSeason, Year: 1998}
+
+	// Act
+	media, err := s.service.GetMedia(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(media)
+	s.Equal(1, media.ID)
+	s.Require().Len(s.requests, 1)
+	s.Equal([]any{"TV", "TV_SHORT", "ONA"}, s.requests[0].Variables["formats"])
+}
+
+func (s *AniListSearchServiceTestSuite) TestGetMedia_ByOriginalTitle() {
+	// Arrange
+	item := model.Item{
+		ID:            "1234",
+		Title:         "Spirited Away",
+		OriginalTitle: "Sen to Chihiro no Kamikakushi",
+		Type:          constant.MediaTypeMovie,
+		Year:          2001,
+	}
+
+	// Act
+	media, err := s.service.GetMedia(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(media)
+	s.Equal(199, media.ID)
+	s.Require().Len(s.requests, 2)
+	s.Equal([]any{"MOVIE"}, s.requests[1].Variables["formats"])
+}
+
+func (s *AniListSearchServiceTestSuite) TestGetMedia_NotFound() {
+	testCases := []struct {
+		name             string
+		item             model.Item
+		expectedRequests int
+	}{
+		{
+			name:             "unknown title",
+			item:             model.Item{ID: "1", Title: "Unknown", Type: constant.MediaTypeMovie},
+			expectedRequests: 1,
+		},
+		{
+			name:             "other year",
+			item:             model.Item{ID: "2", Title: "Cowboy Bebop", Type: constant.MediaTypeShow, Year: 2021},
+			expectedRequests: 1,
+		},
+		{
+			name: "episode",
+			item: model.Item{ID: "3", Title: "Asteroid Blues", Type: constant.MediaTypeEpisode},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.requests = nil
+
+			// Act
+			media, err := s.service.GetMedia(s.ctx, tc.item)
+
+			// Assert
+			s.NoError(err)
+			s.Nil(media)
+			s.Len(s.requests, tc.expectedRequests)
+		})
+	}
+}
+
+func (s *AniListSearchServiceTestSuite) TestFindMedia_BySynonym() {
+	// Arrange
+	media := []aniListModel.Media{
+		{ID: 1, Title: aniListModel.Title{Romaji: "Shingeki no Kyojin"}, Synonyms: []string{"AoT"}},
+		{ID: 2, Title: aniListModel.Title{Romaji: "Kimetsu no Yaiba"}, Synonyms: []string{"Demon Slayer"}},
+	}
+	item := model.Item{Title: "Demon Slayer!", Type: constant.MediaTypeShow}
+
+	// Act
+	result := findMedia(media, item)
+
+	// Assert
+	s.Equal(&media[1], result)
+}
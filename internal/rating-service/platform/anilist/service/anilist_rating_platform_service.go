@@ -0,0 +1,69 @@
+package anilist
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	anilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/model"
+)
+
+// ratingScale is the maximum of the AniList scores, given in percent
+const ratingScale = 100
+
+// MediaSearcher looks the anime up on AniList
+type MediaSearcher interface {
+	GetMedia(ctx context.Context, item model.Item) (*anilist.Media, error)
+}
+
+// AniListRatingPlatformService provides the AniList average score, on a 0-100 scale
+type AniListRatingPlatformService struct {
+	logger        *zap.Logger
+	mediaSearcher MediaSearcher
+}
+
+func NewAniListRatingPlatformService(logger *zap.Logger, mediaSearcher MediaSearcher) *AniListRatingPlatformService {
+	return &AniListRatingPlatformService{
+		logger:        logger,
+		mediaSearcher: mediaSearcher,
+	}
+}
+
+func (s *AniListRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
+	s.logger.Debug("Retrieving AniList rating..",
+		zap.String("Item ID", item.ID),
+	)
+
+	media, err := s.mediaSearcher.GetMedia(ctx, item)
+	if err != nil {
+		s.logger.Error("unable to get AniList media",
+			zap.String("Item ID", item.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if media == nil || media.AverageScore == nil || *media.AverageScore <= 0 {
+		s.logger.Debug("no AniList rating found",
+			zap.String("Item ID", item.ID),
+		)
+		return nil, nil
+	}
+
+	s.logger.Debug("AniList rating found",
+		zap.String("Item ID", item.ID),
+		zap.Int("AniList ID", media.ID),
+		zap.Int("Score", *media.AverageScore),
+	)
+
+	return []model.Rating{
+		{
+			Name:   constant.RatingServiceAniList,
+			Rating: float32(*media.AverageScore),
+			Type:   model.RatingServiceTypeAudience,
+			Scale:  ratingScale,
+		},
+	}, nil
+}
@@ -0,0 +1,89 @@
+package anilist_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	aniListModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/model"
+	anilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/service"
+	anilist_mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/service/mocks"
+)
+
+type AniListRatingPlatformServiceTestSuite struct {
+	suite.Suite
+	mockMediaSearcher *anilist_mocks.MediaSearcher
+	service           *anilist.AniListRatingPlatformService
+	ctx               context.Context
+	item              model.Item
+}
+
+func (s *AniListRatingPlatformServiceTestSuite) SetupTest() {
+	s.mockMediaSearcher = anilist_mocks.NewMediaSearcher(s.T())
+	s.service = anilist.NewAniListRatingPlatformService(zap.NewNop(), s.mockMediaSearcher)
+	s.ctx = context.Background()
+	s.item = model.Item{ID: "1234", Title: "Cowboy Bebop", Type: constant.MediaTypeShow, Year: 1998}
+}
+
+func TestAniListRatingPlatformServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AniListRatingPlatformServiceTestSuite))
+}
+
+func (s *AniListRatingPlatformServiceTestSuite) TestGetRatings() {
+	// Arrange
+	averageScore := 86
+	s.mockMediaSearcher.On("GetMedia", s.ctx, s.item).Return(&aniListModel.Media{ID: 1, AverageScore: &averageScore}, nil).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Rating{
+		{Name: constant.RatingServiceAniList, Rating: 86, Type: model.RatingServiceTypeAudience, Scale: 100},
+	}, ratings)
+}
+
+func (s *AniListRatingPlatformServiceTestSuite) TestGetRatings_NoRating() {
+	zero := 0
+	testCases := []struct {
+		name  string
+		media *aniListModel.Media
+	}{
+		{name: "not on AniList", media: nil},
+		{name: "not enough scores", media: &aniListModel.Media{ID: 1}},
+		{name: "zero score", media: &aniListModel.Media{ID: 1, AverageScore: &zero}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockMediaSearcher.On("GetMedia", s.ctx, s.item).Return(tc.media, nil).Once()
+
+			// Act
+			ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+			// Assert
+			s.NoError(err)
+			s.Empty(ratings)
+		})
+	}
+}
+
+func (s *AniListRatingPlatformServiceTestSuite) TestGetRatings_Error() {
+	// Arrange
+	expectedErr := errors.New("anilist unavailable")
+	s.mockMediaSearcher.On("GetMedia", s.ctx, s.item).Return(nil, expectedErr).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.Equal(expectedErr, err)
+	s.Empty(ratings)
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package anilist_mocks
+
+import (
+	context "context"
+
+	anilist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/anilist/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// MediaSearcher is an autogenerated mock type for the MediaSearcher type
+type MediaSearcher struct {
+	mock.Mock
+}
+
+type MediaSearcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MediaSearcher) EXPECT() *MediaSearcher_Expecter {
+	return &MediaSearcher_Expecter{mock: &_m.Mock}
+}
+
+// GetMedia provides a mock function with given fields: ctx, item
+func (_m *MediaSearcher) GetMedia(ctx context.Context, item model.Item) (*anilist.Media, error) {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMedia")
+	}
+
+	var r0 *anilist.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) (*anilist.Media, error)); ok {
+		return rf(ctx, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) *anilist.Media); ok {
+		r0 = rf(ctx, item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*anilist.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Item) error); ok {
+		r1 = rf(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MediaSearcher_GetMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMedia'
+type MediaSearcher_GetMedia_Call struct {
+	*mock.Call
+}
+
+// GetMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.Item
+func (_e *MediaSearcher_Expecter) GetMedia(ctx interface{}, item interface{}) *MediaSearcher_GetMedia_Call {
+	return &MediaSearcher_GetMedia_Call{Call: _e.mock.On("GetMedia", ctx, item)}
+}
+
+func (_c *MediaSearcher_GetMedia_Call) Run(run func(ctx context.Context, item model.Item)) *MediaSearcher_GetMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Item))
+	})
+	return _c
+}
+
+func (_c *MediaSearcher_GetMedia_Call) Return(_a0 *anilist.Media, _a1 error) *MediaSearcher_GetMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MediaSearcher_GetMedia_Call) RunAndReturn(run func(context.Context, model.Item) (*anilist.Media, error)) *MediaSearcher_GetMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMediaSearcher creates a new instance of MediaSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMediaSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MediaSearcher {
+	mock := &MediaSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
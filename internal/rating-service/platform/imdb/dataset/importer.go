@@ -0,0 +1,131 @@
+package dataset
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// File names of the IMDb datasets, as published on https://datasets.imdbws.com
+const (
+	RatingsFileName = "title.ratings.tsv.gz"
+	BasicsFileName  = "title.basics.tsv.gz"
+)
+
+// nullValue is the value of the unknown fields of the datasets
+const nullValue = `\N`
+
+// maxLineSize bounds the lines of the datasets, far above the longest one
+const maxLineSize = 1024 * 1024
+
+// importedTypes are the title types kept: the episodes, most of the rated titles,
+// are left out as they never get a poster
+var importedTypes = map[string]bool{
+	"movie":        true,
+	"tvMovie":      true,
+	"short":        true,
+	"video":        true,
+	"tvSpecial":    true,
+	"tvSeries":     true,
+	"tvMiniSeries": true,
+}
+
+// Import reads the ratings and basics datasets from dir and returns the store
+// of the rated titles
+func Import(dir string) (*Store, error) {
+	ratings := make(map[string]Title)
+	err := readDataset(filepath.Join(dir, RatingsFileName), 3, func(fields []string) error {
+		rating, err := strconv.ParseFloat(fields[1], 32)
+		if err != nil {
+			return fmt.Errorf("invalid rating of %s: %w", fields[0], err)
+		}
+		votes, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return fmt.Errorf("invalid votes of %s: %w", fields[0], err)
+		}
+
+		ratings[fields[0]] = Title{ID: fields[0], Rating: float32(rating), Votes: votes}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	titles := make([]Title, 0, len(ratings)/2)
+	err = readDataset(filepath.Join(dir, BasicsFileName), 6, func(fields []string) error {
+		title, rated := ratings[fields[0]]
+		if !rated || !importedTypes[fields[1]] {
+			return nil
+		}
+
+		title.Type = fields[1]
+		title.PrimaryTitle = fields[2]
+		title.OriginalTitle = fields[3]
+		if fields[5] != nullValue {
+			year, err := strconv.Atoi(fields[5])
+			if err != nil {
+				return fmt.Errorf("invalid start year of %s: %w", fields[0], err)
+			}
+			title.Year = year
+		}
+
+		titles = append(titles, title)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStore(titles), nil
+}
+
+// readDataset calls handle with the fields of every row of the gzipped TSV file, skipping
+// its header. The rows are expected to have at least minFields fields
+func readDataset(filePath string, minFields int, handle func(fields []string) error) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", filePath, err)
+	}
+	defer reader.Close()
+
+	if err := readRows(reader, minFields, handle); err != nil {
+		return fmt.Errorf("%s: %w", filePath, err)
+	}
+	return nil
+}
+
+// readRows splits the TSV rows of reader. The datasets do not quote the fields,
+// the titles may contain quotes, so the encoding/csv reader is not used
+func readRows(reader io.Reader, minFields int, handle func(fields []string) error) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+
+	header := true
+	for scanner.Scan() {
+		if header {
+			header = false
+			continue
+		}
+
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) < minFields {
+			return fmt.Errorf("invalid row %q: %d fields, at least %d expected", scanner.Text(), len(fields), minFields)
+		}
+		if err := handle(fields); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,107 @@
+package dataset
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	ratingsDataset = "tconst\taverageRating\tnumVotes\n" +
+		"tt0133093\t8.7\t2100000\n" +
+		"tt0903747\t9.5\t2200000\n" +
+		"tt0959621\t9.0\t50000\n" +
+		"tt0000404\t6.1\t10\n"
+	basicsDataset = "tconst\ttitleType\tprimaryTitle\toriginalTitle\tisAdult\tstartYear\tendYear\truntimeMinutes\tgenres\n" +
+		"tt0133093\tmovie\tThe Matrix\tThe Matrix\t0\t1999\t\\N\t136\tAction,Sci-Fi\n" +
+		"tt0903747\ttvSeries\tBreaking Bad\tBreaking Bad\t0\t2008\t2013\t45\tCrime,Drama,Thriller\n" +
+		"tt0959621\ttvEpisode\tPilot\tPilot\t0\t2008\t\\N\t58\tCrime,Drama,Thriller\n" +
+		"tt0000404\tmovie\t\"Quoted\" Title\t\"Quoted\" Title\t0\t\\N\t\\N\t\\N\t\\N\n" +
+		"tt0000001\tshort\tCarmencita\tCarmencita\t0\t1894\t\\N\t1\tDocumentary,Short\n"
+)
+
+type ImporterTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+func (s *ImporterTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func TestImporterTestSuite(t *testing.T) {
+	suite.Run(t, new(ImporterTestSuite))
+}
+
+func (s *ImporterTestSuite) writeDataset(fileName string, content string) {
+	file, err := os.Create(filepath.Join(s.dir, fileName))
+	s.Require().NoError(err)
+	defer file.Close()
+
+	writer := gzip.NewWriter(file)
+	_, err = writer.Write([]byte(content))
+	s.Require().NoError(err)
+	s.Require().NoError(writer.Close())
+}
+
+func (s *ImporterTestSuite) TestImport() {
+	// Arrange
+	s.writeDataset(RatingsFileName, ratingsDataset)
+	s.writeDataset(BasicsFileName, basicsDataset)
+
+	// Act
+	store, err := Import(s.dir)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(3, store.Len(), "the episodes and the titles without rating should be left out")
+
+	title, found := store.FindByID("tt0133093")
+	s.True(found)
+	s.Equal(Title{ID: "tt0133093", Type: "movie", PrimaryTitle: "The Matrix", OriginalTitle: "The Matrix", Year: 1999, Rating: 8.7, Votes: 2100000}, title)
+
+	title, found = store.FindByID("tt0000404")
+	s.True(found)
+	s.Equal(`"Quoted" Title`, title.PrimaryTitle)
+	s.Zero(title.Year)
+
+	_, found = store.FindByID("tt0959621")
+	s.False(found)
+}
+
+func (s *ImporterTestSuite) TestImport_Errors() {
+	testCases := []struct {
+		name          string
+		ratings       string
+		basics        string
+		expectedError string
+	}{
+		{name: "missing datasets", expectedError: RatingsFileName},
+		{name: "missing basics", ratings: ratingsDataset, expectedError: BasicsFileName},
+		{name: "invalid rating", ratings: "tconst\taverageRating\tnumVotes\ntt0133093\thigh\t10\n", basics: basicsDataset, expectedError: "invalid rating of tt0133093"},
+		{name: "missing fields", ratings: "tconst\taverageRating\tnumVotes\ntt0133093\t8.7\n", basics: basicsDataset, expectedError: "at least 3 expected"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.dir = s.T().TempDir()
+			if tc.ratings != "" {
+				s.writeDataset(RatingsFileName, tc.ratings)
+			}
+			if tc.basics != "" {
+				s.writeDataset(BasicsFileName, tc.basics)
+			}
+
+			// Act
+			store, err := Import(s.dir)
+
+			// Assert
+			s.ErrorContains(err, tc.expectedError)
+			s.Nil(store)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package dataset
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"os"
+	"path/filepath"
+
+	"github.com/samber/lo"
+
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+)
+
+// Title is an IMDb title having a rating
+type Title struct {
+	// ID is the IMDb ID, the tconst of the datasets
+	ID            string
+	Type          string
+	PrimaryTitle  string
+	OriginalTitle string
+	Year          int
+	Rating        float32
+	Votes         int
+}
+
+// Store answers the IMDb ratings from the titles imported from the IMDb datasets,
+// indexed in memory by IMDb ID and by title
+type Store struct {
+	titles  []Title
+	byID    map[string]int
+	byTitle map[string][]int
+}
+
+// NewStore indexes the titles
+func NewStore(titles []Title) *Store {
+	store := &Store{
+		titles:  titles,
+		byID:    make(map[string]int, len(titles)),
+		byTitle: make(map[string][]int, len(titles)),
+	}
+
+	for i, title := range titles {
+		store.byID[title.ID] = i
+
+		primaryKey := rating.NormalizeTitle(title.PrimaryTitle)
+		store.byTitle[primaryKey] = append(store.byTitle[primaryKey], i)
+		if originalKey := rating.NormalizeTitle(title.OriginalTitle); originalKey != primaryKey {
+			store.byTitle[originalKey] = append(store.byTitle[originalKey], i)
+		}
+	}
+
+	return store
+}
+
+// LoadStore reads the titles of a dataset file written by Save
+func LoadStore(filePath string) (*Store, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var titles []Title
+	if err := gob.NewDecoder(reader).Decode(&titles); err != nil {
+		return nil, err
+	}
+
+	return NewStore(titles), nil
+}
+
+// Save writes the titles to the dataset file, replacing it only once fully written
+func (s *Store) Save(filePath string) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0775); err != nil {
+		return err
+	}
+
+	tmpFilePath := filePath + ".tmp"
+	file, err := os.OpenFile(tmpFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0664)
+	if err != nil {
+		return err
+	}
+
+	writer := gzip.NewWriter(file)
+	err = gob.NewEncoder(writer).Encode(s.titles)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpFilePath)
+		return err
+	}
+
+	return os.Rename(tmpFilePath, filePath)
+}
+
+// Len returns the number of titles
+func (s *Store) Len() int {
+	return len(s.titles)
+}
+
+// FindByID returns the title of an IMDb ID
+func (s *Store) FindByID(id string) (Title, bool) {
+	i, found := s.byID[id]
+	if !found {
+		return Title{}, false
+	}
+	return s.titles[i], true
+}
+
+// FindByTitle returns the title of one of the given types whose primary or original title
+// is title, released in year when not zero. The most voted one is returned when several match
+func (s *Store) FindByTitle(title string, year int, titleTypes []string) (Title, bool) {
+	var best *Title
+	for _, i := range s.byTitle[rating.NormalizeTitle(title)] {
+		candidate := &s.titles[i]
+		if year > 0 && candidate.Year != year {
+			continue
+		}
+		if !lo.Contains(titleTypes, candidate.Type) {
+			continue
+		}
+		if best == nil || candidate.Votes > best.Votes {
+			best = candidate
+		}
+	}
+
+	if best == nil {
+		return Title{}, false
+	}
+	return *best, true
+}
@@ -0,0 +1,124 @@
+package dataset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	store *Store
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.store = NewStore([]Title{
+		{ID: "tt0133093", Type: "movie", PrimaryTitle: "The Matrix", OriginalTitle: "The Matrix", Year: 1999, Rating: 8.7, Votes: 2100000},
+		{ID: "tt0106062", Type: "tvMovie", PrimaryTitle: "The Matrix", OriginalTitle: "The Matrix", Year: 1993, Rating: 7.2, Votes: 200},
+		{ID: "tt0245429", Type: "movie", PrimaryTitle: "Spirited Away", OriginalTitle: "Sen to Chihiro no kamikakushi", Year: 2001, Rating: 8.6, Votes: 900000},
+		{ID: "tt0903747", Type: "tvSeries", PrimaryTitle: "Breaking Bad", OriginalTitle: "Breaking Bad", Year: 2008, Rating: 9.5, Votes: 2200000},
+		{ID: "tt9999999", Type: "movie", PrimaryTitle: "Breaking Bad", OriginalTitle: "Breaking Bad", Year: 2008, Rating: 5.1, Votes: 12},
+	})
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) TestFindByID() {
+	// Act
+	title, found := s.store.FindByID("tt0133093")
+
+	// Assert
+	s.True(found)
+	s.Equal(float32(8.7), title.Rating)
+
+	_, found = s.store.FindByID("tt0000000")
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestFindByTitle() {
+	testCases := []struct {
+		name       string
+		title      string
+		year       int
+		titleTypes []string
+		expectedID string
+	}{
+		{name: "by year", title: "The Matrix", year: 1993, titleTypes: []string{"movie", "tvMovie"}, expectedID: "tt0106062"},
+		{name: "most voted without year", title: "The Matrix", titleTypes: []string{"movie", "tvMovie"}, expectedID: "tt0133093"},
+		{name: "by original title", title: "Sen to Chihiro no Kamikakushi", year: 2001, titleTypes: []string{"movie"}, expectedID: "tt0245429"},
+		{name: "ignoring punctuation and case", title: "the matrix!", year: 1999, titleTypes: []string{"movie"}, expectedID: "tt0133093"},
+		{name: "by type", title: "Breaking Bad", year: 2008, titleTypes: []string{"movie"}, expectedID: "tt9999999"},
+		{name: "series", title: "Breaking Bad", year: 2008, titleTypes: []string{"tvSeries", "tvMiniSeries"}, expectedID: "tt0903747"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Act
+			title, found := s.store.FindByTitle(tc.title, tc.year, tc.titleTypes)
+
+			// Assert
+			s.True(found)
+			s.Equal(tc.expectedID, title.ID)
+		})
+	}
+}
+
+func (s *StoreTestSuite) TestFindByTitle_NotFound() {
+	testCases := []struct {
+		name       string
+		title      string
+		year       int
+		titleTypes []string
+	}{
+		{name: "unknown title", title: "The Matrix Reloaded", titleTypes: []string{"movie"}},
+		{name: "other year", title: "The Matrix", year: 2003, titleTypes: []string{"movie"}},
+		{name: "other type", title: "Spirited Away", titleTypes: []string{"tvSeries"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Act
+			_, found := s.store.FindByTitle(tc.title, tc.year, tc.titleTypes)
+
+			// Assert
+			s.False(found)
+		})
+	}
+}
+
+func (s *StoreTestSuite) TestSaveAndLoad() {
+	// Arrange
+	filePath := filepath.Join(s.T().TempDir(), "state", "imdb.gob.gz")
+
+	// Act
+	err := s.store.Save(filePath)
+	s.Require().NoError(err)
+	loadedStore, err := LoadStore(filePath)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(s.store.Len(), loadedStore.Len())
+	title, found := loadedStore.FindByTitle("Spirited Away", 2001, []string{"movie"})
+	s.True(found)
+	s.Equal("tt0245429", title.ID)
+	s.NoFileExists(filePath + ".tmp")
+}
+
+func (s *StoreTestSuite) TestLoadStore_Errors() {
+	// Arrange
+	dir := s.T().TempDir()
+	invalidFilePath := filepath.Join(dir, "invalid.gob.gz")
+	s.Require().NoError(os.WriteFile(invalidFilePath, []byte("not gzip"), 0664))
+
+	// Act
+	_, missingErr := LoadStore(filepath.Join(dir, "missing.gob.gz"))
+	_, invalidErr := LoadStore(invalidFilePath)
+
+	// Assert
+	s.ErrorIs(missingErr, os.ErrNotExist)
+	s.Error(invalidErr)
+}
@@ -32,8 +32,9 @@ func (s *IMDBLogoServiceTestSuite) SetupTest() {
 		ImagePaths: struct { // Anonymous struct for ImagePaths
 			RottenTomatoes struct {
 				Critic struct {
-					Normal string
-					Low    string
+					Normal    string
+					Low       string
+					Certified string
 				}
 				Audience struct {
 					Normal string
@@ -50,6 +51,33 @@ func (s *IMDBLogoServiceTestSuite) SetupTest() {
 					Normal string
 				}
 			}
+			Metacritic struct {
+				Critic struct {
+					High   string
+					Medium string
+					Low    string
+				}
+			}
+			Trakt struct {
+				Audience struct {
+					Normal string
+				}
+			}
+			Letterboxd struct {
+				Audience struct {
+					Normal string
+				}
+			}
+			AniList struct {
+				Audience struct {
+					Normal string
+				}
+			}
+			MyAnimeList struct {
+				Audience struct {
+					Normal string
+				}
+			}
 		}{
 			IMDB: struct { // Initialize IMDB
 				Audience struct {
@@ -0,0 +1,84 @@
+package imdb
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/dataset"
+)
+
+var (
+	// movieTitleTypes are the IMDb title types of the movies
+	movieTitleTypes = []string{"movie", "tvMovie", "short", "video", "tvSpecial"}
+	// showTitleTypes are the IMDb title types of the shows
+	showTitleTypes = []string{"tvSeries", "tvMiniSeries"}
+)
+
+// TitleFinder finds the titles imported from the IMDb datasets
+type TitleFinder interface {
+	FindByID(id string) (dataset.Title, bool)
+	FindByTitle(title string, year int, titleTypes []string) (dataset.Title, bool)
+}
+
+// IMDbDatasetRatingPlatformService provides the IMDb audience rating read from the IMDb
+// datasets, without any request
+type IMDbDatasetRatingPlatformService struct {
+	logger      *zap.Logger
+	titleFinder TitleFinder
+}
+
+func NewIMDbDatasetRatingPlatformService(logger *zap.Logger, titleFinder TitleFinder) *IMDbDatasetRatingPlatformService {
+	return &IMDbDatasetRatingPlatformService{
+		logger:      logger,
+		titleFinder: titleFinder,
+	}
+}
+
+func (s *IMDbDatasetRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
+	s.logger.Debug("Retrieving IMDb dataset rating..",
+		zap.String("Item ID", item.ID),
+	)
+
+	title, found := s.findTitle(item)
+	if !found || title.Rating <= 0 {
+		s.logger.Debug("no IMDb dataset rating found",
+			zap.String("Item ID", item.ID),
+		)
+		return nil, nil
+	}
+
+	s.logger.Debug("IMDb dataset rating found",
+		zap.String("Item ID", item.ID),
+		zap.String("IMDb ID", title.ID),
+		zap.Float32("Value", title.Rating),
+		zap.Int("Votes", title.Votes),
+	)
+
+	return []model.Rating{
+		{
+			Name:   constant.RatingServiceIMDB,
+			Rating: title.Rating,
+			Type:   model.RatingServiceTypeAudience,
+			Votes:  title.Votes,
+		},
+	}, nil
+}
+
+// findTitle finds the title of the item by its IMDb ID when known, otherwise by title and year
+func (s *IMDbDatasetRatingPlatformService) findTitle(item model.Item) (dataset.Title, bool) {
+	if item.ExternalIDs.IMDB != "" {
+		return s.titleFinder.FindByID(item.ExternalIDs.IMDB)
+	}
+
+	switch item.Type {
+	case constant.MediaTypeMovie:
+		return s.titleFinder.FindByTitle(item.Title, item.Year, movieTitleTypes)
+	case constant.MediaTypeShow, constant.MediaTypeSeason:
+		return s.titleFinder.FindByTitle(item.Title, item.Year, showTitleTypes)
+	default:
+		return dataset.Title{}, false
+	}
+}
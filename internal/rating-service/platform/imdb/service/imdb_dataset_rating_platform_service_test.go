@@ -0,0 +1,106 @@
+package imdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	"github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/dataset"
+	imdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/service"
+	imdb_mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/service/mocks"
+)
+
+type IMDbDatasetRatingPlatformServiceTestSuite struct {
+	suite.Suite
+	mockTitleFinder *imdb_mocks.TitleFinder
+	service         *imdb.IMDbDatasetRatingPlatformService
+	ctx             context.Context
+	title           dataset.Title
+}
+
+func (s *IMDbDatasetRatingPlatformServiceTestSuite) SetupTest() {
+	s.mockTitleFinder = imdb_mocks.NewTitleFinder(s.T())
+	s.service = imdb.NewIMDbDatasetRatingPlatformService(zap.NewNop(), s.mockTitleFinder)
+	s.ctx = context.Background()
+	s.title = dataset.Title{ID: "tt0133093", Type: "movie", PrimaryTitle: "The Matrix", Year: 1999, Rating: 8.7, Votes: 2100000}
+}
+
+func TestIMDbDatasetRatingPlatformServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(IMDbDatasetRatingPlatformServiceTestSuite))
+}
+
+func (s *IMDbDatasetRatingPlatformServiceTestSuite) TestGetRatings_ByIMDbID() {
+	// Arrange
+	item := model.Item{ID: "1234", Type: constant.MediaTypeMovie, Title: "The Matrix", Year: 1999, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+	s.mockTitleFinder.On("FindByID", "tt0133093").Return(s.title, true).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Rating{{Name: constant.RatingServiceIMDB, Rating: 8.7, Type: model.RatingServiceTypeAudience, Votes: 2100000}}, ratings)
+}
+
+func (s *IMDbDatasetRatingPlatformServiceTestSuite) TestGetRatings_ByTitle() {
+	testCases := []struct {
+		name               string
+		item               model.Item
+		expectedTitleTypes []string
+	}{
+		{
+			name:               "movie",
+			item:               model.Item{ID: "1", Type: constant.MediaTypeMovie, Title: "The Matrix", Year: 1999},
+			expectedTitleTypes: []string{"movie", "tvMovie", "short", "video", "tvSpecial"},
+		},
+		{
+			name:               "season",
+			item:               model.Item{ID: "2", Type: constant.MediaTypeSeason, Title: "The Matrix", Year: 1999},
+			expectedTitleTypes: []string{"tvSeries", "tvMiniSeries"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockTitleFinder.On("FindByTitle", "The Matrix", 1999, tc.expectedTitleTypes).Return(s.title, true).Once()
+
+			// Act
+			ratings, err := s.service.GetRatings(s.ctx, tc.item)
+
+			// Assert
+			s.NoError(err)
+			s.Require().Len(ratings, 1)
+			s.Equal(float32(8.7), ratings[0].Rating)
+		})
+	}
+}
+
+func (s *IMDbDatasetRatingPlatformServiceTestSuite) TestGetRatings_NotFound() {
+	// Arrange
+	item := model.Item{ID: "1234", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0000000"}}
+	s.mockTitleFinder.On("FindByID", "tt0000000").Return(dataset.Title{}, false).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(ratings)
+}
+
+func (s *IMDbDatasetRatingPlatformServiceTestSuite) TestGetRatings_Episode() {
+	// Arrange
+	item := model.Item{ID: "1234", Type: constant.MediaTypeEpisode, Title: "Pilot", Year: 2008}
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(ratings)
+}
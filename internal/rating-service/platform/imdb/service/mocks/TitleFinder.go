@@ -0,0 +1,150 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package imdb_mocks
+
+import (
+	dataset "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/imdb/dataset"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TitleFinder is an autogenerated mock type for the TitleFinder type
+type TitleFinder struct {
+	mock.Mock
+}
+
+type TitleFinder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TitleFinder) EXPECT() *TitleFinder_Expecter {
+	return &TitleFinder_Expecter{mock: &_m.Mock}
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *TitleFinder) FindByID(id string) (dataset.Title, bool) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 dataset.Title
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (dataset.Title, bool)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) dataset.Title); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(dataset.Title)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// TitleFinder_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type TitleFinder_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - id string
+func (_e *TitleFinder_Expecter) FindByID(id interface{}) *TitleFinder_FindByID_Call {
+	return &TitleFinder_FindByID_Call{Call: _e.mock.On("FindByID", id)}
+}
+
+func (_c *TitleFinder_FindByID_Call) Run(run func(id string)) *TitleFinder_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TitleFinder_FindByID_Call) Return(_a0 dataset.Title, _a1 bool) *TitleFinder_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TitleFinder_FindByID_Call) RunAndReturn(run func(string) (dataset.Title, bool)) *TitleFinder_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTitle provides a mock function with given fields: title, year, titleTypes
+func (_m *TitleFinder) FindByTitle(title string, year int, titleTypes []string) (dataset.Title, bool) {
+	ret := _m.Called(title, year, titleTypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTitle")
+	}
+
+	var r0 dataset.Title
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string, int, []string) (dataset.Title, bool)); ok {
+		return rf(title, year, titleTypes)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, []string) dataset.Title); ok {
+		r0 = rf(title, year, titleTypes)
+	} else {
+		r0 = ret.Get(0).(dataset.Title)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, []string) bool); ok {
+		r1 = rf(title, year, titleTypes)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// TitleFinder_FindByTitle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTitle'
+type TitleFinder_FindByTitle_Call struct {
+	*mock.Call
+}
+
+// FindByTitle is a helper method to define mock.On call
+//   - title string
+//   - year int
+//   - titleTypes []string
+func (_e *TitleFinder_Expecter) FindByTitle(title interface{}, year interface{}, titleTypes interface{}) *TitleFinder_FindByTitle_Call {
+	return &TitleFinder_FindByTitle_Call{Call: _e.mock.On("FindByTitle", title, year, titleTypes)}
+}
+
+func (_c *TitleFinder_FindByTitle_Call) Run(run func(title string, year int, titleTypes []string)) *TitleFinder_FindByTitle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *TitleFinder_FindByTitle_Call) Return(_a0 dataset.Title, _a1 bool) *TitleFinder_FindByTitle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TitleFinder_FindByTitle_Call) RunAndReturn(run func(string, int, []string) (dataset.Title, bool)) *TitleFinder_FindByTitle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTitleFinder creates a new instance of TitleFinder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTitleFinder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TitleFinder {
+	mock := &TitleFinder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
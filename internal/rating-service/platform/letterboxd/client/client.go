@@ -0,0 +1,163 @@
+package letterboxd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	letterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/model"
+)
+
+// maxPageSize bounds the film pages read, far above their actual size
+const maxPageSize = 4 << 20
+
+var (
+	structuredDataStart = []byte(`<script type="application/ld+json">`)
+	structuredDataEnd   = []byte(`</script>`)
+	cdataStart          = []byte("/* <![CDATA[ */")
+	cdataEnd            = []byte("/* ]]> */")
+)
+
+// LetterboxdClient implements the RatingClient interface. Letterboxd has no public API, the
+// ratings are read from the structured data of the film pages
+type LetterboxdClient struct {
+	httpClient common.ServiceHTTPClient
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewLetterboxdClient creates a new Letterboxd client
+func NewLetterboxdClient(httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*LetterboxdClient, error) {
+	baseUrl := url.URL{
+		Scheme: "https",
+		Host:   "letterboxd.com",
+	}
+
+	httpClient := NewLetterboxdHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		return nil, err
+	}
+
+	return &LetterboxdClient{
+		httpClient: httpClient,
+		baseUrl:    baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+func (c *LetterboxdClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	c.setupRequest(request)
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("unable to perform request to Letterboxd",
+			zap.String("url", request.URL.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithRatingResponse performs a request and returns the parsed structured data of the page
+func (c *LetterboxdClient) DoWithRatingResponse(request *http.Request) (rating.RatingResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseLetterboxdResponse(resp)
+}
+
+// setupRequest configures the request with common headers
+func (c *LetterboxdClient) setupRequest(request *http.Request) {
+	request.Header.Set("Accept", "text/html")
+}
+
+// parseLetterboxdResponse handles the Letterboxd film page parsing
+func (c *LetterboxdClient) parseLetterboxdResponse(resp *http.Response) (*letterboxd.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.New(model.NotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected Letterboxd status code %d", resp.StatusCode)
+	}
+
+	page, err := io.ReadAll(io.LimitReader(resp.Body, maxPageSize))
+	if err != nil {
+		c.logger.Error("unable to read Letterboxd page", zap.Error(err))
+		return nil, err
+	}
+
+	data, err := extractStructuredData(page)
+	if err != nil {
+		c.logger.Error("unable to find the Letterboxd film data",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var letterboxdResponse letterboxd.Response
+	if err := json.Unmarshal(data, &letterboxdResponse); err != nil {
+		c.logger.Error("unable to decode Letterboxd film data",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &letterboxdResponse, nil
+}
+
+// extractStructuredData returns the JSON-LD of the page, without the CDATA markers around it
+func extractStructuredData(page []byte) ([]byte, error) {
+	_, data, found := bytes.Cut(page, structuredDataStart)
+	if !found {
+		return nil, errors.New("no structured data in the page")
+	}
+
+	data, _, found = bytes.Cut(data, structuredDataEnd)
+	if !found {
+		return nil, errors.New("unterminated structured data in the page")
+	}
+
+	data = bytes.TrimSpace(data)
+	data = bytes.TrimPrefix(data, cdataStart)
+	data = bytes.TrimSuffix(data, cdataEnd)
+
+	return bytes.TrimSpace(data), nil
+}
+
+func (c *LetterboxdClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient with the provided one
+// Method used primarily for testing
+func (c *LetterboxdClient) SetHttpClient(client common.ServiceHTTPClient) {
+	c.httpClient = client
+}
+
+// SetBaseUrl replaces the Letterboxd base URL with the provided one
+// Method used primarily for testing, against a local server
+func (c *LetterboxdClient) SetBaseUrl(baseUrl url.URL) {
+	c.baseUrl = baseUrl
+}
@@ -0,0 +1,132 @@
+package letterboxd_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	letterboxdClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/client"
+	letterboxdModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/model"
+)
+
+type LetterboxdClientTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	handler http.HandlerFunc
+	client  *letterboxdClient.LetterboxdClient
+}
+
+func (s *LetterboxdClientTestSuite) SetupTest() {
+	s.handler = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+
+	client, err := letterboxdClient.NewLetterboxdClient(
+		&config.HTTPClient{Timeout: 5 * time.Second},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+	s.client = client
+}
+
+func (s *LetterboxdClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestLetterboxdClientTestSuite(t *testing.T) {
+	suite.Run(t, new(LetterboxdClientTestSuite))
+}
+
+func (s *LetterboxdClientTestSuite) newRequest() *http.Request {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, s.client.GetBaseUrl().String()+"/film/the-matrix/", nil)
+	s.Require().NoError(err)
+	return req
+}
+
+func (s *LetterboxdClientTestSuite) TestDoWithRatingResponse() {
+	// Arrange
+	page, err := os.ReadFile("testdata/film.html")
+	s.Require().NoError(err)
+
+	var capturedRequest *http.Request
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		capturedRequest = r
+		_, _ = w.Write(page)
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&letterboxdModel.Response{
+		Name: "The Matrix",
+		URL:  "https://letterboxd.com/film/the-matrix/",
+		AggregateRating: &letterboxdModel.AggregateRating{
+			RatingValue: 4.2,
+			RatingCount: 2000000,
+			BestRating:  5,
+			WorstRating: 0,
+		},
+	}, response)
+	s.Equal("text/html", capturedRequest.Header.Get("Accept"))
+}
+
+func (s *LetterboxdClientTestSuite) TestDoWithRatingResponse_NotEnoughRatings() {
+	// Arrange
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><script type="application/ld+json">{"@type":"Movie","name":"Obscure"}</script></html>`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&letterboxdModel.Response{Name: "Obscure"}, response)
+}
+
+func (s *LetterboxdClientTestSuite) TestDoWithRatingResponse_Errors() {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedError string
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, expectedError: model.NotFound},
+		{name: "unexpected status", statusCode: http.StatusForbidden, expectedError: "unexpected Letterboxd status code 403"},
+		{name: "no structured data", statusCode: http.StatusOK, body: "<html></html>", expectedError: "no structured data in the page"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}
+
+			// Act
+			response, err := s.client.DoWithRatingResponse(s.newRequest())
+
+			// Assert
+			s.EqualError(err, tc.expectedError)
+			s.Nil(response)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package letterboxd
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// LetterboxdHTTPClient implements the HTTPClient interface for Letterboxd
+type LetterboxdHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewLetterboxdHTTPClient creates a new Letterboxd HTTP client
+func NewLetterboxdHTTPClient(timeout time.Duration, maxRetries int) *LetterboxdHTTPClient {
+	return &LetterboxdHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *LetterboxdHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
@@ -0,0 +1,69 @@
+package letterboxd
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type LogoCreator interface {
+	CreateLogo(imagePath string, text string, dimensions model.LogoDimensions) (*model.Logo, error)
+}
+
+// LetterboxdLogoService implements the LogoService interface for Letterboxd
+type LetterboxdLogoService struct {
+	logger      *zap.Logger
+	config      *model.PosterConfig
+	logoCreator LogoCreator
+}
+
+// NewLetterboxdLogoService creates a new Letterboxd logo service
+func NewLetterboxdLogoService(
+	logger *zap.Logger,
+	config *model.PosterConfig,
+	logoCreator LogoCreator,
+) *LetterboxdLogoService {
+	return &LetterboxdLogoService{
+		logger:      logger,
+		config:      config,
+		logoCreator: logoCreator,
+	}
+}
+
+// GetLogos gets logos for a Letterboxd item, showing the average stars with one decimal
+func (s *LetterboxdLogoService) GetLogos(
+	ctx context.Context,
+	ratings []model.Rating,
+	itemID string,
+	dimensions model.LogoDimensions,
+) ([]*model.Logo, error) {
+	logos := make([]*model.Logo, 0)
+
+	s.logger.Debug("Build Letterboxd logos..",
+		zap.String("Item ID", itemID),
+	)
+
+	for _, rating := range ratings {
+		if rating.Rating > 0.0 {
+			rating := decimal.NewFromFloat32(rating.Rating).Round(1).StringFixedBank(1)
+
+			logo, err := s.logoCreator.CreateLogo(
+				s.config.ImagePaths.Letterboxd.Audience.Normal,
+				rating,
+				dimensions,
+			)
+
+			if err != nil {
+				s.logger.Debug("Error creating Letterboxd logo", zap.Error(err))
+				return nil, err
+			}
+
+			logos = append(logos, logo)
+		}
+	}
+
+	return logos, nil
+}
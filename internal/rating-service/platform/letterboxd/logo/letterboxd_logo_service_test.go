@@ -0,0 +1,83 @@
+package letterboxd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/logo/mocks"
+)
+
+type LetterboxdLogoServiceTestSuite struct {
+	suite.Suite
+	mockLogoCreator *mocks.LogoCreator
+	config          *model.PosterConfig
+	service         *LetterboxdLogoService
+}
+
+func (s *LetterboxdLogoServiceTestSuite) SetupTest() {
+	s.mockLogoCreator = new(mocks.LogoCreator)
+
+	s.config = &model.PosterConfig{}
+	s.config.ImagePaths.Letterboxd.Audience.Normal = "path/to/letterboxd.png"
+
+	s.service = NewLetterboxdLogoService(zap.NewNop(), s.config, s.mockLogoCreator)
+}
+
+func (s *LetterboxdLogoServiceTestSuite) TearDownTest() {
+	s.mockLogoCreator.AssertExpectations(s.T())
+}
+
+func TestLetterboxdLogoServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LetterboxdLogoServiceTestSuite))
+}
+
+func (s *LetterboxdLogoServiceTestSuite) TestGetLogos() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 3.86, Scale: 5}}
+	dimensions := model.LogoDimensions{}
+	expectedLogo := &model.Logo{}
+
+	s.mockLogoCreator.On("CreateLogo", "path/to/letterboxd.png", "3.9", dimensions).Return(expectedLogo, nil).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(logos, 1)
+	s.Equal(expectedLogo, logos[0])
+}
+
+func (s *LetterboxdLogoServiceTestSuite) TestGetLogos_RatingIsZero() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 0.0}}
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", model.LogoDimensions{})
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(logos)
+	s.Len(logos, 0)
+}
+
+func (s *LetterboxdLogoServiceTestSuite) TestGetLogos_CreateLogoError() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 4.0}}
+	dimensions := model.LogoDimensions{}
+	expectedError := errors.New("logo creation failed")
+
+	s.mockLogoCreator.On("CreateLogo", "path/to/letterboxd.png", "4.0", dimensions).Return(nil, expectedError).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.Equal(expectedError, err)
+	s.Nil(logos)
+}
@@ -0,0 +1,16 @@
+package letterboxd
+
+// Response is the structured data (JSON-LD) of a Letterboxd film page
+type Response struct {
+	Name            string           `json:"name"`
+	URL             string           `json:"url"`
+	AggregateRating *AggregateRating `json:"aggregateRating"`
+}
+
+// AggregateRating is the average rating of a film, missing until the film has enough ratings
+type AggregateRating struct {
+	RatingValue float64 `json:"ratingValue"`
+	RatingCount int     `json:"ratingCount"`
+	BestRating  float64 `json:"bestRating"`
+	WorstRating float64 `json:"worstRating"`
+}
@@ -0,0 +1,77 @@
+package letterboxd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	letterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/model"
+)
+
+// LetterboxdSearchService looks the films up on Letterboxd
+type LetterboxdSearchService struct {
+	client rating.RatingClient
+	logger *zap.Logger
+}
+
+func NewLetterboxdSearchService(client rating.RatingClient, logger *zap.Logger) *LetterboxdSearchService {
+	return &LetterboxdSearchService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetFilm returns the Letterboxd film of the item, resolved by its TMDB ID: Letterboxd redirects
+// /tmdb/{id}/ to the page of the film. It returns nil when the item is not a film known to Letterboxd
+func (s *LetterboxdSearchService) GetFilm(ctx context.Context, item model.Item) (*letterboxd.Response, error) {
+	if item.Type != constant.MediaTypeMovie || item.ExternalIDs.TMDB == "" {
+		s.logger.Debug("item cannot be found on Letterboxd, only films with a TMDB ID can",
+			zap.String("Item ID", item.ID),
+			zap.String("Type", item.Type),
+		)
+		return nil, nil
+	}
+
+	endpoint := *s.client.GetBaseUrl()
+	endpoint.Path += "/tmdb/" + url.PathEscape(item.ExternalIDs.TMDB) + "/"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetFilm"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	response, err := s.client.DoWithRatingResponse(req)
+	if err != nil {
+		if err.Error() == model.NotFound {
+			s.logger.Debug("film not found on Letterboxd",
+				zap.String("Item ID", item.ID),
+			)
+			return nil, nil
+		}
+		s.logger.Error("unable to perform request to Letterboxd Client",
+			zap.String("method", "GetFilm"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result, ok := response.(*letterboxd.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to Letterboxd Response",
+			zap.String("method", "GetFilm"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	return result, nil
+}
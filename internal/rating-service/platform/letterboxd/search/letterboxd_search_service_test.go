@@ -0,0 +1,116 @@
+package letterboxd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	letterboxdClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/client"
+)
+
+// LetterboxdSearchServiceTestSuite runs the search service and the Letterboxd client against a local server
+type LetterboxdSearchServiceTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests int
+	service  *LetterboxdSearchService
+	ctx      context.Context
+}
+
+func TestLetterboxdSearchServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LetterboxdSearchServiceTestSuite))
+}
+
+func (s *LetterboxdSearchServiceTestSuite) SetupTest() {
+	page, err := os.ReadFile("../client/testdata/film.html")
+	s.Require().NoError(err)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /tmdb/603/", http.RedirectHandler("/film/the-matrix/", http.StatusFound))
+	mux.HandleFunc("GET /film/the-matrix/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(page)
+	})
+
+	s.requests = 0
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests++
+		mux.ServeHTTP(w, r)
+	}))
+
+	client, err := letterboxdClient.NewLetterboxdClient(&config.HTTPClient{Timeout: 5 * time.Second}, os.DevNull, zap.NewNop())
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+
+	s.service = NewLetterboxdSearchService(client, zap.NewNop())
+	s.ctx = context.Background()
+}
+
+func (s *LetterboxdSearchServiceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *LetterboxdSearchServiceTestSuite) TestGetFilm_ByTMDBID() {
+	// Arrange
+	item := model.Item{ID: "1234", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093", TMDB: "603"}}
+
+	// Act
+	film, err := s.service.GetFilm(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(film)
+	s.Equal("The Matrix", film.Name)
+	s.Require().NotNil(film.AggregateRating)
+	s.Equal(4.2, film.AggregateRating.RatingValue)
+	s.Equal(2, s.requests, "the redirect to the film page should be followed")
+}
+
+func (s *LetterboxdSearchServiceTestSuite) TestGetFilm_NotFound() {
+	testCases := []struct {
+		name             string
+		item             model.Item
+		expectedRequests int
+	}{
+		{
+			name:             "unknown TMDB ID",
+			item:             model.Item{ID: "1", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{TMDB: "42"}},
+			expectedRequests: 1,
+		},
+		{
+			name: "no TMDB ID",
+			item: model.Item{ID: "2", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}},
+		},
+		{
+			name: "show",
+			item: model.Item{ID: "3", Type: constant.MediaTypeShow, ExternalIDs: model.ExternalIDs{TMDB: "1399"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.requests = 0
+
+			// Act
+			film, err := s.service.GetFilm(s.ctx, tc.item)
+
+			// Assert
+			s.NoError(err)
+			s.Nil(film)
+			s.Equal(tc.expectedRequests, s.requests)
+		})
+	}
+}
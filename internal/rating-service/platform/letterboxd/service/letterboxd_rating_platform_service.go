@@ -0,0 +1,75 @@
+package letterboxd
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	letterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/model"
+)
+
+// ratingScale is the maximum of the Letterboxd ratings, given in stars
+const ratingScale = 5
+
+// FilmSearcher looks the films up on Letterboxd
+type FilmSearcher interface {
+	GetFilm(ctx context.Context, item model.Item) (*letterboxd.Response, error)
+}
+
+// LetterboxdRatingPlatformService provides the Letterboxd average rating, on a 0-5 scale
+type LetterboxdRatingPlatformService struct {
+	logger       *zap.Logger
+	filmSearcher FilmSearcher
+}
+
+func NewLetterboxdRatingPlatformService(logger *zap.Logger, filmSearcher FilmSearcher) *LetterboxdRatingPlatformService {
+	return &LetterboxdRatingPlatformService{
+		logger:       logger,
+		filmSearcher: filmSearcher,
+	}
+}
+
+func (s *LetterboxdRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
+	s.logger.Debug("Retrieving Letterboxd rating..",
+		zap.String("Item ID", item.ID),
+	)
+
+	film, err := s.filmSearcher.GetFilm(ctx, item)
+	if err != nil {
+		s.logger.Error("unable to get Letterboxd film",
+			zap.String("Item ID", item.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if film == nil || film.AggregateRating == nil || film.AggregateRating.RatingValue <= 0 {
+		s.logger.Debug("no Letterboxd rating found",
+			zap.String("Item ID", item.ID),
+		)
+		return nil, nil
+	}
+
+	scale := float32(ratingScale)
+	if film.AggregateRating.BestRating > 0 {
+		scale = float32(film.AggregateRating.BestRating)
+	}
+
+	s.logger.Debug("Letterboxd rating found",
+		zap.String("Item ID", item.ID),
+		zap.Float64("Value", film.AggregateRating.RatingValue),
+		zap.Int("Count", film.AggregateRating.RatingCount),
+	)
+
+	return []model.Rating{
+		{
+			Name:   constant.RatingServiceLetterboxd,
+			Rating: float32(film.AggregateRating.RatingValue),
+			Type:   model.RatingServiceTypeAudience,
+			Scale:  scale,
+			Votes:  film.AggregateRating.RatingCount,
+		},
+	}, nil
+}
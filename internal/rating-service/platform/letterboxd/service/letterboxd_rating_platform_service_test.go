@@ -0,0 +1,103 @@
+package letterboxd_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	letterboxdModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/model"
+	letterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/service"
+	letterboxd_mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/service/mocks"
+)
+
+type LetterboxdRatingPlatformServiceTestSuite struct {
+	suite.Suite
+	mockFilmSearcher *letterboxd_mocks.FilmSearcher
+	service          *letterboxd.LetterboxdRatingPlatformService
+	ctx              context.Context
+	item             model.Item
+}
+
+func (s *LetterboxdRatingPlatformServiceTestSuite) SetupTest() {
+	s.mockFilmSearcher = letterboxd_mocks.NewFilmSearcher(s.T())
+	s.service = letterboxd.NewLetterboxdRatingPlatformService(zap.NewNop(), s.mockFilmSearcher)
+	s.ctx = context.Background()
+	s.item = model.Item{ID: "1234", Title: "The Matrix", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{TMDB: "603"}}
+}
+
+func TestLetterboxdRatingPlatformServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LetterboxdRatingPlatformServiceTestSuite))
+}
+
+func (s *LetterboxdRatingPlatformServiceTestSuite) TestGetRatings() {
+	// Arrange
+	film := &letterboxdModel.Response{
+		Name:            "The Matrix",
+		AggregateRating: &letterboxdModel.AggregateRating{RatingValue: 4.2, RatingCount: 2000000, BestRating: 5},
+	}
+	s.mockFilmSearcher.On("GetFilm", s.ctx, s.item).Return(film, nil).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Rating{model.Rating{Name: constant.RatingServiceLetterboxd, Rating: 4.2, Type: model.RatingServiceTypeAudience, Scale: 5, Votes: 2000000}}, ratings)
+	s.InDelta(8.4, ratings[0].Normalized(), 0.0001)
+}
+
+func (s *LetterboxdRatingPlatformServiceTestSuite) TestGetRatings_DefaultScale() {
+	// Arrange
+	film := &letterboxdModel.Response{AggregateRating: &letterboxdModel.AggregateRating{RatingValue: 3.5}}
+	s.mockFilmSearcher.On("GetFilm", s.ctx, s.item).Return(film, nil).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(ratings, 1)
+	s.Equal(float32(5), ratings[0].Scale)
+}
+
+func (s *LetterboxdRatingPlatformServiceTestSuite) TestGetRatings_NoRating() {
+	testCases := []struct {
+		name string
+		film *letterboxdModel.Response
+	}{
+		{name: "not on Letterboxd", film: nil},
+		{name: "not enough ratings", film: &letterboxdModel.Response{Name: "Obscure"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockFilmSearcher.On("GetFilm", s.ctx, s.item).Return(tc.film, nil).Once()
+
+			// Act
+			ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+			// Assert
+			s.NoError(err)
+			s.Empty(ratings)
+		})
+	}
+}
+
+func (s *LetterboxdRatingPlatformServiceTestSuite) TestGetRatings_Error() {
+	// Arrange
+	expectedErr := errors.New("letterboxd unavailable")
+	s.mockFilmSearcher.On("GetFilm", s.ctx, s.item).Return(nil, expectedErr).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.Equal(expectedErr, err)
+	s.Empty(ratings)
+}
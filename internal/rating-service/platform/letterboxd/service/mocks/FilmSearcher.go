@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package letterboxd_mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	letterboxd "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/letterboxd/model"
+
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// FilmSearcher is an autogenerated mock type for the FilmSearcher type
+type FilmSearcher struct {
+	mock.Mock
+}
+
+type FilmSearcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FilmSearcher) EXPECT() *FilmSearcher_Expecter {
+	return &FilmSearcher_Expecter{mock: &_m.Mock}
+}
+
+// GetFilm provides a mock function with given fields: ctx, item
+func (_m *FilmSearcher) GetFilm(ctx context.Context, item model.Item) (*letterboxd.Response, error) {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFilm")
+	}
+
+	var r0 *letterboxd.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) (*letterboxd.Response, error)); ok {
+		return rf(ctx, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) *letterboxd.Response); ok {
+		r0 = rf(ctx, item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*letterboxd.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Item) error); ok {
+		r1 = rf(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilmSearcher_GetFilm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilm'
+type FilmSearcher_GetFilm_Call struct {
+	*mock.Call
+}
+
+// GetFilm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.Item
+func (_e *FilmSearcher_Expecter) GetFilm(ctx interface{}, item interface{}) *FilmSearcher_GetFilm_Call {
+	return &FilmSearcher_GetFilm_Call{Call: _e.mock.On("GetFilm", ctx, item)}
+}
+
+func (_c *FilmSearcher_GetFilm_Call) Run(run func(ctx context.Context, item model.Item)) *FilmSearcher_GetFilm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Item))
+	})
+	return _c
+}
+
+func (_c *FilmSearcher_GetFilm_Call) Return(_a0 *letterboxd.Response, _a1 error) *FilmSearcher_GetFilm_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilmSearcher_GetFilm_Call) RunAndReturn(run func(context.Context, model.Item) (*letterboxd.Response, error)) *FilmSearcher_GetFilm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFilmSearcher creates a new instance of FilmSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFilmSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FilmSearcher {
+	mock := &FilmSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+package mdblist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	mdblist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/model"
+)
+
+// MDBListClient implements the RatingClient interface
+type MDBListClient struct {
+	httpClient common.ServiceHTTPClient
+	apiKey     string
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewMDBListClient creates a new MDBList client
+func NewMDBListClient(clientConfig *config.MDBList, httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*MDBListClient, error) {
+	if clientConfig.ApiKey == "" {
+		logger.Error("mdblist.api_key is required")
+		return nil, errors.New("mdblist.api_key is required")
+	}
+
+	baseUrl := url.URL{
+		Scheme: "https",
+		Host:   "api.mdblist.com",
+	}
+
+	httpClient := NewMDBListHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		return nil, err
+	}
+
+	return &MDBListClient{
+		httpClient: httpClient,
+		apiKey:     clientConfig.ApiKey,
+		baseUrl:    baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+func (c *MDBListClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	c.setupRequest(request)
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("unable to perform request to MDBList API",
+			zap.String("url", request.URL.Redacted()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithRatingResponse performs a request and returns a parsed MDBList response
+func (c *MDBListClient) DoWithRatingResponse(request *http.Request) (rating.RatingResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseMDBListResponse(resp)
+}
+
+// setupRequest configures the request with common headers and authentication
+func (c *MDBListClient) setupRequest(request *http.Request) {
+	request.Header.Set("Accept", "application/json")
+
+	// Add MDBList api key to every request
+	q := request.URL.Query()
+	q.Set("apikey", c.apiKey)
+	request.URL.RawQuery = q.Encode()
+}
+
+// parseMDBListResponse handles the MDBList API response parsing
+func (c *MDBListClient) parseMDBListResponse(resp *http.Response) (*mdblist.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		err := errors.New(model.NotAuthorized)
+		c.logger.Error(
+			"your MDBList Api key is invalid or out of daily requests, please use a valid Api key",
+			zap.Error(err),
+		)
+		return nil, err
+	case http.StatusNotFound:
+		return nil, errors.New(model.NotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected MDBList API status code %d", resp.StatusCode)
+	}
+
+	var mdblistResponse mdblist.Response
+	err := json.NewDecoder(resp.Body).Decode(&mdblistResponse)
+	if err != nil {
+		c.logger.Error("unable to decode MDBList API response",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if mdblistResponse.Error != "" {
+		return nil, fmt.Errorf("MDBList API error: %s", mdblistResponse.Error)
+	}
+
+	return &mdblistResponse, nil
+}
+
+func (c *MDBListClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient with the provided one
+// Method used primarily for testing
+func (c *MDBListClient) SetHttpClient(client common.ServiceHTTPClient) {
+	c.httpClient = client
+}
+
+// SetBaseUrl replaces the MDBList API base URL with the provided one
+// Method used primarily for testing, against a local server
+func (c *MDBListClient) SetBaseUrl(baseUrl url.URL) {
+	c.baseUrl = baseUrl
+}
@@ -0,0 +1,166 @@
+package mdblist_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	httpClientMocks "github.com/zepollabot/media-rating-overlay/internal/httpclient/mocks"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mdblistClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/client"
+	mdblistModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/model"
+)
+
+type MDBListClientTestSuite struct {
+	suite.Suite
+	mockHTTPClient *httpClientMocks.ServiceHTTPClient
+	client         *mdblistClient.MDBListClient
+}
+
+func (s *MDBListClientTestSuite) SetupTest() {
+	s.mockHTTPClient = httpClientMocks.NewServiceHTTPClient(s.T())
+
+	client, err := mdblistClient.NewMDBListClient(
+		&config.MDBList{Enabled: true, ApiKey: "test-api-key"},
+		&config.HTTPClient{Timeout: 5 * time.Second, MaxRetries: 3},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+	client.SetHttpClient(s.mockHTTPClient)
+	s.client = client
+}
+
+func TestMDBListClientTestSuite(t *testing.T) {
+	suite.Run(t, new(MDBListClientTestSuite))
+}
+
+func (s *MDBListClientTestSuite) newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func (s *MDBListClientTestSuite) newRequest() *http.Request {
+	req, err := http.NewRequest(http.MethodGet, s.client.GetBaseUrl().String()+"/imdb/movie/tt0133093", nil)
+	s.Require().NoError(err)
+	return req
+}
+
+func (s *MDBListClientTestSuite) TestNewMDBListClient_MissingApiKey() {
+	// Act
+	client, err := mdblistClient.NewMDBListClient(&config.MDBList{Enabled: true}, &config.HTTPClient{}, os.DevNull, zap.NewNop())
+
+	// Assert
+	s.EqualError(err, "mdblist.api_key is required")
+	s.Nil(client)
+}
+
+func (s *MDBListClientTestSuite) TestGetBaseUrl() {
+	// Act
+	baseUrl := s.client.GetBaseUrl()
+
+	// Assert
+	s.Equal("https://api.mdblist.com", baseUrl.String())
+}
+
+func (s *MDBListClientTestSuite) TestDoWithResponse_AddsApiKey() {
+	// Arrange
+	var capturedRequest *http.Request
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Run(func(args mock.Arguments) {
+			capturedRequest = args.Get(0).(*http.Request)
+		}).
+		Return(s.newResponse(http.StatusOK, `{}`), nil).
+		Once()
+
+	// Act
+	resp, err := s.client.DoWithResponse(s.newRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("test-api-key", capturedRequest.URL.Query().Get("apikey"))
+	s.Equal("/imdb/movie/tt0133093", capturedRequest.URL.Path)
+	s.Equal("application/json", capturedRequest.Header.Get("Accept"))
+}
+
+func (s *MDBListClientTestSuite) TestDoWithResponse_ClientError() {
+	// Arrange
+	expectedError := errors.New("network error")
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expectedError).Once()
+
+	// Act
+	resp, err := s.client.DoWithResponse(s.newRequest())
+
+	// Assert
+	s.ErrorIs(err, expectedError)
+	s.Nil(resp)
+}
+
+func (s *MDBListClientTestSuite) TestDoWithRatingResponse_Success() {
+	// Arrange
+	body := `{"title":"The Matrix","year":1999,"type":"movie","ratings":[` +
+		`{"source":"imdb","value":8.7,"score":87,"votes":2100000},` +
+		`{"source":"letterboxd","value":4.2,"score":84,"votes":null},` +
+		`{"source":"rogerebert","value":null,"score":null,"votes":null}]}`
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(s.newResponse(http.StatusOK, body), nil).Once()
+
+	// Act
+	ratingResp, err := s.client.DoWithRatingResponse(s.newRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	parsedResp, ok := ratingResp.(*mdblistModel.Response)
+	s.Require().True(ok, "Response should be of type *mdblistModel.Response")
+	s.Equal("The Matrix", parsedResp.Title)
+	s.Equal(1999, parsedResp.Year)
+	s.Require().Len(parsedResp.Ratings, 3)
+	s.Equal("imdb", parsedResp.Ratings[0].Source)
+	s.Equal(8.7, *parsedResp.Ratings[0].Value)
+	s.Equal(2100000, *parsedResp.Ratings[0].Votes)
+	s.Equal(4.2, *parsedResp.Ratings[1].Value)
+	s.Nil(parsedResp.Ratings[1].Votes)
+	s.Nil(parsedResp.Ratings[2].Value)
+}
+
+func (s *MDBListClientTestSuite) TestDoWithRatingResponse_Errors() {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedError string
+	}{
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, body: `{"error":"Invalid API key!"}`, expectedError: model.NotAuthorized},
+		{name: "forbidden", statusCode: http.StatusForbidden, body: ``, expectedError: model.NotAuthorized},
+		{name: "not found", statusCode: http.StatusNotFound, body: ``, expectedError: model.NotFound},
+		{name: "server error", statusCode: http.StatusServiceUnavailable, body: ``, expectedError: "unexpected MDBList API status code 503"},
+		{name: "error in body", statusCode: http.StatusOK, body: `{"error":"API limit reached!"}`, expectedError: "MDBList API error: API limit reached!"},
+		{name: "invalid JSON", statusCode: http.StatusOK, body: `this is not json`, expectedError: "invalid character 'h' in literal true"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(s.newResponse(tc.statusCode, tc.body), nil).Once()
+
+			// Act
+			ratingResp, err := s.client.DoWithRatingResponse(s.newRequest())
+
+			// Assert
+			s.ErrorContains(err, tc.expectedError)
+			s.Nil(ratingResp)
+		})
+	}
+}
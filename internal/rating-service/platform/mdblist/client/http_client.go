@@ -0,0 +1,25 @@
+package mdblist
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// MDBListHTTPClient implements the HTTPClient interface for MDBList
+type MDBListHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewMDBListHTTPClient creates a new MDBList HTTP client
+func NewMDBListHTTPClient(timeout time.Duration, maxRetries int) *MDBListHTTPClient {
+	return &MDBListHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *MDBListHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
@@ -0,0 +1,20 @@
+package mdblist
+
+// Response is the MDBList media response, holding the ratings of the item on several platforms
+type Response struct {
+	Title   string   `json:"title"`
+	Year    int      `json:"year"`
+	Type    string   `json:"type"`
+	Ratings []Rating `json:"ratings"`
+	// Error is set instead of the media when the request is refused
+	Error string `json:"error"`
+}
+
+// Rating is the rating of the item on the platform Source, on the scale of the platform: Value
+// is null when the platform does not rate the item, Score is Value on a 0-100 scale
+type Rating struct {
+	Source string   `json:"source"`
+	Value  *float64 `json:"value"`
+	Score  *float64 `json:"score"`
+	Votes  *int     `json:"votes"`
+}
@@ -0,0 +1,98 @@
+package mdblist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	mdblist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/model"
+)
+
+// MDBListSearchService looks the items up on MDBList
+type MDBListSearchService struct {
+	client rating.RatingClient
+	logger *zap.Logger
+}
+
+func NewMDBListSearchService(client rating.RatingClient, logger *zap.Logger) *MDBListSearchService {
+	return &MDBListSearchService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetMedia returns the MDBList media of the item, looked up by its IMDb ID when known, otherwise
+// by its TMDB ID. It returns nil when the item is not on MDBList
+func (s *MDBListSearchService) GetMedia(ctx context.Context, item model.Item) (*mdblist.Response, error) {
+	var mediaType string
+	switch item.Type {
+	case constant.MediaTypeMovie:
+		mediaType = "movie"
+	case constant.MediaTypeShow, constant.MediaTypeSeason:
+		mediaType = "show"
+	default:
+		s.logger.Debug("media type not supported by MDBList",
+			zap.String("Item ID", item.ID),
+			zap.String("Type", item.Type),
+		)
+		return nil, nil
+	}
+
+	var provider, id string
+	switch {
+	case item.ExternalIDs.IMDB != "":
+		provider, id = "imdb", item.ExternalIDs.IMDB
+	case item.ExternalIDs.TMDB != "":
+		provider, id = "tmdb", item.ExternalIDs.TMDB
+	default:
+		s.logger.Debug("item has no ID known by MDBList",
+			zap.String("Item ID", item.ID),
+		)
+		return nil, nil
+	}
+
+	return s.getResponse(ctx, "/"+provider+"/"+mediaType+"/"+url.PathEscape(id))
+}
+
+// getResponse requests path, returning nil when MDBList does not know the resource
+func (s *MDBListSearchService) getResponse(ctx context.Context, path string) (*mdblist.Response, error) {
+	endpoint := *s.client.GetBaseUrl()
+	endpoint.Path += path
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetMedia"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	response, err := s.client.DoWithRatingResponse(req)
+	if err != nil {
+		if err.Error() == model.NotFound {
+			return nil, nil
+		}
+		s.logger.Error("unable to perform request to MDBList Client",
+			zap.String("method", "GetMedia"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result, ok := response.(*mdblist.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to MDBList Response",
+			zap.String("method", "GetMedia"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	return result, nil
+}
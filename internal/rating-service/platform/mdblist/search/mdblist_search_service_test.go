@@ -0,0 +1,144 @@
+package mdblist
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mdblistClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/client"
+)
+
+// MDBListSearchServiceTestSuite runs the search service and the MDBList client against a local server
+type MDBListSearchServiceTestSuite struct {
+	suite.Suite
+	mux      *http.ServeMux
+	server   *httptest.Server
+	requests []*http.Request
+	service  *MDBListSearchService
+	ctx      context.Context
+}
+
+func TestMDBListSearchServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MDBListSearchServiceTestSuite))
+}
+
+func (s *MDBListSearchServiceTestSuite) SetupTest() {
+	s.mux = http.NewServeMux()
+	s.requests = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests = append(s.requests, r)
+		s.mux.ServeHTTP(w, r)
+	}))
+
+	client, err := mdblistClient.NewMDBListClient(
+		&config.MDBList{Enabled: true, ApiKey: "test-api-key"},
+		&config.HTTPClient{Timeout: 5 * time.Second},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+
+	s.service = NewMDBListSearchService(client, zap.NewNop())
+	s.ctx = context.Background()
+}
+
+func (s *MDBListSearchServiceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *MDBListSearchServiceTestSuite) handle(pattern string, body string) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+func (s *MDBListSearchServiceTestSuite) TestGetMedia_ByIMDbID() {
+	// Arrange
+	item := model.Item{ID: "1234", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093", TMDB: "603"}}
+	s.handle("GET /imdb/movie/tt0133093", `{"title": "The Matrix", "ratings": [{"source": "imdb", "value": 8.7}]}`)
+
+	// Act
+	response, err := s.service.GetMedia(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Equal("The Matrix", response.Title)
+	s.Len(response.Ratings, 1)
+	s.Require().Len(s.requests, 1)
+	s.Equal("test-api-key", s.requests[0].URL.Query().Get("apikey"))
+}
+
+func (s *MDBListSearchServiceTestSuite) TestGetMedia_ShowByTMDBID() {
+	// Arrange
+	item := model.Item{ID: "5678", Type: constant.MediaTypeSeason, ExternalIDs: model.ExternalIDs{TMDB: "1399"}}
+	s.handle("GET /tmdb/show/1399", `{"title": "Game of Thrones", "ratings": []}`)
+
+	// Act
+	response, err := s.service.GetMedia(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Equal("Game of Thrones", response.Title)
+}
+
+func (s *MDBListSearchServiceTestSuite) TestGetMedia_NotFound() {
+	testCases := []struct {
+		name string
+		item model.Item
+	}{
+		{
+			name: "unknown IMDb ID",
+			item: model.Item{ID: "1", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0000000"}},
+		},
+		{
+			name: "no external ID",
+			item: model.Item{ID: "2", Type: constant.MediaTypeMovie},
+		},
+		{
+			name: "episode",
+			item: model.Item{ID: "3", Type: constant.MediaTypeEpisode, ExternalIDs: model.ExternalIDs{IMDB: "tt0944947"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Act
+			response, err := s.service.GetMedia(s.ctx, tc.item)
+
+			// Assert
+			s.NoError(err)
+			s.Nil(response)
+		})
+	}
+}
+
+func (s *MDBListSearchServiceTestSuite) TestGetMedia_Error() {
+	// Arrange
+	item := model.Item{ID: "1234", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+	s.mux.HandleFunc("GET /imdb/movie/tt0133093", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	// Act
+	response, err := s.service.GetMedia(s.ctx, item)
+
+	// Assert
+	s.EqualError(err, model.NotAuthorized)
+	s.Nil(response)
+}
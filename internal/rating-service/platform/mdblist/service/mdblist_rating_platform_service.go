@@ -0,0 +1,93 @@
+package mdblist
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mdblist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/model"
+)
+
+// MediaSearcher looks the items up on MDBList
+type MediaSearcher interface {
+	GetMedia(ctx context.Context, item model.Item) (*mdblist.Response, error)
+}
+
+// source is the rating a MDBList source stands for, the value of the source being divided
+// by divisor to get the rating on its scale
+type source struct {
+	name       string
+	ratingType string
+	divisor    float64
+	scale      float32
+}
+
+// sources are the MDBList sources having a logo, the others are ignored
+var sources = map[string]source{
+	"imdb":       {name: constant.RatingServiceIMDB, ratingType: model.RatingServiceTypeAudience, divisor: 1},
+	"tmdb":       {name: constant.RatingServiceTMDB, ratingType: model.RatingServiceTypeAudience, divisor: 10},
+	"trakt":      {name: constant.RatingServiceTrakt, ratingType: model.RatingServiceTypeAudience, divisor: 10},
+	"letterboxd": {name: constant.RatingServiceLetterboxd, ratingType: model.RatingServiceTypeAudience, divisor: 1, scale: 5},
+	"tomatoes":   {name: constant.RatingServiceRottenTomatoes, ratingType: model.RatingServiceTypeCritic, divisor: 10},
+	"popcorn":    {name: constant.RatingServiceRottenTomatoes, ratingType: model.RatingServiceTypeAudience, divisor: 10},
+	"metacritic": {name: constant.RatingServiceMetacritic, ratingType: model.RatingServiceTypeCritic, divisor: 10},
+}
+
+// MDBListRatingPlatformService provides the ratings aggregated by MDBList, each one named after
+// its platform so that it is drawn with the logo of the platform
+type MDBListRatingPlatformService struct {
+	logger        *zap.Logger
+	mediaSearcher MediaSearcher
+}
+
+func NewMDBListRatingPlatformService(logger *zap.Logger, mediaSearcher MediaSearcher) *MDBListRatingPlatformService {
+	return &MDBListRatingPlatformService{
+		logger:        logger,
+		mediaSearcher: mediaSearcher,
+	}
+}
+
+func (s *MDBListRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
+	s.logger.Debug("Retrieving MDBList ratings..",
+		zap.String("Item ID", item.ID),
+	)
+
+	response, err := s.mediaSearcher.GetMedia(ctx, item)
+	if err != nil {
+		s.logger.Error("unable to get MDBList media",
+			zap.String("Item ID", item.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if response == nil {
+		return nil, nil
+	}
+
+	var ratings []model.Rating
+	for _, rating := range response.Ratings {
+		source, found := sources[rating.Source]
+		if !found || rating.Value == nil || *rating.Value <= 0 {
+			continue
+		}
+
+		ratings = append(ratings, model.Rating{
+			Name:   source.name,
+			Rating: float32(*rating.Value / source.divisor),
+			Type:   source.ratingType,
+			Scale:  source.scale,
+			Votes:  lo.FromPtr(rating.Votes),
+		})
+	}
+
+	s.logger.Debug("MDBList ratings found",
+		zap.String("Item ID", item.ID),
+		zap.Int("Ratings", len(ratings)),
+	)
+
+	return ratings, nil
+}
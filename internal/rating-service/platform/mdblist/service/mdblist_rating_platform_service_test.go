@@ -0,0 +1,125 @@
+package mdblist_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mdblistModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/model"
+	mdblist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/service"
+	mdblist_mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/mdblist/service/mocks"
+)
+
+type MDBListRatingPlatformServiceTestSuite struct {
+	suite.Suite
+	mockMediaSearcher *mdblist_mocks.MediaSearcher
+	service           *mdblist.MDBListRatingPlatformService
+	ctx               context.Context
+	item              model.Item
+}
+
+func (s *MDBListRatingPlatformServiceTestSuite) SetupTest() {
+	s.mockMediaSearcher = mdblist_mocks.NewMediaSearcher(s.T())
+	s.service = mdblist.NewMDBListRatingPlatformService(zap.NewNop(), s.mockMediaSearcher)
+	s.ctx = context.Background()
+	s.item = model.Item{ID: "1234", Title: "The Matrix", Year: 1999, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+}
+
+func TestMDBListRatingPlatformServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MDBListRatingPlatformServiceTestSuite))
+}
+
+func value(v float64) *float64 {
+	return &v
+}
+
+func votes(v int) *int {
+	return &v
+}
+
+func (s *MDBListRatingPlatformServiceTestSuite) TestGetRatings() {
+	// Arrange
+	response := &mdblistModel.Response{
+		Title: "The Matrix",
+		Ratings: []mdblistModel.Rating{
+			{Source: "imdb", Value: value(8.7), Votes: votes(2134567)},
+			{Source: "metacritic", Value: value(73)},
+			{Source: "metacriticuser", Value: value(9.1)},
+			{Source: "trakt", Value: value(85)},
+			{Source: "tomatoes", Value: value(83)},
+			{Source: "popcorn", Value: value(85)},
+			{Source: "letterboxd", Value: value(4.2)},
+			{Source: "tmdb", Value: value(82)},
+			{Source: "rogerebert", Value: nil},
+		},
+	}
+	s.mockMediaSearcher.On("GetMedia", s.ctx, s.item).Return(response, nil).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.Require().NoError(err)
+	expectedRatings := []model.Rating{
+		{Name: constant.RatingServiceIMDB, Rating: 8.7, Type: model.RatingServiceTypeAudience, Votes: 2134567},
+		{Name: constant.RatingServiceMetacritic, Rating: 7.3, Type: model.RatingServiceTypeCritic},
+		{Name: constant.RatingServiceTrakt, Rating: 8.5, Type: model.RatingServiceTypeAudience},
+		{Name: constant.RatingServiceRottenTomatoes, Rating: 8.3, Type: model.RatingServiceTypeCritic},
+		{Name: constant.RatingServiceRottenTomatoes, Rating: 8.5, Type: model.RatingServiceTypeAudience},
+		{Name: constant.RatingServiceLetterboxd, Rating: 4.2, Type: model.RatingServiceTypeAudience, Scale: 5},
+		{Name: constant.RatingServiceTMDB, Rating: 8.2, Type: model.RatingServiceTypeAudience},
+	}
+	s.Require().Len(ratings, len(expectedRatings))
+	for i, expectedRating := range expectedRatings {
+		s.Equal(expectedRating.Name, ratings[i].Name)
+		s.Equal(expectedRating.Type, ratings[i].Type)
+		s.Equal(expectedRating.Scale, ratings[i].Scale)
+		s.Equal(expectedRating.Votes, ratings[i].Votes)
+		s.InDelta(expectedRating.Rating, ratings[i].Rating, 0.001)
+	}
+}
+
+func (s *MDBListRatingPlatformServiceTestSuite) TestGetRatings_NoRating() {
+	testCases := []struct {
+		name     string
+		response *mdblistModel.Response
+	}{
+		{name: "not on MDBList", response: nil},
+		{name: "no ratings", response: &mdblistModel.Response{Title: "The Matrix"}},
+		{name: "zero and unknown values", response: &mdblistModel.Response{
+			Ratings: []mdblistModel.Rating{{Source: "imdb", Value: value(0)}, {Source: "tomatoes", Value: nil}},
+		}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockMediaSearcher.On("GetMedia", s.ctx, s.item).Return(tc.response, nil).Once()
+
+			// Act
+			ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+			// Assert
+			s.NoError(err)
+			s.Empty(ratings)
+		})
+	}
+}
+
+func (s *MDBListRatingPlatformServiceTestSuite) TestGetRatings_Error() {
+	// Arrange
+	expectedErr := errors.New("request failed")
+	s.mockMediaSearcher.On("GetMedia", s.ctx, s.item).Return(nil, expectedErr).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.ErrorIs(err, expectedErr)
+	s.Nil(ratings)
+}
@@ -0,0 +1,87 @@
+package metacritic
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/shopspring/decimal"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+// Metascore thresholds of the Metacritic colors: green from 61, yellow from 40, red below
+const (
+	highMetascoreThreshold   = 61
+	mediumMetascoreThreshold = 40
+)
+
+type LogoCreator interface {
+	CreateLogo(imagePath string, text string, dimensions model.LogoDimensions) (*model.Logo, error)
+}
+
+// MetacriticLogoService implements the LogoService interface for Metacritic
+type MetacriticLogoService struct {
+	logger      *zap.Logger
+	config      *model.PosterConfig
+	logoCreator LogoCreator
+}
+
+// NewMetacriticLogoService creates a new Metacritic logo service
+func NewMetacriticLogoService(
+	logger *zap.Logger,
+	config *model.PosterConfig,
+	logoCreator LogoCreator,
+) *MetacriticLogoService {
+	return &MetacriticLogoService{
+		logger:      logger,
+		config:      config,
+		logoCreator: logoCreator,
+	}
+}
+
+// GetLogos gets logos for a Metacritic item, the badge color following the Metascore
+func (s *MetacriticLogoService) GetLogos(
+	ctx context.Context,
+	ratings []model.Rating,
+	itemID string,
+	dimensions model.LogoDimensions,
+) ([]*model.Logo, error) {
+	logos := make([]*model.Logo, 0)
+
+	s.logger.Debug("Build Metacritic logos..",
+		zap.String("Item ID", itemID),
+	)
+
+	for _, rating := range ratings {
+		if rating.Rating > 0.0 {
+
+			if rating.Type != model.RatingServiceTypeCritic {
+				s.logger.Debug("Unknown rating type", zap.String("type", rating.Type))
+				continue
+			}
+
+			metascore := decimal.NewFromFloat32(rating.Rating * 10).Round(0)
+
+			logoPath := s.config.ImagePaths.Metacritic.Critic.Low
+			switch {
+			case metascore.GreaterThanOrEqual(decimal.NewFromInt(highMetascoreThreshold)):
+				logoPath = s.config.ImagePaths.Metacritic.Critic.High
+			case metascore.GreaterThanOrEqual(decimal.NewFromInt(mediumMetascoreThreshold)):
+				logoPath = s.config.ImagePaths.Metacritic.Critic.Medium
+			}
+
+			logo, err := s.logoCreator.CreateLogo(
+				logoPath,
+				metascore.String(),
+				dimensions,
+			)
+			if err != nil {
+				s.logger.Debug("Error creating Metacritic logo", zap.Error(err))
+				return nil, err
+			}
+			logos = append(logos, logo)
+		}
+	}
+
+	return logos, nil
+}
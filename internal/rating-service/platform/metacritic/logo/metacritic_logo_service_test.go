@@ -0,0 +1,121 @@
+package metacritic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/metacritic/logo/mocks"
+)
+
+type MetacriticLogoServiceTestSuite struct {
+	suite.Suite
+	mockLogoCreator *mocks.LogoCreator
+	logger          *zap.Logger
+	config          *model.PosterConfig
+	service         *MetacriticLogoService
+}
+
+func (s *MetacriticLogoServiceTestSuite) SetupTest() {
+	s.mockLogoCreator = new(mocks.LogoCreator)
+	s.logger = zap.NewNop()
+
+	s.config = &model.PosterConfig{}
+	s.config.ImagePaths.Metacritic.Critic.High = "path/to/metacritic/high.png"
+	s.config.ImagePaths.Metacritic.Critic.Medium = "path/to/metacritic/medium.png"
+	s.config.ImagePaths.Metacritic.Critic.Low = "path/to/metacritic/low.png"
+
+	s.service = NewMetacriticLogoService(s.logger, s.config, s.mockLogoCreator)
+}
+
+func (s *MetacriticLogoServiceTestSuite) TearDownTest() {
+	s.mockLogoCreator.AssertExpectations(s.T())
+}
+
+func TestMetacriticLogoServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MetacriticLogoServiceTestSuite))
+}
+
+func (s *MetacriticLogoServiceTestSuite) TestNewMetacriticLogoService() {
+	logger := zap.NewNop()
+	config := &model.PosterConfig{}
+	mockLogoCreator := new(mocks.LogoCreator)
+
+	service := NewMetacriticLogoService(logger, config, mockLogoCreator)
+
+	s.NotNil(service)
+	s.Equal(logger, service.logger)
+	s.Equal(config, service.config)
+	s.Equal(mockLogoCreator, service.logoCreator)
+}
+
+func (s *MetacriticLogoServiceTestSuite) TestGetLogos_Variants() {
+	testCases := []struct {
+		name         string
+		rating       float32
+		expectedPath string
+		expectedText string
+	}{
+		{name: "high", rating: 8.4, expectedPath: s.config.ImagePaths.Metacritic.Critic.High, expectedText: "84"},
+		{name: "high lower bound", rating: 6.1, expectedPath: s.config.ImagePaths.Metacritic.Critic.High, expectedText: "61"},
+		{name: "medium upper bound", rating: 6.0, expectedPath: s.config.ImagePaths.Metacritic.Critic.Medium, expectedText: "60"},
+		{name: "medium lower bound", rating: 4.0, expectedPath: s.config.ImagePaths.Metacritic.Critic.Medium, expectedText: "40"},
+		{name: "low", rating: 3.9, expectedPath: s.config.ImagePaths.Metacritic.Critic.Low, expectedText: "39"},
+		{name: "rounded before choosing the variant", rating: 6.05, expectedPath: s.config.ImagePaths.Metacritic.Critic.High, expectedText: "61"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			ratings := []model.Rating{{Type: model.RatingServiceTypeCritic, Rating: tc.rating}}
+			dimensions := model.LogoDimensions{}
+			expectedLogo := &model.Logo{}
+
+			s.mockLogoCreator.On("CreateLogo", tc.expectedPath, tc.expectedText, dimensions).Return(expectedLogo, nil).Once()
+
+			// Act
+			logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+			// Assert
+			s.NoError(err)
+			s.Require().Len(logos, 1)
+			s.Equal(expectedLogo, logos[0])
+		})
+	}
+}
+
+func (s *MetacriticLogoServiceTestSuite) TestGetLogos_SkippedRatings() {
+	// Arrange
+	ratings := []model.Rating{
+		{Type: model.RatingServiceTypeCritic, Rating: 0.0},
+		{Type: model.RatingServiceTypeAudience, Rating: 7.0},
+	}
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", model.LogoDimensions{})
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(logos)
+	s.Len(logos, 0)
+}
+
+func (s *MetacriticLogoServiceTestSuite) TestGetLogos_CreateLogoError() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeCritic, Rating: 9.0}}
+	dimensions := model.LogoDimensions{}
+	expectedError := errors.New("logo creation failed")
+
+	s.mockLogoCreator.On("CreateLogo", s.config.ImagePaths.Metacritic.Critic.High, "90", dimensions).Return(nil, expectedError).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.Equal(expectedError, err)
+	s.Nil(logos)
+}
@@ -0,0 +1,146 @@
+package myanimelist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	myanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/model"
+)
+
+// MyAnimeListClient implements the RatingClient interface
+type MyAnimeListClient struct {
+	httpClient common.ServiceHTTPClient
+	clientID   string
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewMyAnimeListClient creates a new MyAnimeList client
+func NewMyAnimeListClient(clientConfig *config.MyAnimeList, httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*MyAnimeListClient, error) {
+	if clientConfig.ClientID == "" {
+		logger.Error("myanimelist.client_id is required")
+		return nil, errors.New("myanimelist.client_id is required")
+	}
+
+	baseUrl := url.URL{
+		Scheme: "https",
+		Host:   "api.myanimelist.net",
+		Path:   "/v2",
+	}
+
+	httpClient := NewMyAnimeListHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		return nil, err
+	}
+
+	return &MyAnimeListClient{
+		httpClient: httpClient,
+		clientID:   clientConfig.ClientID,
+		baseUrl:    baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+func (c *MyAnimeListClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	c.setupRequest(request)
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("unable to perform request to MyAnimeList API",
+			zap.String("url", request.URL.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithRatingResponse performs a request and returns a parsed MyAnimeList response
+func (c *MyAnimeListClient) DoWithRatingResponse(request *http.Request) (rating.RatingResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseMyAnimeListResponse(resp)
+}
+
+// setupRequest configures the request with common headers and authentication
+func (c *MyAnimeListClient) setupRequest(request *http.Request) {
+	request.Header.Set("Accept", "application/json")
+
+	// MyAnimeList identifies the application by its client ID on the requests of public data
+	request.Header.Set("X-MAL-CLIENT-ID", c.clientID)
+}
+
+// parseMyAnimeListResponse handles the MyAnimeList API response parsing
+func (c *MyAnimeListClient) parseMyAnimeListResponse(resp *http.Response) (*myanimelist.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		err := errors.New(model.NotAuthorized)
+		c.logger.Error(
+			"your MyAnimeList client ID is invalid, please use the client ID of a MyAnimeList API application",
+			zap.Error(err),
+		)
+		return nil, err
+	case http.StatusNotFound:
+		return nil, errors.New(model.NotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest {
+		return nil, fmt.Errorf("unexpected MyAnimeList API status code %d", resp.StatusCode)
+	}
+
+	var myAnimeListResponse myanimelist.Response
+	err := json.NewDecoder(resp.Body).Decode(&myAnimeListResponse)
+	if err != nil {
+		c.logger.Error("unable to decode MyAnimeList API response",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Bad requests, e.g. an invalid search, come with the reason in the body
+	if myAnimeListResponse.Error != "" {
+		return nil, fmt.Errorf("MyAnimeList API error: %s", myAnimeListResponse.Error)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected MyAnimeList API status code %d", resp.StatusCode)
+	}
+
+	return &myAnimeListResponse, nil
+}
+
+func (c *MyAnimeListClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient with the provided one
+// Method used primarily for testing
+func (c *MyAnimeListClient) SetHttpClient(client common.ServiceHTTPClient) {
+	c.httpClient = client
+}
+
+// SetBaseUrl replaces the MyAnimeList API base URL with the provided one
+// Method used primarily for testing, against a local server
+func (c *MyAnimeListClient) SetBaseUrl(baseUrl url.URL) {
+	c.baseUrl = baseUrl
+}
@@ -0,0 +1,152 @@
+package myanimelist_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	myAnimeListClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/client"
+	myAnimeListModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/model"
+)
+
+type MyAnimeListClientTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	handler http.HandlerFunc
+	client  *myAnimeListClient.MyAnimeListClient
+}
+
+func (s *MyAnimeListClientTestSuite) SetupTest() {
+	s.handler = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+
+	client, err := myAnimeListClient.NewMyAnimeListClient(
+		&config.MyAnimeList{Enabled: true, ClientID: "test-client-id"},
+		&config.HTTPClient{Timeout: 5 * time.Second, MaxRetries: 0},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+	s.client = client
+}
+
+func (s *MyAnimeListClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestMyAnimeListClientTestSuite(t *testing.T) {
+	suite.Run(t, new(MyAnimeListClientTestSuite))
+}
+
+func (s *MyAnimeListClientTestSuite) newRequest(path string) *http.Request {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, s.client.GetBaseUrl().String()+path, nil)
+	s.Require().NoError(err)
+	return req
+}
+
+func (s *MyAnimeListClientTestSuite) TestNewMyAnimeListClient_MissingClientID() {
+	// Act
+	client, err := myAnimeListClient.NewMyAnimeListClient(&config.MyAnimeList{Enabled: true}, &config.HTTPClient{}, os.DevNull, zap.NewNop())
+
+	// Assert
+	s.EqualError(err, "myanimelist.client_id is required")
+	s.Nil(client)
+}
+
+func (s *MyAnimeListClientTestSuite) TestDoWithRatingResponse() {
+	// Arrange
+	var capturedRequest *http.Request
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		capturedRequest = r
+		_, _ = w.Write([]byte(`{"data": [{"node": {"id": 1, "title": "Cowboy Bebop", "main_picture": {}, "alternative_titles": {"synonyms": [], "en": "Cowboy Bebop", "ja": "カウボーイビバップ"}, "start_date": "1998-04-03", "media_type": "tv", "mean": 8.75}}], "paging": {}}`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest("/anime?q=Cowboy+Bebop"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&myAnimeListModel.Response{
+		Data: []myAnimeListModel.Result{
+			{
+				Node: myAnimeListModel.Anime{
+					ID:                1,
+					Title:             "Cowboy Bebop",
+					AlternativeTitles: myAnimeListModel.AlternativeTitles{Synonyms: []string{}, En: "Cowboy Bebop", Ja: "カウボーイビバップ"},
+					StartDate:         "1998-04-03",
+					MediaType:         "tv",
+					Mean:              8.75,
+				},
+			},
+		},
+	}, response)
+	s.Equal("/anime", capturedRequest.URL.Path)
+	s.Equal("test-client-id", capturedRequest.Header.Get("X-MAL-CLIENT-ID"))
+	s.Equal("application/json", capturedRequest.Header.Get("Accept"))
+}
+
+func (s *MyAnimeListClientTestSuite) TestDoWithRatingResponse_Errors() {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedError string
+	}{
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, expectedError: model.NotAuthorized},
+		{name: "forbidden", statusCode: http.StatusForbidden, expectedError: model.NotAuthorized},
+		{name: "not found", statusCode: http.StatusNotFound, expectedError: model.NotFound},
+		{name: "unexpected status", statusCode: http.StatusTeapot, expectedError: "unexpected MyAnimeList API status code 418"},
+		{
+			name:          "bad request",
+			statusCode:    http.StatusBadRequest,
+			body:          `{"message": "", "error": "bad_request"}`,
+			expectedError: "MyAnimeList API error: bad_request",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}
+
+			// Act
+			response, err := s.client.DoWithRatingResponse(s.newRequest("/anime?q=Cowboy+Bebop"))
+
+			// Assert
+			s.EqualError(err, tc.expectedError)
+			s.Nil(response)
+		})
+	}
+}
+
+func (s *MyAnimeListClientTestSuite) TestDoWithRatingResponse_InvalidBody() {
+	// Arrange
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest("/anime?q=Cowboy+Bebop"))
+
+	// Assert
+	s.Error(err)
+	s.Nil(response)
+}
@@ -0,0 +1,25 @@
+package myanimelist
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// MyAnimeListHTTPClient implements the HTTPClient interface for MyAnimeList
+type MyAnimeListHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewMyAnimeListHTTPClient creates a new MyAnimeList HTTP client
+func NewMyAnimeListHTTPClient(timeout time.Duration, maxRetries int) *MyAnimeListHTTPClient {
+	return &MyAnimeListHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *MyAnimeListHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
@@ -0,0 +1,69 @@
+package myanimelist
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type LogoCreator interface {
+	CreateLogo(imagePath string, text string, dimensions model.LogoDimensions) (*model.Logo, error)
+}
+
+// MyAnimeListLogoService implements the LogoService interface for MyAnimeList
+type MyAnimeListLogoService struct {
+	logger      *zap.Logger
+	config      *model.PosterConfig
+	logoCreator LogoCreator
+}
+
+// NewMyAnimeListLogoService creates a new MyAnimeList logo service
+func NewMyAnimeListLogoService(
+	logger *zap.Logger,
+	config *model.PosterConfig,
+	logoCreator LogoCreator,
+) *MyAnimeListLogoService {
+	return &MyAnimeListLogoService{
+		logger:      logger,
+		config:      config,
+		logoCreator: logoCreator,
+	}
+}
+
+// GetLogos gets logos for a MyAnimeList item, showing the mean score with two decimals, as MyAnimeList does
+func (s *MyAnimeListLogoService) GetLogos(
+	ctx context.Context,
+	ratings []model.Rating,
+	itemID string,
+	dimensions model.LogoDimensions,
+) ([]*model.Logo, error) {
+	logos := make([]*model.Logo, 0)
+
+	s.logger.Debug("Build MyAnimeList logos..",
+		zap.String("Item ID", itemID),
+	)
+
+	for _, rating := range ratings {
+		if rating.Rating > 0.0 {
+			rating := decimal.NewFromFloat32(rating.Normalized()).Round(2).StringFixedBank(2)
+
+			logo, err := s.logoCreator.CreateLogo(
+				s.config.ImagePaths.MyAnimeList.Audience.Normal,
+				rating,
+				dimensions,
+			)
+
+			if err != nil {
+				s.logger.Debug("Error creating MyAnimeList logo", zap.Error(err))
+				return nil, err
+			}
+
+			logos = append(logos, logo)
+		}
+	}
+
+	return logos, nil
+}
@@ -0,0 +1,83 @@
+package myanimelist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/logo/mocks"
+)
+
+type MyAnimeListLogoServiceTestSuite struct {
+	suite.Suite
+	mockLogoCreator *mocks.LogoCreator
+	config          *model.PosterConfig
+	service         *MyAnimeListLogoService
+}
+
+func (s *MyAnimeListLogoServiceTestSuite) SetupTest() {
+	s.mockLogoCreator = new(mocks.LogoCreator)
+
+	s.config = &model.PosterConfig{}
+	s.config.ImagePaths.MyAnimeList.Audience.Normal = "path/to/myanimelist.png"
+
+	s.service = NewMyAnimeListLogoService(zap.NewNop(), s.config, s.mockLogoCreator)
+}
+
+func (s *MyAnimeListLogoServiceTestSuite) TearDownTest() {
+	s.mockLogoCreator.AssertExpectations(s.T())
+}
+
+func TestMyAnimeListLogoServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MyAnimeListLogoServiceTestSuite))
+}
+
+func (s *MyAnimeListLogoServiceTestSuite) TestGetLogos() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 8.16}}
+	dimensions := model.LogoDimensions{}
+	expectedLogo := &model.Logo{}
+
+	s.mockLogoCreator.On("CreateLogo", "path/to/myanimelist.png", "8.16", dimensions).Return(expectedLogo, nil).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(logos, 1)
+	s.Equal(expectedLogo, logos[0])
+}
+
+func (s *MyAnimeListLogoServiceTestSuite) TestGetLogos_RatingIsZero() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 0.0}}
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", model.LogoDimensions{})
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(logos)
+	s.Len(logos, 0)
+}
+
+func (s *MyAnimeListLogoServiceTestSuite) TestGetLogos_CreateLogoError() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 7}}
+	dimensions := model.LogoDimensions{}
+	expectedError := errors.New("logo creation failed")
+
+	s.mockLogoCreator.On("CreateLogo", "path/to/myanimelist.png", "7.00", dimensions).Return(nil, expectedError).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.Equal(expectedError, err)
+	s.Nil(logos)
+}
@@ -0,0 +1,36 @@
+package myanimelist
+
+// Response is the MyAnimeList response to an anime search
+type Response struct {
+	Data []Result `json:"data"`
+	// Error and Message are set instead of the data when the request is refused
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// Result wraps an anime found by a search
+type Result struct {
+	Node Anime `json:"node"`
+}
+
+// Anime is an anime listed on MyAnimeList
+type Anime struct {
+	ID                int               `json:"id"`
+	Title             string            `json:"title"`
+	AlternativeTitles AlternativeTitles `json:"alternative_titles"`
+	// StartDate is the start date of the anime, as YYYY-MM-DD, YYYY-MM or YYYY
+	StartDate string `json:"start_date"`
+	// MediaType is the type of the anime, e.g. tv, movie or ona
+	MediaType string `json:"media_type"`
+	// Mean is the mean score of the users, on a 0-10 scale. It is missing until the anime has enough scores
+	Mean float64 `json:"mean"`
+	// NumScoringUsers is the number of users who scored the anime
+	NumScoringUsers int `json:"num_scoring_users"`
+}
+
+// AlternativeTitles holds the English and Japanese titles of an anime, and its synonyms
+type AlternativeTitles struct {
+	Synonyms []string `json:"synonyms"`
+	En       string   `json:"en"`
+	Ja       string   `json:"ja"`
+}
@@ -0,0 +1,165 @@
+package myanimelist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	myanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/model"
+)
+
+const (
+	// searchFields are the fields of the anime returned by a search
+	searchFields = "alternative_titles,start_date,media_type,mean,num_scoring_users"
+	searchLimit  = "10"
+	// minSearchLength is the minimum length of a search, MyAnimeList refusing shorter ones
+	minSearchLength = 3
+)
+
+// mediaTypes maps the media types to the MyAnimeList media types they can be found under
+var mediaTypes = map[string][]string{
+	constant.MediaTypeMovie:  {"movie"},
+	constant.MediaTypeShow:   {"tv", "ona"},
+	constant.MediaTypeSeason: {"tv", "ona"},
+}
+
+// MyAnimeListSearchService looks the anime up on MyAnimeList
+type MyAnimeListSearchService struct {
+	client rating.RatingClient
+	logger *zap.Logger
+}
+
+func NewMyAnimeListSearchService(client rating.RatingClient, logger *zap.Logger) *MyAnimeListSearchService {
+	return &MyAnimeListSearchService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetAnime returns the MyAnimeList anime of the item, searched by its title then by its original title,
+// and matched by type, title, alternative titles and year. Seasons are searched as their show. It returns
+// nil when the item is not an anime known to MyAnimeList
+func (s *MyAnimeListSearchService) GetAnime(ctx context.Context, item model.Item) (*myanimelist.Anime, error) {
+	types, ok := mediaTypes[item.Type]
+	if !ok {
+		s.logger.Debug("media type not supported by MyAnimeList",
+			zap.String("Item ID", item.ID),
+			zap.String("Type", item.Type),
+		)
+		return nil, nil
+	}
+
+	for _, title := range searchTitles(item) {
+		if utf8.RuneCountInString(title) < minSearchLength {
+			continue
+		}
+
+		response, err := s.search(ctx, title)
+		if err != nil {
+			return nil, err
+		}
+
+		if anime := findAnime(response.Data, item, types); anime != nil {
+			return anime, nil
+		}
+	}
+
+	s.logger.Debug("item not found on MyAnimeList",
+		zap.String("Item ID", item.ID),
+		zap.String("Title", item.Title),
+		zap.Int("Year", item.Year),
+	)
+	return nil, nil
+}
+
+// search requests the anime matching title
+func (s *MyAnimeListSearchService) search(ctx context.Context, title string) (*myanimelist.Response, error) {
+	endpoint := *s.client.GetBaseUrl()
+	endpoint.Path += "/anime"
+	endpoint.RawQuery = url.Values{
+		"q":      {title},
+		"limit":  {searchLimit},
+		"fields": {searchFields},
+	}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetAnime"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	response, err := s.client.DoWithRatingResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to MyAnimeList Client",
+			zap.String("method", "GetAnime"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result, ok := response.(*myanimelist.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to MyAnimeList Response",
+			zap.String("method", "GetAnime"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	return result, nil
+}
+
+// searchTitles returns the titles the item is searched by, its original title being often
+// the romanized or native title of an anime
+func searchTitles(item model.Item) []string {
+	titles := []string{item.Title}
+	if item.OriginalTitle != "" && rating.NormalizeTitle(item.OriginalTitle) != rating.NormalizeTitle(item.Title) {
+		titles = append(titles, item.OriginalTitle)
+	}
+	return titles
+}
+
+// findAnime returns the first anime of one of the types having one of the titles of the item,
+// and the same start year when both are known
+func findAnime(results []myanimelist.Result, item model.Item, types []string) *myanimelist.Anime {
+	itemTitles := lo.Map(searchTitles(item), func(title string, _ int) string {
+		return rating.NormalizeTitle(title)
+	})
+
+	for i, result := range results {
+		anime := result.Node
+		if !lo.Contains(types, anime.MediaType) {
+			continue
+		}
+		if year := startYear(anime); item.Year > 0 && year > 0 && item.Year != year {
+			continue
+		}
+
+		titles := append([]string{anime.Title, anime.AlternativeTitles.En, anime.AlternativeTitles.Ja}, anime.AlternativeTitles.Synonyms...)
+		if lo.SomeBy(titles, func(title string) bool {
+			return title != "" && lo.Contains(itemTitles, rating.NormalizeTitle(title))
+		}) {
+			return &results[i].Node
+		}
+	}
+	return nil
+}
+
+// startYear returns the year the anime started airing, zero when unknown
+func startYear(anime myanimelist.Anime) int {
+	year, _, _ := strings.Cut(anime.StartDate, "-")
+	parsed, _ := strconv.Atoi(year)
+	return parsed
+}
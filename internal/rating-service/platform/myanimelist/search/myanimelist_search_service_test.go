@@ -0,0 +1,174 @@
+package myanimelist
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	myAnimeListClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/client"
+	myAnimeListModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/model"
+)
+
+// searchResults are the anime returned by the local server, by search
+var searchResults = map[string]string{
+	"Cowboy Bebop": `{"data": [
+		{"node": {"id": 5, "title": "Cowboy Bebop: Tengoku no Tobira", "alternative_titles": {"en": "Cowboy Bebop: The Movie"}, "start_date": "2001-09-01", "media_type": "movie", "mean": 8.38}},
+		{"node": {"id": 1, "title": "Cowboy Bebop", "alternative_titles": {"en": "Cowboy Bebop", "ja": "カウボーイビバップ"}, "start_date": "1998-04-03", "media_type": "tv", "mean": 8.75}}
+	]}`,
+	"Sen to Chihiro no Kamikakushi": `{"data": [
+		{"node": {"id": 199, "title": "Sen to Chihiro no Kamikakushi", "alternative_titles": {"en": "Spirited Away", "ja": "千と千尋の神隠し"}, "start_date": "2001-07-20", "media_type": "movie", "mean": 8.77}}
+	]}`,
+}
+
+// MyAnimeListSearchServiceTestSuite runs the search service and the MyAnimeList client against a local server
+type MyAnimeListSearchServiceTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []*http.Request
+	service  *MyAnimeListSearchService
+	ctx      context.Context
+}
+
+func TestMyAnimeListSearchServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MyAnimeListSearchServiceTestSuite))
+}
+
+func (s *MyAnimeListSearchServiceTestSuite) SetupTest() {
+	s.requests = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests = append(s.requests, r)
+
+		body, ok := searchResults[r.URL.Query().Get("q")]
+		if !ok {
+			body = `{"data": []}`
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+
+	client, err := myAnimeListClient.NewMyAnimeListClient(
+		&config.MyAnimeList{Enabled: true, ClientID: "test-client-id"},
+		&config.HTTPClient{Timeout: 5 * time.Second},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+
+	s.service = NewMyAnimeListSearchService(client, zap.NewNop())
+	s.ctx = context.Background()
+}
+
+func (s *MyAnimeListSearchServiceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *MyAnimeListSearchServiceTestSuite) TestGetAnime() {
+	// Arrange
+	item := model.Item{ID: "1234", Title: "Cowboy Bebop", Type: constant.MediaTypeSeason, Year: 1998}
+
+	// Act
+	anime, err := s.service.GetAnime(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(anime)
+	s.Equal(1, anime.ID)
+	s.Require().Len(s.requests, 1)
+	s.Equal("/anime", s.requests[0].URL.Path)
+	s.Equal(searchFields, s.requests[0].URL.Query().Get("fields"))
+}
+
+func (s *MyAnimeListSearchServiceTestSuite) TestGetAnime_ByOriginalTitle() {
+	// Arrange
+	item := model.Item{
+		ID:            "1234",
+		Title:         "Spirited Away",
+		OriginalTitle: "Sen to Chihiro no Kamikakushi",
+		Type:          constant.MediaTypeMovie,
+		Year:          2001,
+	}
+
+	// Act
+	anime, err := s.service.GetAnime(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(anime)
+	s.Equal(199, anime.ID)
+	s.Len(s.requests, 2)
+}
+
+func (s *MyAnimeListSearchServiceTestSuite) TestGetAnime_NotFound() {
+	testCases := []struct {
+		name             string
+		item             model.Item
+		expectedRequests int
+	}{
+		{
+			name:             "unknown title",
+			item:             model.Item{ID: "1", Title: "Unknown", Type: constant.MediaTypeMovie},
+			expectedRequests: 1,
+		},
+		{
+			name:             "other year",
+			item:             model.Item{ID: "2", Title: "Cowboy Bebop", Type: constant.MediaTypeShow, Year: 2021},
+			expectedRequests: 1,
+		},
+		{
+			name:             "other type",
+			item:             model.Item{ID: "3", Title: "Cowboy Bebop", Type: constant.MediaTypeMovie, Year: 1998},
+			expectedRequests: 1,
+		},
+		{
+			name: "title too short",
+			item: model.Item{ID: "4", Title: "K", Type: constant.MediaTypeShow},
+		},
+		{
+			name: "episode",
+			item: model.Item{ID: "5", Title: "Asteroid Blues", Type: constant.MediaTypeEpisode},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.requests = nil
+
+			// Act
+			anime, err := s.service.GetAnime(s.ctx, tc.item)
+
+			// Assert
+			s.NoError(err)
+			s.Nil(anime)
+			s.Len(s.requests, tc.expectedRequests)
+		})
+	}
+}
+
+func (s *MyAnimeListSearchServiceTestSuite) TestFindAnime_BySynonymWithUnknownYear() {
+	// Arrange
+	results := []myAnimeListModel.Result{
+		{Node: myAnimeListModel.Anime{ID: 1, Title: "Shingeki no Kyojin", MediaType: "tv", AlternativeTitles: myAnimeListModel.AlternativeTitles{Synonyms: []string{"AoT"}}}},
+		{Node: myAnimeListModel.Anime{ID: 2, Title: "Kimetsu no Yaiba", MediaType: "tv", AlternativeTitles: myAnimeListModel.AlternativeTitles{Synonyms: []string{"Demon Slayer"}}}},
+	}
+	item := model.Item{Title: "Demon Slayer!", Type: constant.MediaTypeShow, Year: 2019}
+
+	// Act
+	result := findAnime(results, item, []string{"tv"})
+
+	// Assert
+	s.Equal(&results[1].Node, result)
+}
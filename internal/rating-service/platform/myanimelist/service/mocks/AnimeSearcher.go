@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package myanimelist_mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+
+	myanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/model"
+)
+
+// AnimeSearcher is an autogenerated mock type for the AnimeSearcher type
+type AnimeSearcher struct {
+	mock.Mock
+}
+
+type AnimeSearcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnimeSearcher) EXPECT() *AnimeSearcher_Expecter {
+	return &AnimeSearcher_Expecter{mock: &_m.Mock}
+}
+
+// GetAnime provides a mock function with given fields: ctx, item
+func (_m *AnimeSearcher) GetAnime(ctx context.Context, item model.Item) (*myanimelist.Anime, error) {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnime")
+	}
+
+	var r0 *myanimelist.Anime
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) (*myanimelist.Anime, error)); ok {
+		return rf(ctx, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) *myanimelist.Anime); ok {
+		r0 = rf(ctx, item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*myanimelist.Anime)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Item) error); ok {
+		r1 = rf(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnimeSearcher_GetAnime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnime'
+type AnimeSearcher_GetAnime_Call struct {
+	*mock.Call
+}
+
+// GetAnime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.Item
+func (_e *AnimeSearcher_Expecter) GetAnime(ctx interface{}, item interface{}) *AnimeSearcher_GetAnime_Call {
+	return &AnimeSearcher_GetAnime_Call{Call: _e.mock.On("GetAnime", ctx, item)}
+}
+
+func (_c *AnimeSearcher_GetAnime_Call) Run(run func(ctx context.Context, item model.Item)) *AnimeSearcher_GetAnime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Item))
+	})
+	return _c
+}
+
+func (_c *AnimeSearcher_GetAnime_Call) Return(_a0 *myanimelist.Anime, _a1 error) *AnimeSearcher_GetAnime_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnimeSearcher_GetAnime_Call) RunAndReturn(run func(context.Context, model.Item) (*myanimelist.Anime, error)) *AnimeSearcher_GetAnime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnimeSearcher creates a new instance of AnimeSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnimeSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnimeSearcher {
+	mock := &AnimeSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,67 @@
+package myanimelist
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	myanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/model"
+)
+
+// AnimeSearcher looks the anime up on MyAnimeList
+type AnimeSearcher interface {
+	GetAnime(ctx context.Context, item model.Item) (*myanimelist.Anime, error)
+}
+
+// MyAnimeListRatingPlatformService provides the MyAnimeList mean score, on a 0-10 scale
+type MyAnimeListRatingPlatformService struct {
+	logger        *zap.Logger
+	animeSearcher AnimeSearcher
+}
+
+func NewMyAnimeListRatingPlatformService(logger *zap.Logger, animeSearcher AnimeSearcher) *MyAnimeListRatingPlatformService {
+	return &MyAnimeListRatingPlatformService{
+		logger:        logger,
+		animeSearcher: animeSearcher,
+	}
+}
+
+func (s *MyAnimeListRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
+	s.logger.Debug("Retrieving MyAnimeList rating..",
+		zap.String("Item ID", item.ID),
+	)
+
+	anime, err := s.animeSearcher.GetAnime(ctx, item)
+	if err != nil {
+		s.logger.Error("unable to get MyAnimeList anime",
+			zap.String("Item ID", item.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if anime == nil || anime.Mean <= 0 {
+		s.logger.Debug("no MyAnimeList rating found",
+			zap.String("Item ID", item.ID),
+		)
+		return nil, nil
+	}
+
+	s.logger.Debug("MyAnimeList rating found",
+		zap.String("Item ID", item.ID),
+		zap.Int("MyAnimeList ID", anime.ID),
+		zap.Float64("Mean", anime.Mean),
+		zap.Int("Votes", anime.NumScoringUsers),
+	)
+
+	return []model.Rating{
+		{
+			Name:   constant.RatingServiceMyAnimeList,
+			Rating: float32(anime.Mean),
+			Type:   model.RatingServiceTypeAudience,
+			Votes:  anime.NumScoringUsers,
+		},
+	}, nil
+}
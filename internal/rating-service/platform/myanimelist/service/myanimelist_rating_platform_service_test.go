@@ -0,0 +1,86 @@
+package myanimelist_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	myAnimeListModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/model"
+	myanimelist "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/service"
+	myanimelist_mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/myanimelist/service/mocks"
+)
+
+type MyAnimeListRatingPlatformServiceTestSuite struct {
+	suite.Suite
+	mockAnimeSearcher *myanimelist_mocks.AnimeSearcher
+	service           *myanimelist.MyAnimeListRatingPlatformService
+	ctx               context.Context
+	item              model.Item
+}
+
+func (s *MyAnimeListRatingPlatformServiceTestSuite) SetupTest() {
+	s.mockAnimeSearcher = myanimelist_mocks.NewAnimeSearcher(s.T())
+	s.service = myanimelist.NewMyAnimeListRatingPlatformService(zap.NewNop(), s.mockAnimeSearcher)
+	s.ctx = context.Background()
+	s.item = model.Item{ID: "1234", Title: "Cowboy Bebop", Type: constant.MediaTypeShow, Year: 1998}
+}
+
+func TestMyAnimeListRatingPlatformServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MyAnimeListRatingPlatformServiceTestSuite))
+}
+
+func (s *MyAnimeListRatingPlatformServiceTestSuite) TestGetRatings() {
+	// Arrange
+	s.mockAnimeSearcher.On("GetAnime", s.ctx, s.item).Return(&myAnimeListModel.Anime{ID: 1, Mean: 8.75, NumScoringUsers: 1003242}, nil).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Rating{
+		{Name: constant.RatingServiceMyAnimeList, Rating: 8.75, Type: model.RatingServiceTypeAudience, Votes: 1003242},
+	}, ratings)
+}
+
+func (s *MyAnimeListRatingPlatformServiceTestSuite) TestGetRatings_NoRating() {
+	testCases := []struct {
+		name  string
+		anime *myAnimeListModel.Anime
+	}{
+		{name: "not on MyAnimeList", anime: nil},
+		{name: "not enough scores", anime: &myAnimeListModel.Anime{ID: 1}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockAnimeSearcher.On("GetAnime", s.ctx, s.item).Return(tc.anime, nil).Once()
+
+			// Act
+			ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+			// Assert
+			s.NoError(err)
+			s.Empty(ratings)
+		})
+	}
+}
+
+func (s *MyAnimeListRatingPlatformServiceTestSuite) TestGetRatings_Error() {
+	// Arrange
+	expectedErr := errors.New("myanimelist unavailable")
+	s.mockAnimeSearcher.On("GetAnime", s.ctx, s.item).Return(nil, expectedErr).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.Equal(expectedErr, err)
+	s.Empty(ratings)
+}
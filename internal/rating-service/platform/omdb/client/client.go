@@ -0,0 +1,138 @@
+package omdb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	omdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/model"
+)
+
+// OMDbClient implements the RatingClient interface
+type OMDbClient struct {
+	httpClient common.ServiceHTTPClient
+	apiKey     string
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewOMDbClient creates a new OMDb client
+func NewOMDbClient(clientConfig *config.OMDb, httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*OMDbClient, error) {
+	if clientConfig.ApiKey == "" {
+		logger.Error("omdb.api_key is required")
+		return nil, errors.New("omdb.api_key is required")
+	}
+
+	baseUrl := url.URL{
+		Scheme: "https",
+		Host:   "www.omdbapi.com",
+		Path:   "/",
+	}
+
+	httpClient := NewOMDbHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		return nil, err
+	}
+
+	return &OMDbClient{
+		httpClient: httpClient,
+		apiKey:     clientConfig.ApiKey,
+		baseUrl:    baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+func (c *OMDbClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	c.setupRequest(request)
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("unable to perform request to OMDb API",
+			zap.String("url", request.URL.Redacted()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithRatingResponse performs a request and returns a parsed OMDb response
+func (c *OMDbClient) DoWithRatingResponse(request *http.Request) (rating.RatingResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseOMDbResponse(resp)
+}
+
+// setupRequest configures the request with common headers and authentication
+func (c *OMDbClient) setupRequest(request *http.Request) {
+	request.Header.Set("Accept", "application/json")
+
+	// Add OMDb api key to every request
+	q := request.URL.Query()
+	q.Set("apikey", c.apiKey)
+	request.URL.RawQuery = q.Encode()
+}
+
+// parseOMDbResponse handles the OMDb API response parsing
+func (c *OMDbClient) parseOMDbResponse(resp *http.Response) (*omdb.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		err := errors.New(model.NotAuthorized)
+		c.logger.Error(
+			"your OMDb Api key is invalid, expired or out of daily requests, please use a valid Api key",
+			zap.Error(err),
+		)
+		return nil, err
+	case http.StatusNotFound:
+		err := errors.New(model.NotFound)
+		c.logger.Error(
+			"cannot find the resource, please check the query",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected OMDb API status code %d", resp.StatusCode)
+	}
+
+	var OMDbResponse omdb.Response
+	err := json.NewDecoder(resp.Body).Decode(&OMDbResponse)
+	if err != nil {
+		c.logger.Error("unable to decode OMDb API response",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &OMDbResponse, nil
+}
+
+func (c *OMDbClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient with the provided one
+// Method used primarily for testing
+func (c *OMDbClient) SetHttpClient(client common.ServiceHTTPClient) {
+	c.httpClient = client
+}
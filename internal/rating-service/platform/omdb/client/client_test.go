@@ -0,0 +1,177 @@
+package omdb_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	httpClientMocks "github.com/zepollabot/media-rating-overlay/internal/httpclient/mocks"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	omdbClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/client"
+	omdbModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/model"
+)
+
+type OMDbClientTestSuite struct {
+	suite.Suite
+	mockHTTPClient *httpClientMocks.ServiceHTTPClient
+	client         *omdbClient.OMDbClient
+}
+
+func (s *OMDbClientTestSuite) SetupTest() {
+	s.mockHTTPClient = httpClientMocks.NewServiceHTTPClient(s.T())
+
+	client, err := omdbClient.NewOMDbClient(
+		&config.OMDb{Enabled: true, ApiKey: "test-api-key"},
+		&config.HTTPClient{Timeout: 5, MaxRetries: 3},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+	client.SetHttpClient(s.mockHTTPClient)
+	s.client = client
+}
+
+func TestOMDbClientTestSuite(t *testing.T) {
+	suite.Run(t, new(OMDbClientTestSuite))
+}
+
+func (s *OMDbClientTestSuite) newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func (s *OMDbClientTestSuite) newRequest() *http.Request {
+	req, err := http.NewRequest(http.MethodGet, s.client.GetBaseUrl().String()+"?i=tt0133093", nil)
+	s.Require().NoError(err)
+	return req
+}
+
+func (s *OMDbClientTestSuite) TestNewOMDbClient_MissingApiKey() {
+	// Act
+	client, err := omdbClient.NewOMDbClient(&config.OMDb{Enabled: true}, &config.HTTPClient{}, os.DevNull, zap.NewNop())
+
+	// Assert
+	s.EqualError(err, "omdb.api_key is required")
+	s.Nil(client)
+}
+
+func (s *OMDbClientTestSuite) TestGetBaseUrl() {
+	// Act
+	baseUrl := s.client.GetBaseUrl()
+
+	// Assert
+	s.Equal("https://www.omdbapi.com/", baseUrl.String())
+}
+
+func (s *OMDbClientTestSuite) TestDoWithResponse_AddsApiKey() {
+	// Arrange
+	var capturedRequest *http.Request
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Run(func(args mock.Arguments) {
+			capturedRequest = args.Get(0).(*http.Request)
+		}).
+		Return(s.newResponse(http.StatusOK, `{}`), nil).
+		Once()
+
+	// Act
+	resp, err := s.client.DoWithResponse(s.newRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("test-api-key", capturedRequest.URL.Query().Get("apikey"))
+	s.Equal("tt0133093", capturedRequest.URL.Query().Get("i"))
+	s.Equal("application/json", capturedRequest.Header.Get("Accept"))
+}
+
+func (s *OMDbClientTestSuite) TestDoWithResponse_ClientError() {
+	// Arrange
+	expectedError := errors.New("network error")
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expectedError).Once()
+
+	// Act
+	resp, err := s.client.DoWithResponse(s.newRequest())
+
+	// Assert
+	s.ErrorIs(err, expectedError)
+	s.Nil(resp)
+}
+
+func (s *OMDbClientTestSuite) TestDoWithRatingResponse_Success() {
+	// Arrange
+	body := `{"Title":"The Matrix","Year":"1999","imdbRating":"8.7","Metascore":"73","imdbID":"tt0133093","Type":"movie","Response":"True",` +
+		`"Ratings":[{"Source":"Internet Movie Database","Value":"8.7/10"},{"Source":"Rotten Tomatoes","Value":"83%"},{"Source":"Metacritic","Value":"73/100"}]}`
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(s.newResponse(http.StatusOK, body), nil).Once()
+
+	// Act
+	ratingResp, err := s.client.DoWithRatingResponse(s.newRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	parsedResp, ok := ratingResp.(*omdbModel.Response)
+	s.Require().True(ok, "Response should be of type *omdbModel.Response")
+	s.True(parsedResp.Found())
+	s.Equal("tt0133093", parsedResp.ImdbID)
+	s.Equal("8.7", parsedResp.ImdbRating)
+	s.Equal("73", parsedResp.Metascore)
+	s.Equal([]omdbModel.Rating{
+		{Source: "Internet Movie Database", Value: "8.7/10"},
+		{Source: "Rotten Tomatoes", Value: "83%"},
+		{Source: "Metacritic", Value: "73/100"},
+	}, parsedResp.Ratings)
+}
+
+func (s *OMDbClientTestSuite) TestDoWithRatingResponse_NotFound() {
+	// Arrange: OMDb answers the unknown titles with a 200
+	s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(s.newResponse(http.StatusOK, `{"Response":"False","Error":"Incorrect IMDb ID."}`), nil).
+		Once()
+
+	// Act
+	ratingResp, err := s.client.DoWithRatingResponse(s.newRequest())
+
+	// Assert
+	s.Require().NoError(err)
+	parsedResp := ratingResp.(*omdbModel.Response)
+	s.False(parsedResp.Found())
+	s.Equal("Incorrect IMDb ID.", parsedResp.Error)
+}
+
+func (s *OMDbClientTestSuite) TestDoWithRatingResponse_Errors() {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedError string
+	}{
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, body: `{"Response":"False","Error":"Invalid API key!"}`, expectedError: model.NotAuthorized},
+		{name: "not found", statusCode: http.StatusNotFound, body: ``, expectedError: model.NotFound},
+		{name: "server error", statusCode: http.StatusServiceUnavailable, body: ``, expectedError: "unexpected OMDb API status code 503"},
+		{name: "invalid JSON", statusCode: http.StatusOK, body: `this is not json`, expectedError: "invalid character 'h' in literal true"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).Return(s.newResponse(tc.statusCode, tc.body), nil).Once()
+
+			// Act
+			ratingResp, err := s.client.DoWithRatingResponse(s.newRequest())
+
+			// Assert
+			s.ErrorContains(err, tc.expectedError)
+			s.Nil(ratingResp)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package omdb
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// OMDbHTTPClient implements the HTTPClient interface for OMDb
+type OMDbHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewOMDbHTTPClient creates a new OMDb HTTP client
+func NewOMDbHTTPClient(timeout time.Duration, maxRetries int) *OMDbHTTPClient {
+	return &OMDbHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *OMDbHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
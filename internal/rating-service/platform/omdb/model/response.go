@@ -0,0 +1,27 @@
+package omdb
+
+// Response is the OMDb title response. Every value is a string, "N/A" when unknown
+type Response struct {
+	// Response is "False" when the title is not found, Error telling why
+	Response   string   `json:"Response"`
+	Error      string   `json:"Error"`
+	Title      string   `json:"Title"`
+	Year       string   `json:"Year"`
+	Type       string   `json:"Type"`
+	ImdbID     string   `json:"imdbID"`
+	ImdbRating string   `json:"imdbRating"`
+	ImdbVotes  string   `json:"imdbVotes"`
+	Metascore  string   `json:"Metascore"`
+	Ratings    []Rating `json:"Ratings"`
+}
+
+// Rating is a rating of the title on another platform, e.g. "83%" on Rotten Tomatoes
+type Rating struct {
+	Source string `json:"Source"`
+	Value  string `json:"Value"`
+}
+
+// Found reports whether OMDb knows the title
+func (r *Response) Found() bool {
+	return r.Response == "True"
+}
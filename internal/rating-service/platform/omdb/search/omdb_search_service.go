@@ -0,0 +1,124 @@
+package omdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	omdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/model"
+)
+
+// maxCachedTitles bounds the titles kept in memory, the cache is emptied once reached
+const maxCachedTitles = 1000
+
+// OMDbSearchService looks the items up on OMDb. A single OMDb response holds the IMDb, Rotten
+// Tomatoes and Metacritic ratings, so the responses are cached by item: the rating platform
+// services of these ratings share one request per item
+type OMDbSearchService struct {
+	client rating.RatingClient
+	logger *zap.Logger
+	mu     sync.Mutex
+	cache  map[string]*omdb.Response
+}
+
+func NewOMDbSearchService(client rating.RatingClient, logger *zap.Logger) *OMDbSearchService {
+	return &OMDbSearchService{
+		client: client,
+		logger: logger,
+		cache:  make(map[string]*omdb.Response),
+	}
+}
+
+// GetTitle returns the OMDb title of the item, looked up by its IMDb ID when known, otherwise
+// by title and year. The response is not Found() when OMDb does not know the item
+func (s *OMDbSearchService) GetTitle(ctx context.Context, item model.Item) (*omdb.Response, error) {
+	cacheKey := item.ID + "|" + item.ExternalIDs.IMDB
+	s.mu.Lock()
+	response, found := s.cache[cacheKey]
+	s.mu.Unlock()
+	if found {
+		return response, nil
+	}
+
+	response, err := s.getResponse(ctx, s.buildQuery(item))
+	if err != nil {
+		return nil, err
+	}
+
+	if !response.Found() {
+		s.logger.Debug("item not found on OMDb",
+			zap.String("Item ID", item.ID),
+			zap.String("error", response.Error),
+		)
+	}
+
+	s.mu.Lock()
+	if len(s.cache) >= maxCachedTitles {
+		s.cache = make(map[string]*omdb.Response)
+	}
+	s.cache[cacheKey] = response
+	s.mu.Unlock()
+
+	return response, nil
+}
+
+func (s *OMDbSearchService) buildQuery(item model.Item) map[string]string {
+	if item.ExternalIDs.IMDB != "" {
+		return map[string]string{"i": item.ExternalIDs.IMDB}
+	}
+
+	query := map[string]string{"t": item.Title, "type": "movie"}
+	if item.Type == constant.MediaTypeShow || item.Type == constant.MediaTypeSeason {
+		query["type"] = "series"
+	}
+	if item.Year > 0 {
+		query["y"] = strconv.Itoa(item.Year)
+	}
+
+	return query
+}
+
+func (s *OMDbSearchService) getResponse(ctx context.Context, query map[string]string) (*omdb.Response, error) {
+	endpoint := s.client.GetBaseUrl()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetTitle"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	for name, value := range query {
+		q.Set(name, value)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	response, err := s.client.DoWithRatingResponse(req)
+	if err != nil {
+		s.logger.Error("unable to perform request to OMDb Client",
+			zap.String("method", "GetTitle"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result, ok := response.(*omdb.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to OMDb Response",
+			zap.String("method", "GetTitle"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,151 @@
+package omdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	ratingclientmock "github.com/zepollabot/media-rating-overlay/internal/rating-service/mocks"
+	omdbmodel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/model"
+)
+
+type OMDbSearchServiceTestSuite struct {
+	suite.Suite
+	mockClient *ratingclientmock.RatingClient
+	service    *OMDbSearchService
+	ctx        context.Context
+}
+
+func TestOMDbSearchServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OMDbSearchServiceTestSuite))
+}
+
+func (s *OMDbSearchServiceTestSuite) SetupTest() {
+	s.mockClient = ratingclientmock.NewRatingClient(s.T())
+	s.service = NewOMDbSearchService(s.mockClient, zap.NewNop())
+	s.ctx = context.Background()
+
+	baseURL, _ := url.Parse("https://www.omdbapi.com/")
+	s.mockClient.On("GetBaseUrl").Return(baseURL).Maybe()
+}
+
+// requestWithQuery matches a GET request with exactly the given query parameters
+func requestWithQuery(expected url.Values) interface{} {
+	return mock.MatchedBy(func(req *http.Request) bool {
+		query := req.URL.Query()
+		if req.Method != http.MethodGet || len(query) != len(expected) {
+			return false
+		}
+		for name := range expected {
+			if query.Get(name) != expected.Get(name) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (s *OMDbSearchServiceTestSuite) TestGetTitle_ByIMDbID() {
+	// Arrange
+	item := model.Item{ID: "1234", Title: "The Matrix", Year: 1999, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+	expected := &omdbmodel.Response{Response: "True", ImdbID: "tt0133093", ImdbRating: "8.7"}
+	s.mockClient.On("DoWithRatingResponse", requestWithQuery(url.Values{"i": {"tt0133093"}})).Return(expected, nil).Once()
+
+	// Act
+	response, err := s.service.GetTitle(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(expected, response)
+}
+
+func (s *OMDbSearchServiceTestSuite) TestGetTitle_ByTitleAndYear() {
+	testCases := []struct {
+		name          string
+		item          model.Item
+		expectedQuery url.Values
+	}{
+		{
+			name:          "movie",
+			item:          model.Item{ID: "1", Title: "Alien", Year: 1979, Type: constant.MediaTypeMovie},
+			expectedQuery: url.Values{"t": {"Alien"}, "y": {"1979"}, "type": {"movie"}},
+		},
+		{
+			name:          "show",
+			item:          model.Item{ID: "2", Title: "The Show", Year: 2020, Type: constant.MediaTypeShow},
+			expectedQuery: url.Values{"t": {"The Show"}, "y": {"2020"}, "type": {"series"}},
+		},
+		{
+			name:          "no year",
+			item:          model.Item{ID: "3", Title: "Alien", Type: constant.MediaTypeMovie},
+			expectedQuery: url.Values{"t": {"Alien"}, "type": {"movie"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			expected := &omdbmodel.Response{Response: "True", Title: tc.item.Title}
+			s.mockClient.On("DoWithRatingResponse", requestWithQuery(tc.expectedQuery)).Return(expected, nil).Once()
+
+			// Act
+			response, err := s.service.GetTitle(s.ctx, tc.item)
+
+			// Assert
+			s.NoError(err)
+			s.Equal(expected, response)
+		})
+	}
+}
+
+func (s *OMDbSearchServiceTestSuite) TestGetTitle_Cached() {
+	// Arrange: the IMDb, Rotten Tomatoes and Metacritic services ask for the same item
+	item := model.Item{ID: "1234", ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+	expected := &omdbmodel.Response{Response: "False", Error: "Incorrect IMDb ID."}
+	s.mockClient.On("DoWithRatingResponse", mock.Anything).Return(expected, nil).Once()
+
+	// Act
+	for range 3 {
+		response, err := s.service.GetTitle(s.ctx, item)
+
+		// Assert
+		s.NoError(err)
+		s.Equal(expected, response)
+	}
+}
+
+func (s *OMDbSearchServiceTestSuite) TestGetTitle_ErrorNotCached() {
+	// Arrange
+	item := model.Item{ID: "1234", ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+	expectedErr := errors.New(model.NotAuthorized)
+	s.mockClient.On("DoWithRatingResponse", mock.Anything).Return(nil, expectedErr).Twice()
+
+	// Act
+	_, firstErr := s.service.GetTitle(s.ctx, item)
+	_, secondErr := s.service.GetTitle(s.ctx, item)
+
+	// Assert
+	s.ErrorIs(firstErr, expectedErr)
+	s.ErrorIs(secondErr, expectedErr)
+}
+
+func (s *OMDbSearchServiceTestSuite) TestGetTitle_InvalidResponseType() {
+	// Arrange
+	item := model.Item{ID: "1234", ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+	s.mockClient.On("DoWithRatingResponse", mock.Anything).Return(&struct{}{}, nil).Once()
+
+	// Act
+	response, err := s.service.GetTitle(s.ctx, item)
+
+	// Assert
+	s.EqualError(err, "invalid response type")
+	s.Nil(response)
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package omdb_mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+
+	omdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/model"
+)
+
+// TitleSearcher is an autogenerated mock type for the TitleSearcher type
+type TitleSearcher struct {
+	mock.Mock
+}
+
+type TitleSearcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TitleSearcher) EXPECT() *TitleSearcher_Expecter {
+	return &TitleSearcher_Expecter{mock: &_m.Mock}
+}
+
+// GetTitle provides a mock function with given fields: ctx, item
+func (_m *TitleSearcher) GetTitle(ctx context.Context, item model.Item) (*omdb.Response, error) {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTitle")
+	}
+
+	var r0 *omdb.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) (*omdb.Response, error)); ok {
+		return rf(ctx, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) *omdb.Response); ok {
+		r0 = rf(ctx, item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*omdb.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Item) error); ok {
+		r1 = rf(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TitleSearcher_GetTitle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTitle'
+type TitleSearcher_GetTitle_Call struct {
+	*mock.Call
+}
+
+// GetTitle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.Item
+func (_e *TitleSearcher_Expecter) GetTitle(ctx interface{}, item interface{}) *TitleSearcher_GetTitle_Call {
+	return &TitleSearcher_GetTitle_Call{Call: _e.mock.On("GetTitle", ctx, item)}
+}
+
+func (_c *TitleSearcher_GetTitle_Call) Run(run func(ctx context.Context, item model.Item)) *TitleSearcher_GetTitle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Item))
+	})
+	return _c
+}
+
+func (_c *TitleSearcher_GetTitle_Call) Return(_a0 *omdb.Response, _a1 error) *TitleSearcher_GetTitle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TitleSearcher_GetTitle_Call) RunAndReturn(run func(context.Context, model.Item) (*omdb.Response, error)) *TitleSearcher_GetTitle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTitleSearcher creates a new instance of TitleSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTitleSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TitleSearcher {
+	mock := &TitleSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,139 @@
+package omdb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	omdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/model"
+)
+
+// notAvailable is the OMDb value of the unknown fields
+const notAvailable = "N/A"
+
+// TitleSearcher looks the items up on OMDb
+type TitleSearcher interface {
+	GetTitle(ctx context.Context, item model.Item) (*omdb.Response, error)
+}
+
+// OMDbRatingPlatformService provides one of the ratings found on OMDb: the IMDb audience rating,
+// the Rotten Tomatoes critic rating or the Metascore, on a 0-10 scale
+type OMDbRatingPlatformService struct {
+	logger        *zap.Logger
+	titleSearcher TitleSearcher
+	ratingName    string
+}
+
+// NewOMDbRatingPlatformService creates a service providing the rating of ratingName, one of
+// constant.RatingServiceIMDB, constant.RatingServiceRottenTomatoes and constant.RatingServiceMetacritic
+func NewOMDbRatingPlatformService(logger *zap.Logger, titleSearcher TitleSearcher, ratingName string) (*OMDbRatingPlatformService, error) {
+	switch ratingName {
+	case constant.RatingServiceIMDB, constant.RatingServiceRottenTomatoes, constant.RatingServiceMetacritic:
+	default:
+		return nil, fmt.Errorf("rating %s is not provided by OMDb", ratingName)
+	}
+
+	return &OMDbRatingPlatformService{
+		logger:        logger,
+		titleSearcher: titleSearcher,
+		ratingName:    ratingName,
+	}, nil
+}
+
+func (s *OMDbRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
+	s.logger.Debug("Retrieving OMDb rating..",
+		zap.String("Item ID", item.ID),
+		zap.String("Rating", s.ratingName),
+	)
+
+	response, err := s.titleSearcher.GetTitle(ctx, item)
+	if err != nil {
+		s.logger.Error("unable to get OMDb title",
+			zap.String("Item ID", item.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if !response.Found() {
+		return nil, nil
+	}
+
+	var value float32
+	var ratingType string
+	var votes int
+	switch s.ratingName {
+	case constant.RatingServiceIMDB:
+		value, ratingType = parseScore(response.ImdbRating, 1), model.RatingServiceTypeAudience
+		votes = parseVotes(response.ImdbVotes)
+	case constant.RatingServiceRottenTomatoes:
+		value, ratingType = parseScore(s.findRating(response, "Rotten Tomatoes"), 10), model.RatingServiceTypeCritic
+	case constant.RatingServiceMetacritic:
+		value, ratingType = parseScore(response.Metascore, 10), model.RatingServiceTypeCritic
+	}
+
+	if value <= 0 {
+		s.logger.Debug("no OMDb rating found",
+			zap.String("Item ID", item.ID),
+			zap.String("Rating", s.ratingName),
+		)
+		return nil, nil
+	}
+
+	s.logger.Debug("OMDb rating found",
+		zap.String("Item ID", item.ID),
+		zap.String("Rating", s.ratingName),
+		zap.Float32("Value", value),
+	)
+
+	return []model.Rating{
+		{
+			Name:   s.ratingName,
+			Rating: value,
+			Type:   ratingType,
+			Votes:  votes,
+		},
+	}, nil
+}
+
+func (s *OMDbRatingPlatformService) findRating(response *omdb.Response, source string) string {
+	for _, rating := range response.Ratings {
+		if rating.Source == source {
+			return rating.Value
+		}
+	}
+	return notAvailable
+}
+
+// parseScore converts an OMDb value ("7.8", "83%", "73/100", "N/A") to the 0-10 scale, divisor
+// being the ratio between the scale of the value and it. It returns 0 when the value is unknown
+func parseScore(value string, divisor float64) float32 {
+	value = strings.TrimSpace(value)
+	if value == "" || value == notAvailable {
+		return 0
+	}
+
+	value = strings.TrimSuffix(value, "%")
+	value, _, _ = strings.Cut(value, "/")
+
+	score, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+
+	return float32(score / divisor)
+}
+
+// parseVotes converts an OMDb number of votes ("1,234,567", "N/A"). It returns 0 when the number is unknown
+func parseVotes(value string) int {
+	votes, err := strconv.Atoi(strings.ReplaceAll(strings.TrimSpace(value), ",", ""))
+	if err != nil {
+		return 0
+	}
+	return votes
+}
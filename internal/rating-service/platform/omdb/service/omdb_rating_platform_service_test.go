@@ -0,0 +1,166 @@
+package omdb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	omdbModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/model"
+	omdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/service"
+	omdb_mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/omdb/service/mocks"
+)
+
+type OMDbRatingPlatformServiceTestSuite struct {
+	suite.Suite
+	mockTitleSearcher *omdb_mocks.TitleSearcher
+	logger            *zap.Logger
+	ctx               context.Context
+	item              model.Item
+}
+
+func (s *OMDbRatingPlatformServiceTestSuite) SetupTest() {
+	s.logger = zap.NewNop()
+	s.mockTitleSearcher = omdb_mocks.NewTitleSearcher(s.T())
+	s.ctx = context.Background()
+	s.item = model.Item{ID: "1234", Title: "The Matrix", Year: 1999, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+}
+
+func TestOMDbRatingPlatformServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OMDbRatingPlatformServiceTestSuite))
+}
+
+func (s *OMDbRatingPlatformServiceTestSuite) newService(ratingName string) *omdb.OMDbRatingPlatformService {
+	service, err := omdb.NewOMDbRatingPlatformService(s.logger, s.mockTitleSearcher, ratingName)
+	s.Require().NoError(err)
+	return service
+}
+
+func (s *OMDbRatingPlatformServiceTestSuite) TestGetRating() {
+	response := &omdbModel.Response{
+		Response:   "True",
+		Title:      "The Matrix",
+		ImdbRating: "8.7",
+		ImdbVotes:  "2,134,567",
+		Metascore:  "73",
+		Ratings: []omdbModel.Rating{
+			{Source: "Internet Movie Database", Value: "8.7/10"},
+			{Source: "Rotten Tomatoes", Value: "83%"},
+			{Source: "Metacritic", Value: "73/100"},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		ratingName     string
+		expectedRating model.Rating
+	}{
+		{
+			name:           "IMDb audience rating",
+			ratingName:     constant.RatingServiceIMDB,
+			expectedRating: model.Rating{Name: constant.RatingServiceIMDB, Rating: 8.7, Type: model.RatingServiceTypeAudience, Votes: 2134567},
+		},
+		{
+			name:           "Rotten Tomatoes critic rating",
+			ratingName:     constant.RatingServiceRottenTomatoes,
+			expectedRating: model.Rating{Name: constant.RatingServiceRottenTomatoes, Rating: 8.3, Type: model.RatingServiceTypeCritic},
+		},
+		{
+			name:           "Metascore",
+			ratingName:     constant.RatingServiceMetacritic,
+			expectedRating: model.Rating{Name: constant.RatingServiceMetacritic, Rating: 7.3, Type: model.RatingServiceTypeCritic},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockTitleSearcher.On("GetTitle", s.ctx, s.item).Return(response, nil).Once()
+
+			// Act
+			ratings, err := s.newService(tc.ratingName).GetRatings(s.ctx, s.item)
+
+			// Assert
+			s.NoError(err)
+			s.Require().Len(ratings, 1)
+			rating := ratings[0]
+			s.Equal(tc.expectedRating.Name, rating.Name)
+			s.InDelta(tc.expectedRating.Rating, rating.Rating, 0.001)
+			s.Equal(tc.expectedRating.Type, rating.Type)
+		})
+	}
+}
+
+func (s *OMDbRatingPlatformServiceTestSuite) TestGetRatings_NotAvailable() {
+	response := &omdbModel.Response{
+		Response:   "True",
+		ImdbRating: "N/A",
+		Metascore:  "N/A",
+		Ratings:    []omdbModel.Rating{},
+	}
+
+	for _, ratingName := range []string{constant.RatingServiceIMDB, constant.RatingServiceRottenTomatoes, constant.RatingServiceMetacritic} {
+		s.Run(ratingName, func() {
+			// Arrange
+			s.mockTitleSearcher.On("GetTitle", s.ctx, s.item).Return(response, nil).Once()
+
+			// Act
+			ratings, err := s.newService(ratingName).GetRatings(s.ctx, s.item)
+
+			// Assert
+			s.NoError(err)
+			s.Empty(ratings)
+		})
+	}
+}
+
+func (s *OMDbRatingPlatformServiceTestSuite) TestGetRatings_UnknownVotes() {
+	// Arrange
+	response := &omdbModel.Response{Response: "True", Title: "The Matrix", ImdbRating: "8.7", ImdbVotes: "N/A"}
+	s.mockTitleSearcher.On("GetTitle", s.ctx, s.item).Return(response, nil).Once()
+
+	// Act
+	ratings, err := s.newService(constant.RatingServiceIMDB).GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Rating{{Name: constant.RatingServiceIMDB, Rating: 8.7, Type: model.RatingServiceTypeAudience}}, ratings)
+}
+
+func (s *OMDbRatingPlatformServiceTestSuite) TestGetRatings_NotFound() {
+	// Arrange
+	s.mockTitleSearcher.On("GetTitle", s.ctx, s.item).Return(&omdbModel.Response{Response: "False", Error: "Incorrect IMDb ID."}, nil).Once()
+
+	// Act
+	ratings, err := s.newService(constant.RatingServiceIMDB).GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(ratings)
+}
+
+func (s *OMDbRatingPlatformServiceTestSuite) TestGetRatings_SearchError() {
+	// Arrange
+	expectedErr := errors.New("request failed")
+	s.mockTitleSearcher.On("GetTitle", s.ctx, s.item).Return(nil, expectedErr).Once()
+
+	// Act
+	ratings, err := s.newService(constant.RatingServiceIMDB).GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.ErrorIs(err, expectedErr)
+	s.Empty(ratings)
+}
+
+func (s *OMDbRatingPlatformServiceTestSuite) TestNewOMDbRatingPlatformService_UnsupportedRating() {
+	// Act
+	service, err := omdb.NewOMDbRatingPlatformService(s.logger, s.mockTitleSearcher, constant.RatingServiceTMDB)
+
+	// Assert
+	s.EqualError(err, "rating TMDB is not provided by OMDb")
+	s.Nil(service)
+}
@@ -0,0 +1,215 @@
+package rotten_tomatoes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	rottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/model"
+)
+
+// maxPageSize bounds the pages read, far above their actual size
+const maxPageSize = 4 << 20
+
+var (
+	scorecardStart = []byte(`<script id="media-scorecard-json"`)
+	scorecardEnd   = []byte(`</script>`)
+
+	searchResultPattern = regexp.MustCompile(`(?s)<search-page-result([^>]*)>(.*?)</search-page-result>`)
+	mediaRowPattern     = regexp.MustCompile(`(?s)<search-page-media-row([^>]*)>(.*?)</search-page-media-row>`)
+	nameLinkPattern     = regexp.MustCompile(`(?s)<a([^>]*data-qa="info-name"[^>]*)>(.*?)</a>`)
+	attributePattern    = regexp.MustCompile(`([a-z-]+)="([^"]*)"`)
+)
+
+// RottenTomatoesClient implements the RatingClient interface. Rotten Tomatoes has no public API, the
+// titles are looked up on the search page and the scores read from the scorecard of their pages
+type RottenTomatoesClient struct {
+	httpClient common.ServiceHTTPClient
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewRottenTomatoesClient creates a new Rotten Tomatoes client
+func NewRottenTomatoesClient(httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*RottenTomatoesClient, error) {
+	baseUrl := url.URL{
+		Scheme: "https",
+		Host:   "www.rottentomatoes.com",
+	}
+
+	httpClient := NewRottenTomatoesHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		return nil, err
+	}
+
+	return &RottenTomatoesClient{
+		httpClient: httpClient,
+		baseUrl:    baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+func (c *RottenTomatoesClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	c.setupRequest(request)
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("unable to perform request to Rotten Tomatoes",
+			zap.String("url", request.URL.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithRatingResponse performs a request and returns the parsed content of the page: the scorecard
+// of a title page, the results of any other page
+func (c *RottenTomatoesClient) DoWithRatingResponse(request *http.Request) (rating.RatingResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseRottenTomatoesResponse(resp)
+}
+
+// setupRequest configures the request with common headers
+func (c *RottenTomatoesClient) setupRequest(request *http.Request) {
+	request.Header.Set("Accept", "text/html")
+}
+
+// parseRottenTomatoesResponse handles the Rotten Tomatoes page parsing
+func (c *RottenTomatoesClient) parseRottenTomatoesResponse(resp *http.Response) (*rottenTomatoes.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.New(model.NotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected Rotten Tomatoes status code %d", resp.StatusCode)
+	}
+
+	page, err := io.ReadAll(io.LimitReader(resp.Body, maxPageSize))
+	if err != nil {
+		c.logger.Error("unable to read Rotten Tomatoes page", zap.Error(err))
+		return nil, err
+	}
+
+	if !bytes.Contains(page, scorecardStart) {
+		return &rottenTomatoes.Response{Results: parseSearchResults(page)}, nil
+	}
+
+	data, err := extractScorecard(page)
+	if err != nil {
+		c.logger.Error("unable to find the Rotten Tomatoes scorecard",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var scorecard rottenTomatoes.Scorecard
+	if err := json.Unmarshal(data, &scorecard); err != nil {
+		c.logger.Error("unable to decode Rotten Tomatoes scorecard",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &rottenTomatoes.Response{Scorecard: &scorecard}, nil
+}
+
+// extractScorecard returns the JSON content of the scorecard script of the page
+func extractScorecard(page []byte) ([]byte, error) {
+	_, data, _ := bytes.Cut(page, scorecardStart)
+
+	_, data, found := bytes.Cut(data, []byte(">"))
+	if !found {
+		return nil, errors.New("malformed scorecard in the page")
+	}
+
+	data, _, found = bytes.Cut(data, scorecardEnd)
+	if !found {
+		return nil, errors.New("unterminated scorecard in the page")
+	}
+
+	return bytes.TrimSpace(data), nil
+}
+
+// parseSearchResults returns the titles listed by a search page, in their order on the page
+func parseSearchResults(page []byte) []rottenTomatoes.SearchResult {
+	results := make([]rottenTomatoes.SearchResult, 0)
+
+	for _, section := range searchResultPattern.FindAllSubmatch(page, -1) {
+		resultType := parseAttributes(section[1])["type"]
+
+		for _, row := range mediaRowPattern.FindAllSubmatch(section[2], -1) {
+			link := nameLinkPattern.FindSubmatch(row[2])
+			if link == nil {
+				continue
+			}
+
+			rowAttributes := parseAttributes(row[1])
+			year := rowAttributes["releaseyear"]
+			if year == "" {
+				year = rowAttributes["startyear"]
+			}
+			// Titles without a known year are kept, with a zero year
+			parsedYear, _ := strconv.Atoi(year)
+
+			results = append(results, rottenTomatoes.SearchResult{
+				Title: html.UnescapeString(strings.TrimSpace(string(link[2]))),
+				Type:  resultType,
+				Year:  parsedYear,
+				URL:   html.UnescapeString(parseAttributes(link[1])["href"]),
+			})
+		}
+	}
+
+	return results
+}
+
+// parseAttributes returns the attributes of an HTML tag, by name
+func parseAttributes(tag []byte) map[string]string {
+	attributes := make(map[string]string)
+	for _, attribute := range attributePattern.FindAllSubmatch(tag, -1) {
+		attributes[string(attribute[1])] = string(attribute[2])
+	}
+	return attributes
+}
+
+func (c *RottenTomatoesClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient with the provided one
+// Method used primarily for testing
+func (c *RottenTomatoesClient) SetHttpClient(client common.ServiceHTTPClient) {
+	c.httpClient = client
+}
+
+// SetBaseUrl replaces the Rotten Tomatoes base URL with the provided one
+// Method used primarily for testing, against a local server
+func (c *RottenTomatoesClient) SetBaseUrl(baseUrl url.URL) {
+	c.baseUrl = baseUrl
+}
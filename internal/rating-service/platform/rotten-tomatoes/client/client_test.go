@@ -0,0 +1,149 @@
+package rotten_tomatoes_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rottenTomatoesClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/client"
+	rottenTomatoesModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/model"
+)
+
+type RottenTomatoesClientTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	handler http.HandlerFunc
+	client  *rottenTomatoesClient.RottenTomatoesClient
+}
+
+func (s *RottenTomatoesClientTestSuite) SetupTest() {
+	s.handler = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+
+	client, err := rottenTomatoesClient.NewRottenTomatoesClient(
+		&config.HTTPClient{Timeout: 5 * time.Second},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+	s.client = client
+}
+
+func (s *RottenTomatoesClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestRottenTomatoesClientTestSuite(t *testing.T) {
+	suite.Run(t, new(RottenTomatoesClientTestSuite))
+}
+
+func (s *RottenTomatoesClientTestSuite) newRequest(path string) *http.Request {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, s.client.GetBaseUrl().String()+path, nil)
+	s.Require().NoError(err)
+	return req
+}
+
+func (s *RottenTomatoesClientTestSuite) serveFile(name string) {
+	page, err := os.ReadFile("testdata/" + name)
+	s.Require().NoError(err)
+
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("text/html", r.Header.Get("Accept"))
+		_, _ = w.Write(page)
+	}
+}
+
+func (s *RottenTomatoesClientTestSuite) TestDoWithRatingResponse_SearchPage() {
+	// Arrange
+	s.serveFile("search.html")
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest("/search?search=the+matrix"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&rottenTomatoesModel.Response{
+		Results: []rottenTomatoesModel.SearchResult{
+			{Title: "The Matrix", Type: "movie", Year: 1999, URL: "https://www.rottentomatoes.com/m/matrix"},
+			{Title: "The Matrix Resurrections", Type: "movie", Year: 2021, URL: "https://www.rottentomatoes.com/m/the_matrix_resurrections"},
+			{Title: "Game of Thrones", Type: "tvSeries", Year: 2011, URL: "https://www.rottentomatoes.com/tv/game_of_thrones"},
+		},
+	}, response)
+}
+
+func (s *RottenTomatoesClientTestSuite) TestDoWithRatingResponse_NoSearchResults() {
+	// Arrange
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body><search-page-result type="movie"></search-page-result></body></html>`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest("/search?search=nothing"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&rottenTomatoesModel.Response{Results: []rottenTomatoesModel.SearchResult{}}, response)
+}
+
+func (s *RottenTomatoesClientTestSuite) TestDoWithRatingResponse_TitlePage() {
+	// Arrange
+	s.serveFile("movie.html")
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest("/m/matrix"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&rottenTomatoesModel.Response{
+		Scorecard: &rottenTomatoesModel.Scorecard{
+			CriticsScore:  rottenTomatoesModel.Score{Score: "83", Certified: true},
+			AudienceScore: rottenTomatoesModel.Score{Score: "85", Certified: true},
+		},
+	}, response)
+}
+
+func (s *RottenTomatoesClientTestSuite) TestDoWithRatingResponse_Errors() {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedError string
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, expectedError: model.NotFound},
+		{name: "unexpected status", statusCode: http.StatusForbidden, expectedError: "unexpected Rotten Tomatoes status code 403"},
+		{name: "unterminated scorecard", statusCode: http.StatusOK, body: `<script id="media-scorecard-json" type="application/json">{}`, expectedError: "unterminated scorecard in the page"},
+		{name: "invalid scorecard", statusCode: http.StatusOK, body: `<script id="media-scorecard-json" type="application/json">{</script>`, expectedError: "unexpected end of JSON input"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}
+
+			// Act
+			response, err := s.client.DoWithRatingResponse(s.newRequest("/m/matrix"))
+
+			// Assert
+			s.EqualError(err, tc.expectedError)
+			s.Nil(response)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package rotten_tomatoes
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// RottenTomatoesHTTPClient implements the HTTPClient interface for Rotten Tomatoes
+type RottenTomatoesHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewRottenTomatoesHTTPClient creates a new Rotten Tomatoes HTTP client
+func NewRottenTomatoesHTTPClient(timeout time.Duration, maxRetries int) *RottenTomatoesHTTPClient {
+	return &RottenTomatoesHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *RottenTomatoesHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
@@ -62,7 +62,9 @@ func (s *RottenTomatoesLogoService) GetLogos(
 					logoPath = s.config.ImagePaths.RottenTomatoes.Audience.Normal
 				}
 			case model.RatingServiceTypeCritic:
-				if percentageRating < 60 {
+				if rating.Certified {
+					logoPath = s.config.ImagePaths.RottenTomatoes.Critic.Certified
+				} else if percentageRating < 60 {
 					logoPath = s.config.ImagePaths.RottenTomatoes.Critic.Low
 				} else {
 					logoPath = s.config.ImagePaths.RottenTomatoes.Critic.Normal
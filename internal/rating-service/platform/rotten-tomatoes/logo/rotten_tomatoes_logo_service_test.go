@@ -22,6 +22,7 @@ type RottenTomatoesLogoServiceTestSuite struct {
 	testAudienceLowConfigPath    string
 	testCriticNormalConfigPath   string
 	testCriticLowConfigPath      string
+	testCriticCertifiedPath      string
 }
 
 func (s *RottenTomatoesLogoServiceTestSuite) SetupTest() {
@@ -32,13 +33,15 @@ func (s *RottenTomatoesLogoServiceTestSuite) SetupTest() {
 	s.testAudienceLowConfigPath = "path/to/rt/audience/low.png"
 	s.testCriticNormalConfigPath = "path/to/rt/critic/normal.png"
 	s.testCriticLowConfigPath = "path/to/rt/critic/low.png"
+	s.testCriticCertifiedPath = "path/to/rt/critic/certified.png"
 
 	s.config = &model.PosterConfig{
 		ImagePaths: struct { // Anonymous struct for ImagePaths
 			RottenTomatoes struct {
 				Critic struct {
-					Normal string
-					Low    string
+					Normal    string
+					Low       string
+					Certified string
 				}
 				Audience struct {
 					Normal string
@@ -51,11 +54,27 @@ func (s *RottenTomatoesLogoServiceTestSuite) SetupTest() {
 			TMDB struct {
 				Audience struct{ Normal string }
 			}
+			Metacritic struct {
+				Critic struct{ High, Medium, Low string }
+			}
+			Trakt struct {
+				Audience struct{ Normal string }
+			}
+			Letterboxd struct {
+				Audience struct{ Normal string }
+			}
+			AniList struct {
+				Audience struct{ Normal string }
+			}
+			MyAnimeList struct {
+				Audience struct{ Normal string }
+			}
 		}{
 			RottenTomatoes: struct {
 				Critic struct {
-					Normal string
-					Low    string
+					Normal    string
+					Low       string
+					Certified string
 				}
 				Audience struct {
 					Normal string
@@ -70,11 +89,13 @@ func (s *RottenTomatoesLogoServiceTestSuite) SetupTest() {
 					Low:    s.testAudienceLowConfigPath,
 				},
 				Critic: struct {
-					Normal string
-					Low    string
+					Normal    string
+					Low       string
+					Certified string
 				}{
-					Normal: s.testCriticNormalConfigPath,
-					Low:    s.testCriticLowConfigPath,
+					Normal:    s.testCriticNormalConfigPath,
+					Low:       s.testCriticLowConfigPath,
+					Certified: s.testCriticCertifiedPath,
 				},
 			},
 		},
@@ -172,6 +193,23 @@ func (s *RottenTomatoesLogoServiceTestSuite) TestGetLogos_Success_Critic_Low() {
 	s.Equal(expectedLogo, logos[0])
 }
 
+func (s *RottenTomatoesLogoServiceTestSuite) TestGetLogos_Success_Critic_Certified() {
+	ctx := context.Background()
+	ratings := []model.Rating{{Type: model.RatingServiceTypeCritic, Rating: 9.3, Certified: true}} // 93% Certified Fresh
+	dimensions := model.LogoDimensions{}
+	expectedLogo := &model.Logo{}
+	expectedRatingText := "93%"
+
+	s.mockLogoCreator.On("CreateLogo", s.testCriticCertifiedPath, expectedRatingText, dimensions).Return(expectedLogo, nil).Once()
+
+	logos, err := s.service.GetLogos(ctx, ratings, "tt123", dimensions)
+
+	s.NoError(err)
+	s.NotNil(logos)
+	s.Len(logos, 1)
+	s.Equal(expectedLogo, logos[0])
+}
+
 func (s *RottenTomatoesLogoServiceTestSuite) TestGetLogos_Success_MultipleRatings() {
 	ctx := context.Background()
 	ratings := []model.Rating{
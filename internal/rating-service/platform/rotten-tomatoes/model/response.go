@@ -0,0 +1,30 @@
+package rotten_tomatoes
+
+// Response is the content read from a Rotten Tomatoes page: the results of a search page, or the
+// scorecard of a movie or TV series page
+type Response struct {
+	Results   []SearchResult
+	Scorecard *Scorecard
+}
+
+// SearchResult is a movie or TV series listed by the search page
+type SearchResult struct {
+	Title string
+	// Type is the type of the result, either movie or tvSeries
+	Type string
+	// Year is the release year of a movie, or the start year of a TV series
+	Year int
+	URL  string
+}
+
+// Scorecard holds the scores of a movie or TV series page
+type Scorecard struct {
+	CriticsScore  Score `json:"criticsScore"`
+	AudienceScore Score `json:"audienceScore"`
+}
+
+// Score is a percentage score, empty until the title has enough reviews
+type Score struct {
+	Score     string `json:"score"`
+	Certified bool   `json:"certified"`
+}
@@ -0,0 +1,139 @@
+package rotten_tomatoes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	rottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/model"
+)
+
+// resultTypes maps the media types to the types of the Rotten Tomatoes search results
+var resultTypes = map[string]string{
+	constant.MediaTypeMovie: "movie",
+	constant.MediaTypeShow:  "tvSeries",
+}
+
+// RottenTomatoesSearchService looks the movies and TV series up on Rotten Tomatoes
+type RottenTomatoesSearchService struct {
+	client rating.RatingClient
+	logger *zap.Logger
+}
+
+func NewRottenTomatoesSearchService(client rating.RatingClient, logger *zap.Logger) *RottenTomatoesSearchService {
+	return &RottenTomatoesSearchService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetScorecard returns the scorecard of the item, found by title, type and year on the search page.
+// It returns nil when the item is not a movie or a TV series known to Rotten Tomatoes
+func (s *RottenTomatoesSearchService) GetScorecard(ctx context.Context, item model.Item) (*rottenTomatoes.Scorecard, error) {
+	resultType, ok := resultTypes[item.Type]
+	if !ok || item.Title == "" {
+		s.logger.Debug("item cannot be found on Rotten Tomatoes, only movies and TV series can",
+			zap.String("Item ID", item.ID),
+			zap.String("Type", item.Type),
+		)
+		return nil, nil
+	}
+
+	endpoint := *s.client.GetBaseUrl()
+	endpoint.Path += "/search"
+	endpoint.RawQuery = url.Values{"search": {item.Title}}.Encode()
+
+	response, err := s.getPage(ctx, endpoint, "GetScorecard")
+	if err != nil || response == nil {
+		return nil, err
+	}
+
+	result := findResult(response.Results, item, resultType)
+	if result == nil {
+		s.logger.Debug("item not found on Rotten Tomatoes",
+			zap.String("Item ID", item.ID),
+			zap.String("Title", item.Title),
+			zap.Int("Year", item.Year),
+		)
+		return nil, nil
+	}
+
+	resultUrl, err := url.Parse(result.URL)
+	if err != nil {
+		s.logger.Error("invalid Rotten Tomatoes result URL",
+			zap.String("url", result.URL),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// The result URLs are absolute, only their path is requested to stay on the configured base URL
+	endpoint = *s.client.GetBaseUrl()
+	endpoint.Path += resultUrl.Path
+
+	response, err = s.getPage(ctx, endpoint, "GetScorecard")
+	if err != nil || response == nil {
+		return nil, err
+	}
+
+	return response.Scorecard, nil
+}
+
+// getPage requests a Rotten Tomatoes page, it returns nil when the page does not exist
+func (s *RottenTomatoesSearchService) getPage(ctx context.Context, endpoint url.URL, method string) (*rottenTomatoes.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	response, err := s.client.DoWithRatingResponse(req)
+	if err != nil {
+		if err.Error() == model.NotFound {
+			s.logger.Debug("page not found on Rotten Tomatoes",
+				zap.String("path", endpoint.Path),
+			)
+			return nil, nil
+		}
+		s.logger.Error("unable to perform request to Rotten Tomatoes Client",
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result, ok := response.(*rottenTomatoes.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to Rotten Tomatoes Response",
+			zap.String("method", method),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	return result, nil
+}
+
+// findResult returns the first result with the title, type and year of the item. The year is only
+// compared when both are known
+func findResult(results []rottenTomatoes.SearchResult, item model.Item, resultType string) *rottenTomatoes.SearchResult {
+	title := rating.NormalizeTitle(item.Title)
+	for i, result := range results {
+		if result.Type != resultType || rating.NormalizeTitle(result.Title) != title {
+			continue
+		}
+		if item.Year > 0 && result.Year > 0 && item.Year != result.Year {
+			continue
+		}
+		return &results[i]
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package rotten_tomatoes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rottenTomatoesClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/client"
+	rottenTomatoesModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/model"
+)
+
+// RottenTomatoesSearchServiceTestSuite runs the search service and the Rotten Tomatoes client against a local server
+type RottenTomatoesSearchServiceTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []string
+	service  *RottenTomatoesSearchService
+	ctx      context.Context
+}
+
+func TestRottenTomatoesSearchServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RottenTomatoesSearchServiceTestSuite))
+}
+
+func (s *RottenTomatoesSearchServiceTestSuite) SetupTest() {
+	searchPage, err := os.ReadFile("../client/testdata/search.html")
+	s.Require().NoError(err)
+	moviePage, err := os.ReadFile("../client/testdata/movie.html")
+	s.Require().NoError(err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /search", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(searchPage)
+	})
+	mux.HandleFunc("GET /m/matrix", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(moviePage)
+	})
+
+	s.requests = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests = append(s.requests, r.URL.RequestURI())
+		mux.ServeHTTP(w, r)
+	}))
+
+	client, err := rottenTomatoesClient.NewRottenTomatoesClient(&config.HTTPClient{Timeout: 5 * time.Second}, os.DevNull, zap.NewNop())
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+
+	s.service = NewRottenTomatoesSearchService(client, zap.NewNop())
+	s.ctx = context.Background()
+}
+
+func (s *RottenTomatoesSearchServiceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *RottenTomatoesSearchServiceTestSuite) TestGetScorecard() {
+	// Arrange
+	item := model.Item{ID: "1234", Title: "The Matrix", Type: constant.MediaTypeMovie, Year: 1999}
+
+	// Act
+	scorecard, err := s.service.GetScorecard(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&rottenTomatoesModel.Scorecard{
+		CriticsScore:  rottenTomatoesModel.Score{Score: "83", Certified: true},
+		AudienceScore: rottenTomatoesModel.Score{Score: "85", Certified: true},
+	}, scorecard)
+	s.Equal([]string{"/search?search=The+Matrix", "/m/matrix"}, s.requests)
+}
+
+func (s *RottenTomatoesSearchServiceTestSuite) TestGetScorecard_NotFound() {
+	testCases := []struct {
+		name             string
+		item             model.Item
+		expectedRequests int
+	}{
+		{
+			name:             "unknown title",
+			item:             model.Item{ID: "1", Title: "Unknown", Type: constant.MediaTypeMovie, Year: 1999},
+			expectedRequests: 1,
+		},
+		{
+			name:             "other year",
+			item:             model.Item{ID: "2", Title: "The Matrix", Type: constant.MediaTypeMovie, Year: 2003},
+			expectedRequests: 1,
+		},
+		{
+			name:             "other type",
+			item:             model.Item{ID: "3", Title: "The Matrix", Type: constant.MediaTypeShow, Year: 1999},
+			expectedRequests: 1,
+		},
+		{
+			name:             "missing title page",
+			item:             model.Item{ID: "4", Title: "Game of Thrones", Type: constant.MediaTypeShow, Year: 2011},
+			expectedRequests: 2,
+		},
+		{
+			name: "episode",
+			item: model.Item{ID: "5", Title: "Winter Is Coming", Type: constant.MediaTypeEpisode},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.requests = nil
+
+			// Act
+			scorecard, err := s.service.GetScorecard(s.ctx, tc.item)
+
+			// Assert
+			s.NoError(err)
+			s.Nil(scorecard)
+			s.Len(s.requests, tc.expectedRequests)
+		})
+	}
+}
+
+func (s *RottenTomatoesSearchServiceTestSuite) TestFindResult_UnknownYear() {
+	// Arrange
+	results := []rottenTomatoesModel.SearchResult{
+		{Title: "The Matrix", Type: "movie", URL: "https://www.rottentomatoes.com/m/matrix"},
+	}
+	item := model.Item{Title: "the matrix", Type: constant.MediaTypeMovie, Year: 1999}
+
+	// Act
+	result := findResult(results, item, "movie")
+
+	// Assert
+	s.Equal(&results[0], result)
+}
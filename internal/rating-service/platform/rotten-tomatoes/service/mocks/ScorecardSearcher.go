@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package rotten_tomatoes_mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+
+	rotten_tomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/model"
+)
+
+// ScorecardSearcher is an autogenerated mock type for the ScorecardSearcher type
+type ScorecardSearcher struct {
+	mock.Mock
+}
+
+type ScorecardSearcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ScorecardSearcher) EXPECT() *ScorecardSearcher_Expecter {
+	return &ScorecardSearcher_Expecter{mock: &_m.Mock}
+}
+
+// GetScorecard provides a mock function with given fields: ctx, item
+func (_m *ScorecardSearcher) GetScorecard(ctx context.Context, item model.Item) (*rotten_tomatoes.Scorecard, error) {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScorecard")
+	}
+
+	var r0 *rotten_tomatoes.Scorecard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) (*rotten_tomatoes.Scorecard, error)); ok {
+		return rf(ctx, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) *rotten_tomatoes.Scorecard); ok {
+		r0 = rf(ctx, item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rotten_tomatoes.Scorecard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Item) error); ok {
+		r1 = rf(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScorecardSearcher_GetScorecard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScorecard'
+type ScorecardSearcher_GetScorecard_Call struct {
+	*mock.Call
+}
+
+// GetScorecard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.Item
+func (_e *ScorecardSearcher_Expecter) GetScorecard(ctx interface{}, item interface{}) *ScorecardSearcher_GetScorecard_Call {
+	return &ScorecardSearcher_GetScorecard_Call{Call: _e.mock.On("GetScorecard", ctx, item)}
+}
+
+func (_c *ScorecardSearcher_GetScorecard_Call) Run(run func(ctx context.Context, item model.Item)) *ScorecardSearcher_GetScorecard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Item))
+	})
+	return _c
+}
+
+func (_c *ScorecardSearcher_GetScorecard_Call) Return(_a0 *rotten_tomatoes.Scorecard, _a1 error) *ScorecardSearcher_GetScorecard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScorecardSearcher_GetScorecard_Call) RunAndReturn(run func(context.Context, model.Item) (*rotten_tomatoes.Scorecard, error)) *ScorecardSearcher_GetScorecard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewScorecardSearcher creates a new instance of ScorecardSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScorecardSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ScorecardSearcher {
+	mock := &ScorecardSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
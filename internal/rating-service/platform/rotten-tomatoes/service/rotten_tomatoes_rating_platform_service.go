@@ -0,0 +1,89 @@
+package rotten_tomatoes
+
+import (
+	"context"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/model"
+)
+
+// ScorecardSearcher looks the scorecards up on Rotten Tomatoes
+type ScorecardSearcher interface {
+	GetScorecard(ctx context.Context, item model.Item) (*rottenTomatoes.Scorecard, error)
+}
+
+// RottenTomatoesRatingPlatformService provides the Tomatometer, with its Certified Fresh state, and the audience score
+type RottenTomatoesRatingPlatformService struct {
+	logger            *zap.Logger
+	scorecardSearcher ScorecardSearcher
+}
+
+func NewRottenTomatoesRatingPlatformService(logger *zap.Logger, scorecardSearcher ScorecardSearcher) *RottenTomatoesRatingPlatformService {
+	return &RottenTomatoesRatingPlatformService{
+		logger:            logger,
+		scorecardSearcher: scorecardSearcher,
+	}
+}
+
+func (s *RottenTomatoesRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
+	s.logger.Debug("Retrieving Rotten Tomatoes ratings..",
+		zap.String("Item ID", item.ID),
+	)
+
+	scorecard, err := s.scorecardSearcher.GetScorecard(ctx, item)
+	if err != nil {
+		s.logger.Error("unable to get Rotten Tomatoes scorecard",
+			zap.String("Item ID", item.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if scorecard == nil {
+		s.logger.Debug("no Rotten Tomatoes scorecard found",
+			zap.String("Item ID", item.ID),
+		)
+		return nil, nil
+	}
+
+	var ratings []model.Rating
+
+	if score, ok := parseScore(scorecard.CriticsScore); ok {
+		ratings = append(ratings, model.Rating{
+			Name:      constant.RatingServiceRottenTomatoes,
+			Rating:    score,
+			Type:      model.RatingServiceTypeCritic,
+			Certified: scorecard.CriticsScore.Certified,
+		})
+	}
+
+	if score, ok := parseScore(scorecard.AudienceScore); ok {
+		ratings = append(ratings, model.Rating{
+			Name:   constant.RatingServiceRottenTomatoes,
+			Rating: score,
+			Type:   model.RatingServiceTypeAudience,
+		})
+	}
+
+	s.logger.Debug("Rotten Tomatoes ratings found",
+		zap.String("Item ID", item.ID),
+		zap.String("Tomatometer", scorecard.CriticsScore.Score),
+		zap.Bool("Certified Fresh", scorecard.CriticsScore.Certified),
+		zap.String("Audience Score", scorecard.AudienceScore.Score),
+	)
+
+	return ratings, nil
+}
+
+// parseScore returns the percentage score on the 0-10 scale, false while the title has too few reviews
+func parseScore(score rottenTomatoes.Score) (float32, bool) {
+	percentage, err := strconv.ParseFloat(score.Score, 32)
+	if err != nil || percentage <= 0 {
+		return 0, false
+	}
+	return float32(percentage) / 10, true
+}
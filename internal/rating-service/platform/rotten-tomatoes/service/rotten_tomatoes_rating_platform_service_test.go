@@ -0,0 +1,108 @@
+package rotten_tomatoes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rottenTomatoesModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/model"
+	rottenTomatoes "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/service"
+	rotten_tomatoes_mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/rotten-tomatoes/service/mocks"
+)
+
+type RottenTomatoesRatingPlatformServiceTestSuite struct {
+	suite.Suite
+	mockScorecardSearcher *rotten_tomatoes_mocks.ScorecardSearcher
+	service               *rottenTomatoes.RottenTomatoesRatingPlatformService
+	ctx                   context.Context
+	item                  model.Item
+}
+
+func (s *RottenTomatoesRatingPlatformServiceTestSuite) SetupTest() {
+	s.mockScorecardSearcher = rotten_tomatoes_mocks.NewScorecardSearcher(s.T())
+	s.service = rottenTomatoes.NewRottenTomatoesRatingPlatformService(zap.NewNop(), s.mockScorecardSearcher)
+	s.ctx = context.Background()
+	s.item = model.Item{ID: "1234", Title: "The Matrix", Type: constant.MediaTypeMovie, Year: 1999}
+}
+
+func TestRottenTomatoesRatingPlatformServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RottenTomatoesRatingPlatformServiceTestSuite))
+}
+
+func (s *RottenTomatoesRatingPlatformServiceTestSuite) TestGetRatings() {
+	// Arrange
+	scorecard := &rottenTomatoesModel.Scorecard{
+		CriticsScore:  rottenTomatoesModel.Score{Score: "83", Certified: true},
+		AudienceScore: rottenTomatoesModel.Score{Score: "85", Certified: true},
+	}
+	s.mockScorecardSearcher.On("GetScorecard", s.ctx, s.item).Return(scorecard, nil).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Rating{
+		{Name: constant.RatingServiceRottenTomatoes, Rating: 8.3, Type: model.RatingServiceTypeCritic, Certified: true},
+		{Name: constant.RatingServiceRottenTomatoes, Rating: 8.5, Type: model.RatingServiceTypeAudience},
+	}, ratings)
+}
+
+func (s *RottenTomatoesRatingPlatformServiceTestSuite) TestGetRatings_CriticsOnly() {
+	// Arrange
+	scorecard := &rottenTomatoesModel.Scorecard{
+		CriticsScore: rottenTomatoesModel.Score{Score: "45"},
+	}
+	s.mockScorecardSearcher.On("GetScorecard", s.ctx, s.item).Return(scorecard, nil).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Rating{
+		{Name: constant.RatingServiceRottenTomatoes, Rating: 4.5, Type: model.RatingServiceTypeCritic},
+	}, ratings)
+}
+
+func (s *RottenTomatoesRatingPlatformServiceTestSuite) TestGetRatings_NoRating() {
+	testCases := []struct {
+		name      string
+		scorecard *rottenTomatoesModel.Scorecard
+	}{
+		{name: "not on Rotten Tomatoes", scorecard: nil},
+		{name: "not enough reviews", scorecard: &rottenTomatoesModel.Scorecard{}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockScorecardSearcher.On("GetScorecard", s.ctx, s.item).Return(tc.scorecard, nil).Once()
+
+			// Act
+			ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+			// Assert
+			s.NoError(err)
+			s.Empty(ratings)
+		})
+	}
+}
+
+func (s *RottenTomatoesRatingPlatformServiceTestSuite) TestGetRatings_Error() {
+	// Arrange
+	expectedErr := errors.New("rotten tomatoes unavailable")
+	s.mockScorecardSearcher.On("GetScorecard", s.ctx, s.item).Return(nil, expectedErr).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.Equal(expectedErr, err)
+	s.Empty(ratings)
+}
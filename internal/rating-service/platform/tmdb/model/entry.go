@@ -5,5 +5,11 @@ type Entry struct {
 	Adult         bool    `json:"adult"`
 	Title         string  `json:"title"`
 	OriginalTitle string  `json:"original_title"`
+	Name          string  `json:"name"`
+	OriginalName  string  `json:"original_name"`
+	ReleaseDate   string  `json:"release_date"`
+	FirstAirDate  string  `json:"first_air_date"`
+	Popularity    float64 `json:"popularity"`
 	Vote          float64 `json:"vote_average"`
+	VoteCount     int     `json:"vote_count"`
 }
@@ -3,4 +3,9 @@ package tmdb
 type Response struct {
 	Page    int     `json:"page"`
 	Results []Entry `json:"results"`
+	// Entry is filled by the /3/movie/{id} and /3/tv/{id} details endpoints
+	Entry
+	// MovieResults and TVResults are filled by the /3/find/{external_id} endpoint
+	MovieResults []Entry `json:"movie_results"`
+	TVResults    []Entry `json:"tv_results"`
 }
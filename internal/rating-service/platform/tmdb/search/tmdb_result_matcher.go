@@ -0,0 +1,115 @@
+package tmdb
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	tmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/model"
+)
+
+const (
+	// minMatchScore is the score a search result needs to be taken as the item, an equal title being enough
+	minMatchScore = 50.0
+	// ambiguityMargin is the score difference under which two matching results are ambiguous
+	ambiguityMargin = 10.0
+
+	exactTitleScore              = 60.0
+	normalizedTitleScore         = 50.0
+	exactOriginalTitleScore      = 50.0
+	normalizedOriginalTitleScore = 40.0
+	sameYearScore                = 25.0
+	nearYearScore                = 10.0
+	otherYearScore               = -40.0
+	maxPopularityScore           = 5.0
+	maxVoteCountScore            = 10.0
+)
+
+// scoredEntry is a search result with the confidence it is the item
+type scoredEntry struct {
+	entry tmdb.Entry
+	score float64
+}
+
+// scoreEntries scores the search results against the item, the best first
+func scoreEntries(entries []tmdb.Entry, item model.Item) []scoredEntry {
+	scored := make([]scoredEntry, 0, len(entries))
+	for _, entry := range entries {
+		scored = append(scored, scoredEntry{entry: entry, score: scoreEntry(entry, item)})
+	}
+	slices.SortStableFunc(scored, func(a, b scoredEntry) int {
+		return cmp.Compare(b.score, a.score)
+	})
+	return scored
+}
+
+// scoreEntry weighs the title, the original title and the year of the result against the item. Its popularity
+// and vote count slightly favor the well known results, e.g. a film over its homonymous short
+func scoreEntry(entry tmdb.Entry, item model.Item) float64 {
+	score := titleScore(entry, item)
+
+	if year := entryYear(entry); item.Year > 0 && year > 0 {
+		switch diff := max(item.Year-year, year-item.Year); {
+		case diff == 0:
+			score += sameYearScore
+		case diff == 1:
+			// The release dates of the media servers and TMDB differ by a year around the new year
+			score += nearYearScore
+		default:
+			score += otherYearScore
+		}
+	}
+
+	score += min(maxPopularityScore, math.Log10(1+entry.Popularity)*2.5)
+	score += min(maxVoteCountScore, math.Log10(1+float64(entry.VoteCount))*2.5)
+	return score
+}
+
+// titleScore compares the title of the result with the title of the item, then its original title with
+// both titles of the item
+func titleScore(entry tmdb.Entry, item model.Item) float64 {
+	title, originalTitle := entryTitles(entry)
+
+	score := compareTitles(title, item.Title, exactTitleScore, normalizedTitleScore)
+	for _, itemTitle := range []string{item.Title, item.OriginalTitle} {
+		score = max(score, compareTitles(originalTitle, itemTitle, exactOriginalTitleScore, normalizedOriginalTitleScore))
+	}
+	return max(score, compareTitles(title, item.OriginalTitle, exactOriginalTitleScore, normalizedOriginalTitleScore))
+}
+
+// compareTitles returns exactScore for equal titles, normalizedScore for titles equal once normalized, zero otherwise
+func compareTitles(title, other string, exactScore, normalizedScore float64) float64 {
+	switch {
+	case title == "" || other == "":
+		return 0
+	case strings.EqualFold(title, other):
+		return exactScore
+	case rating.NormalizeTitle(title) == rating.NormalizeTitle(other):
+		return normalizedScore
+	default:
+		return 0
+	}
+}
+
+// entryTitles returns the title and the original title of the result, TV results carrying them in the name fields
+func entryTitles(entry tmdb.Entry) (string, string) {
+	if entry.Title == "" && entry.OriginalTitle == "" {
+		return entry.Name, entry.OriginalName
+	}
+	return entry.Title, entry.OriginalTitle
+}
+
+// entryYear returns the year the result was released or first aired, zero when unknown
+func entryYear(entry tmdb.Entry) int {
+	date := entry.ReleaseDate
+	if date == "" {
+		date = entry.FirstAirDate
+	}
+	year, _, _ := strings.Cut(date, "-")
+	parsed, _ := strconv.Atoi(year)
+	return parsed
+}
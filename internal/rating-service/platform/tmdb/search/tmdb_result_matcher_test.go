@@ -0,0 +1,122 @@
+package tmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	tmdbmodel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/model"
+)
+
+type TMDBResultMatcherTestSuite struct {
+	suite.Suite
+}
+
+func TestTMDBResultMatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(TMDBResultMatcherTestSuite))
+}
+
+func (s *TMDBResultMatcherTestSuite) TestScoreEntries() {
+	testCases := []struct {
+		name        string
+		item        model.Item
+		entries     []tmdbmodel.Entry
+		expectedIDs []int
+	}{
+		{
+			name: "same year before a popular remake",
+			item: model.Item{Title: "Dune", Year: 1984},
+			entries: []tmdbmodel.Entry{
+				{ID: 438631, Title: "Dune", ReleaseDate: "2021-09-15", Popularity: 300, VoteCount: 12000},
+				{ID: 841, Title: "Dune", ReleaseDate: "1984-12-14", Popularity: 30, VoteCount: 2500},
+			},
+			expectedIDs: []int{841, 438631},
+		},
+		{
+			name: "exact title before normalized title",
+			item: model.Item{Title: "Se7en"},
+			entries: []tmdbmodel.Entry{
+				{ID: 2, Title: "Se-7en"},
+				{ID: 807, Title: "Se7en"},
+			},
+			expectedIDs: []int{807, 2},
+		},
+		{
+			name: "original title of a show",
+			item: model.Item{Title: "Money Heist", OriginalTitle: "La casa de papel", Year: 2017},
+			entries: []tmdbmodel.Entry{
+				{ID: 1, Name: "Paper House", FirstAirDate: "2017-05-02"},
+				{ID: 71446, Name: "Money Heist", OriginalName: "La casa de papel", FirstAirDate: "2017-05-02"},
+			},
+			expectedIDs: []int{71446, 1},
+		},
+		{
+			name: "popularity and votes between equal matches",
+			item: model.Item{Title: "Crash"},
+			entries: []tmdbmodel.Entry{
+				{ID: 884, Title: "Crash", Popularity: 10, VoteCount: 2000},
+				{ID: 1640, Title: "Crash", Popularity: 20, VoteCount: 4000},
+			},
+			expectedIDs: []int{1640, 884},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Act
+			scored := scoreEntries(tc.entries, tc.item)
+
+			// Assert
+			ids := make([]int, 0, len(scored))
+			for _, entry := range scored {
+				ids = append(ids, entry.entry.ID)
+			}
+			s.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func (s *TMDBResultMatcherTestSuite) TestScoreEntry_Threshold() {
+	testCases := []struct {
+		name    string
+		item    model.Item
+		entry   tmdbmodel.Entry
+		matches bool
+	}{
+		{
+			name:    "same title",
+			item:    model.Item{Title: "Inception"},
+			entry:   tmdbmodel.Entry{Title: "inception"},
+			matches: true,
+		},
+		{
+			name:    "same title and near year",
+			item:    model.Item{Title: "The Thing", Year: 1983},
+			entry:   tmdbmodel.Entry{Title: "The Thing", ReleaseDate: "1982-06-25"},
+			matches: true,
+		},
+		{
+			name:    "same title but distant year",
+			item:    model.Item{Title: "Heat", Year: 1995},
+			entry:   tmdbmodel.Entry{Title: "Heat", ReleaseDate: "1972-10-06", Popularity: 50, VoteCount: 5000},
+			matches: false,
+		},
+		{
+			name:    "other title of the same year",
+			item:    model.Item{Title: "Heat", Year: 1995},
+			entry:   tmdbmodel.Entry{Title: "Heat Wave", ReleaseDate: "1995-03-01", Popularity: 1000, VoteCount: 100000},
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Act
+			score := scoreEntry(tc.entry, tc.item)
+
+			// Assert
+			s.Equal(tc.matches, score >= minMatchScore, "score %.1f", score)
+		})
+	}
+}
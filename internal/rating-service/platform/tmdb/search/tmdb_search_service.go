@@ -9,6 +9,7 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
 	"github.com/zepollabot/media-rating-overlay/internal/model"
 	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
 	tmdb "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/model"
@@ -28,34 +29,175 @@ func NewTMDBSearchService(client rating.RatingClient, filtersService rating.Filt
 	}
 }
 
+// GetResults looks the item up by its external IDs when it has any, so that remakes and
+// same-title films get the right rating, otherwise it searches by title and year
 func (s *TMDBSearchService) GetResults(ctx context.Context, item model.Item) ([]model.SearchResult, error) {
-	var searchResults []model.SearchResult
+	results, found, err := s.getResultsByExternalID(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return results, nil
+	}
 
-	baseUrl := s.client.GetBaseUrl()
-	endpoint := baseUrl.JoinPath("/3/search/movie")
+	return s.searchResults(ctx, item)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+// getResultsByExternalID uses the TMDB ID, or finds the item by its IMDb or TVDB ID.
+// found is false when the item has no usable ID or TMDB does not know it.
+func (s *TMDBSearchService) getResultsByExternalID(ctx context.Context, item model.Item) ([]model.SearchResult, bool, error) {
+	isShow := s.isShow(item)
+
+	var path string
+	var filters []model.Filter
+	switch {
+	case item.ExternalIDs.TMDB != "":
+		path = fmt.Sprintf("/3/movie/%s", item.ExternalIDs.TMDB)
+		if isShow {
+			path = fmt.Sprintf("/3/tv/%s", item.ExternalIDs.TMDB)
+		}
+	case item.ExternalIDs.IMDB != "":
+		path = fmt.Sprintf("/3/find/%s", item.ExternalIDs.IMDB)
+		filters = []model.Filter{{Name: "external_source", Value: "imdb_id"}}
+	case item.ExternalIDs.TVDB != "" && isShow:
+		path = fmt.Sprintf("/3/find/%s", item.ExternalIDs.TVDB)
+		filters = []model.Filter{{Name: "external_source", Value: "tvdb_id"}}
+	default:
+		return nil, false, nil
+	}
+
+	response, err := s.getResponse(ctx, path, filters)
+	if err != nil {
+		if err.Error() == model.NotFound {
+			s.logger.Debug("item not found by external ID, searching by title",
+				zap.String("Item ID", item.ID),
+				zap.Any("externalIDs", item.ExternalIDs),
+			)
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var entries []tmdb.Entry
+	switch {
+	case item.ExternalIDs.TMDB != "":
+		if response.Entry.ID != 0 {
+			entries = []tmdb.Entry{response.Entry}
+		}
+	case isShow:
+		entries = response.TVResults
+	default:
+		entries = response.MovieResults
+	}
+
+	if len(entries) == 0 {
+		return nil, false, nil
+	}
+
+	return s.convertTMDBResultsToSearchResults(entries), true, nil
+}
+
+// searchResults searches the item by title and year, then by title only when no result matches: the year
+// of the media server can be the one of another release. It returns the result matching the item best,
+// none when no result is confident enough
+func (s *TMDBSearchService) searchResults(ctx context.Context, item model.Item) ([]model.SearchResult, error) {
+	entries, err := s.search(ctx, item, item.Year > 0)
 	if err != nil {
-		s.logger.Error("unable to build request",
-			zap.String("method", "GetEntries"),
-			zap.String("url", endpoint.String()),
-			zap.Error(err),
-		)
 		return nil, err
 	}
 
+	match := s.findMatch(entries, item)
+	if match == nil && item.Year > 0 {
+		s.logger.Debug("no TMDB result matches the item, searching without the year",
+			zap.String("Item ID", item.ID),
+			zap.String("Title", item.Title),
+			zap.Int("Year", item.Year),
+		)
+		if entries, err = s.search(ctx, item, false); err != nil {
+			return nil, err
+		}
+		match = s.findMatch(entries, item)
+	}
+
+	if match == nil {
+		s.logger.Warn("item not matched on TMDB, check its title and year",
+			zap.String("Item ID", item.ID),
+			zap.String("Title", item.Title),
+			zap.Int("Year", item.Year),
+			zap.Strings("Candidates", describeEntries(scoreEntries(entries, item))),
+		)
+		return nil, nil
+	}
+
+	return s.convertTMDBResultsToSearchResults([]tmdb.Entry{*match}), nil
+}
+
+// search requests the TMDB results having the title of the item, and its year when withYear is set
+func (s *TMDBSearchService) search(ctx context.Context, item model.Item, withYear bool) ([]tmdb.Entry, error) {
+	searchPath, yearFilter := "/3/search/movie", "year"
+	if s.isShow(item) {
+		searchPath, yearFilter = "/3/search/tv", "first_air_date_year"
+	}
+
 	filters := []model.Filter{
 		{
 			Name:  "query",
 			Value: item.Title,
 		},
-		{
-			Name:  "year",
+	}
+	if withYear {
+		filters = append(filters, model.Filter{
+			Name:  yearFilter,
 			Value: strconv.Itoa(item.Year),
-		},
+		})
+	}
+
+	response, err := s.getResponse(ctx, searchPath, filters)
+	if err != nil {
+		return nil, err
 	}
 
-	s.filtersService.ApplyFiltersToRequest(req, filters)
+	return response.Results, nil
+}
+
+// findMatch returns the result matching the item best, nil when none reaches the minimum score.
+// Results too close to tell apart are reported, the best one being still taken
+func (s *TMDBSearchService) findMatch(entries []tmdb.Entry, item model.Item) *tmdb.Entry {
+	scored := scoreEntries(entries, item)
+	if len(scored) == 0 || scored[0].score < minMatchScore {
+		return nil
+	}
+
+	if len(scored) > 1 && scored[1].score >= minMatchScore && scored[0].score-scored[1].score < ambiguityMargin {
+		s.logger.Warn("ambiguous TMDB match, check the title and year of the item",
+			zap.String("Item ID", item.ID),
+			zap.String("Title", item.Title),
+			zap.Int("Year", item.Year),
+			zap.Int("TMDB ID", scored[0].entry.ID),
+			zap.Strings("Candidates", describeEntries(scored)),
+		)
+	}
+
+	return &scored[0].entry
+}
+
+func (s *TMDBSearchService) getResponse(ctx context.Context, path string, filters []model.Filter) (*tmdb.Response, error) {
+	baseUrl := s.client.GetBaseUrl()
+	endpoint := baseUrl.JoinPath(path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetEntries"),
+			zap.String("url", endpoint.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if len(filters) > 0 {
+		s.filtersService.ApplyFiltersToRequest(req, filters)
+	}
 
 	response, err := s.client.DoWithRatingResponse(req)
 
@@ -64,7 +206,7 @@ func (s *TMDBSearchService) GetResults(ctx context.Context, item model.Item) ([]
 			zap.String("method", "GetEntries"),
 			zap.Error(err),
 		)
-		return searchResults, err
+		return nil, err
 	}
 
 	results, ok := response.(*tmdb.Response)
@@ -75,15 +217,34 @@ func (s *TMDBSearchService) GetResults(ctx context.Context, item model.Item) ([]
 		return nil, fmt.Errorf("invalid response type")
 	}
 
-	return s.convertTMDBResultsToSearchResults(results.Results), nil
+	return results, nil
+}
+
+func (s *TMDBSearchService) isShow(item model.Item) bool {
+	return item.Type == constant.MediaTypeShow || item.Type == constant.MediaTypeSeason
+}
+
+// describeEntries describes the scored results for the reports of the unmatched and ambiguous items
+func describeEntries(scored []scoredEntry) []string {
+	return lo.Map(scored, func(scored scoredEntry, _ int) string {
+		title, _ := entryTitles(scored.entry)
+		return fmt.Sprintf("%s (%d) #%d: %.1f", title, entryYear(scored.entry), scored.entry.ID, scored.score)
+	})
 }
 
 func (s *TMDBSearchService) convertTMDBResultsToSearchResults(results []tmdb.Entry) []model.SearchResult {
 	return lo.Map(results, func(result tmdb.Entry, _ int) model.SearchResult {
+		// TV results carry their title in the name field
+		title := result.Title
+		if title == "" {
+			title = result.Name
+		}
+
 		return model.SearchResult{
-			ID:    result.ID,
-			Title: result.Title,
-			Vote:  result.Vote,
+			ID:        result.ID,
+			Title:     title,
+			Vote:      result.Vote,
+			VoteCount: result.VoteCount,
 		}
 	})
 }
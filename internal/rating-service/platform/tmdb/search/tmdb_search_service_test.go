@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
 	"github.com/zepollabot/media-rating-overlay/internal/model"
 	ratingclientmock "github.com/zepollabot/media-rating-overlay/internal/rating-service/mocks"
 	tmdbmodel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/tmdb/model"
@@ -55,7 +56,6 @@ func (s *TMDBSearchServiceTestSuite) TestGetResults_Success() {
 	}
 	expectedSearchResults := []model.SearchResult{
 		{ID: 1, Title: "Inception", Vote: 8.8},
-		{ID: 2, Title: "Inception: The IMAX Experience", Vote: 8.5},
 	}
 
 	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
@@ -76,6 +76,57 @@ func (s *TMDBSearchServiceTestSuite) TestGetResults_Success() {
 	s.Equal(expectedSearchResults, results)
 }
 
+func (s *TMDBSearchServiceTestSuite) TestGetResults_ShowUsesTVSearch() {
+	// Arrange
+	item := model.Item{Title: "Breaking Bad", Year: 2008, Type: constant.MediaTypeShow}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+	expectedEndpoint := "http://test.com/3/search/tv"
+	expectedFilters := []model.Filter{
+		{Name: "query", Value: item.Title},
+		{Name: "first_air_date_year", Value: strconv.Itoa(item.Year)},
+	}
+
+	tmdbResults := []tmdbmodel.Entry{
+		{ID: 1396, Name: "Breaking Bad", Vote: 8.9},
+	}
+	expectedSearchResults := []model.SearchResult{
+		{ID: 1396, Title: "Breaking Bad", Vote: 8.9},
+	}
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), expectedFilters).Return().Once()
+	s.mockClient.On("DoWithRatingResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == expectedEndpoint && req.Method == http.MethodGet
+	})).Return(&tmdbmodel.Response{Results: tmdbResults}, nil)
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(expectedSearchResults, results)
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_SeasonUsesTVSearch() {
+	// Arrange
+	item := model.Item{Title: "Breaking Bad", Year: 2008, Type: constant.MediaTypeSeason}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+	expectedEndpoint := "http://test.com/3/search/tv"
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), mock.AnythingOfType("[]model.Filter")).Return()
+	s.mockClient.On("DoWithRatingResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == expectedEndpoint
+	})).Return(&tmdbmodel.Response{Results: []tmdbmodel.Entry{}}, nil)
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(results)
+}
+
 func (s *TMDBSearchServiceTestSuite) TestGetResults_HttpNewRequestWithContextError() {
 	// Arrange
 	item := model.Item{Title: "Error Movie", Year: 2020}
@@ -165,9 +216,13 @@ func (s *TMDBSearchServiceTestSuite) TestGetResults_FilterApplication() {
 		{Name: "query", Value: item.Title},
 		{Name: "year", Value: strconv.Itoa(item.Year)},
 	}
+	expectedRetryFilters := []model.Filter{
+		{Name: "query", Value: item.Title},
+	}
 
 	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
 	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), expectedFilters).Return().Once() // Ensure it's called once with correct filters
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), expectedRetryFilters).Return().Once()
 	s.mockClient.On("DoWithRatingResponse", mock.AnythingOfType("*http.Request")).Return(&tmdbmodel.Response{Results: []tmdbmodel.Entry{}}, nil)
 
 	// Act
@@ -207,3 +262,191 @@ func (s *TMDBSearchServiceTestSuite) TestConvertTMDBResultsToSearchResults_Empty
 	// Assert
 	s.Equal(expectedSearchResults, actualSearchResults)
 }
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_ByTMDBID() {
+	// Arrange
+	item := model.Item{Title: "Inception", Year: 2010, ExternalIDs: model.ExternalIDs{TMDB: "27205", IMDB: "tt1375666"}}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockClient.On("DoWithRatingResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "http://test.com/3/movie/27205"
+	})).Return(&tmdbmodel.Response{Entry: tmdbmodel.Entry{ID: 27205, Title: "Inception", Vote: 8.4, VoteCount: 37512}}, nil).Once()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.SearchResult{{ID: 27205, Title: "Inception", Vote: 8.4, VoteCount: 37512}}, results)
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_ShowByTMDBID() {
+	// Arrange
+	item := model.Item{Title: "Breaking Bad", Type: constant.MediaTypeSeason, ExternalIDs: model.ExternalIDs{TMDB: "1396"}}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockClient.On("DoWithRatingResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "http://test.com/3/tv/1396"
+	})).Return(&tmdbmodel.Response{Entry: tmdbmodel.Entry{ID: 1396, Name: "Breaking Bad", Vote: 8.9}}, nil).Once()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.SearchResult{{ID: 1396, Title: "Breaking Bad", Vote: 8.9}}, results)
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_FindByIMDBID() {
+	// Arrange
+	item := model.Item{Title: "The Thing", Year: 1982, ExternalIDs: model.ExternalIDs{IMDB: "tt0084787"}}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+	expectedFilters := []model.Filter{{Name: "external_source", Value: "imdb_id"}}
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), expectedFilters).Return().Once()
+	s.mockClient.On("DoWithRatingResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Path == "/3/find/tt0084787"
+	})).Return(&tmdbmodel.Response{
+		MovieResults: []tmdbmodel.Entry{{ID: 1091, Title: "The Thing", Vote: 8.1}},
+		TVResults:    []tmdbmodel.Entry{{ID: 99, Name: "Not this one", Vote: 5}},
+	}, nil).Once()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.SearchResult{{ID: 1091, Title: "The Thing", Vote: 8.1}}, results)
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_FindShowByTVDBID() {
+	// Arrange
+	item := model.Item{Title: "Breaking Bad", Type: constant.MediaTypeShow, ExternalIDs: model.ExternalIDs{TVDB: "81189"}}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+	expectedFilters := []model.Filter{{Name: "external_source", Value: "tvdb_id"}}
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), expectedFilters).Return().Once()
+	s.mockClient.On("DoWithRatingResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Path == "/3/find/81189"
+	})).Return(&tmdbmodel.Response{
+		TVResults: []tmdbmodel.Entry{{ID: 1396, Name: "Breaking Bad", Vote: 8.9}},
+	}, nil).Once()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.SearchResult{{ID: 1396, Title: "Breaking Bad", Vote: 8.9}}, results)
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_ExternalIDNotFoundFallsBackToSearch() {
+	// Arrange
+	item := model.Item{Title: "Inception", Year: 2010, ExternalIDs: model.ExternalIDs{TMDB: "0"}}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockClient.On("DoWithRatingResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Path == "/3/movie/0"
+	})).Return(nil, errors.New(model.NotFound)).Once()
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), mock.AnythingOfType("[]model.Filter")).Return().Once()
+	s.mockClient.On("DoWithRatingResponse", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Path == "/3/search/movie"
+	})).Return(&tmdbmodel.Response{Results: []tmdbmodel.Entry{{ID: 27205, Title: "Inception", Vote: 8.4}}}, nil).Once()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.SearchResult{{ID: 27205, Title: "Inception", Vote: 8.4}}, results)
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_ExternalIDLookupError() {
+	// Arrange
+	item := model.Item{Title: "Inception", Year: 2010, ExternalIDs: model.ExternalIDs{TMDB: "27205"}}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+	clientError := errors.New("network error")
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockClient.On("DoWithRatingResponse", mock.AnythingOfType("*http.Request")).Return(nil, clientError).Once()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.Equal(clientError, err)
+	s.Nil(results)
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_RetriesWithoutYear() {
+	// Arrange
+	item := model.Item{Title: "The Thing", Year: 1983}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{
+		{Name: "query", Value: item.Title},
+		{Name: "year", Value: "1983"},
+	}).Return().Once()
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{
+		{Name: "query", Value: item.Title},
+	}).Return().Once()
+	s.mockClient.On("DoWithRatingResponse", mock.AnythingOfType("*http.Request")).Return(&tmdbmodel.Response{Results: []tmdbmodel.Entry{}}, nil).Once()
+	s.mockClient.On("DoWithRatingResponse", mock.AnythingOfType("*http.Request")).Return(&tmdbmodel.Response{Results: []tmdbmodel.Entry{
+		{ID: 60935, Title: "The Thing", ReleaseDate: "2011-10-12", Vote: 6.3},
+		{ID: 1091, Title: "The Thing", ReleaseDate: "1982-06-25", Vote: 8.1},
+	}}, nil).Once()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.SearchResult{{ID: 1091, Title: "The Thing", Vote: 8.1}}, results, "the release a year apart should be matched")
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_WithoutYear() {
+	// Arrange
+	item := model.Item{Title: "Amélie"}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), []model.Filter{
+		{Name: "query", Value: item.Title},
+	}).Return().Once()
+	s.mockClient.On("DoWithRatingResponse", mock.AnythingOfType("*http.Request")).Return(&tmdbmodel.Response{Results: []tmdbmodel.Entry{
+		{ID: 194, Title: "Amélie", OriginalTitle: "Le Fabuleux Destin d'Amélie Poulain", Vote: 7.9},
+	}}, nil).Once()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.SearchResult{{ID: 194, Title: "Amélie", Vote: 7.9}}, results)
+}
+
+func (s *TMDBSearchServiceTestSuite) TestGetResults_NoConfidentMatch() {
+	// Arrange
+	item := model.Item{Title: "Heat", Year: 1995}
+	expectedBaseURL, _ := url.Parse("http://test.com")
+
+	s.mockClient.On("GetBaseUrl").Return(expectedBaseURL)
+	s.mockFiltersService.On("ApplyFiltersToRequest", mock.AnythingOfType("*http.Request"), mock.AnythingOfType("[]model.Filter")).Return().Twice()
+	s.mockClient.On("DoWithRatingResponse", mock.AnythingOfType("*http.Request")).Return(&tmdbmodel.Response{Results: []tmdbmodel.Entry{
+		{ID: 1, Title: "Heat Wave", ReleaseDate: "1995-03-01", Popularity: 3, Vote: 5.2, VoteCount: 40},
+		{ID: 2, Title: "Heat", ReleaseDate: "1972-10-06", Popularity: 1, Vote: 6.0, VoteCount: 12},
+	}}, nil).Twice()
+
+	// Act
+	results, err := s.service.GetResults(s.ctx, item)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(results, "neither a different title nor a distant year should be matched")
+}
@@ -22,7 +22,7 @@ func NewTMDBRatingPlatformService(logger *zap.Logger, searchService rating.Searc
 	}
 }
 
-func (s *TMDBRatingPlatformService) GetRating(ctx context.Context, item model.Item) (model.Rating, error) {
+func (s *TMDBRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
 	s.logger.Debug("Retrieving TMDB rating..",
 		zap.String("Item ID", item.ID),
 	)
@@ -32,14 +32,14 @@ func (s *TMDBRatingPlatformService) GetRating(ctx context.Context, item model.It
 			zap.String("Item ID", item.ID),
 			zap.Error(err),
 		)
-		return model.Rating{}, err
+		return nil, err
 	}
 
 	if len(results) == 0 {
 		s.logger.Debug("no results found",
 			zap.String("Item ID", item.ID),
 		)
-		return model.Rating{}, nil
+		return nil, nil
 	} else {
 		if len(results) > 1 {
 			s.logger.Debug("multiple results found",
@@ -48,20 +48,24 @@ func (s *TMDBRatingPlatformService) GetRating(ctx context.Context, item model.It
 			)
 		}
 
-		// even if there are multiple results, we take the first one as the best one
+		// the search service returns the result matching the item best first
 		result := results[0]
 		if result.Vote > 0 {
 			s.logger.Debug("TMDB rating found",
 				zap.String("Item ID", item.ID),
 				zap.Float32("Rating", float32(result.Vote)),
+				zap.Int("Votes", result.VoteCount),
 			)
-			return model.Rating{
-				Name:   constant.RatingServiceTMDB,
-				Rating: float32(result.Vote),
-				Type:   model.RatingServiceTypeAudience,
+			return []model.Rating{
+				{
+					Name:   constant.RatingServiceTMDB,
+					Rating: float32(result.Vote),
+					Type:   model.RatingServiceTypeAudience,
+					Votes:  result.VoteCount,
+				},
 			}, nil
 		}
 	}
 
-	return model.Rating{}, nil
+	return nil, nil
 }
@@ -36,7 +36,7 @@ func TestTMDBRatingPlatformServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(TMDBRatingPlatformServiceTestSuite))
 }
 
-func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_Success() {
+func (s *TMDBRatingPlatformServiceTestSuite) TestGetRatings_Success() {
 	// Arrange
 	ctx := context.Background()
 	item := model.Item{ID: "test-id", Title: "Test Movie", Year: 2023, Type: "movie"}
@@ -44,22 +44,23 @@ func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_Success() {
 		Name:   constant.RatingServiceTMDB,
 		Rating: 8.5,
 		Type:   model.RatingServiceTypeAudience,
+		Votes:  1234,
 	}
 	searchResults := []model.SearchResult{
-		{ID: 1, Title: "Test Movie", Vote: 8.5},
+		{ID: 1, Title: "Test Movie", Vote: 8.5, VoteCount: 1234},
 	}
 
 	s.mockSearchService.On("GetResults", ctx, item).Return(searchResults, nil)
 
 	// Act
-	rating, err := s.service.GetRating(ctx, item)
+	ratings, err := s.service.GetRatings(ctx, item)
 
 	// Assert
 	assert.NoError(s.T(), err)
-	assert.Equal(s.T(), expectedRating, rating)
+	assert.Equal(s.T(), []model.Rating{expectedRating}, ratings)
 }
 
-func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_SearchServiceError() {
+func (s *TMDBRatingPlatformServiceTestSuite) TestGetRatings_SearchServiceError() {
 	// Arrange
 	ctx := context.Background()
 	item := model.Item{ID: "test-id", Title: "Test Movie", Year: 2023, Type: "movie"}
@@ -68,15 +69,15 @@ func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_SearchServiceError()
 	s.mockSearchService.On("GetResults", ctx, item).Return(nil, expectedError)
 
 	// Act
-	rating, err := s.service.GetRating(ctx, item)
+	ratings, err := s.service.GetRatings(ctx, item)
 
 	// Assert
 	assert.Error(s.T(), err)
 	assert.Equal(s.T(), expectedError, err)
-	assert.Empty(s.T(), rating)
+	assert.Empty(s.T(), ratings)
 }
 
-func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_NoResults() {
+func (s *TMDBRatingPlatformServiceTestSuite) TestGetRatings_NoResults() {
 	// Arrange
 	ctx := context.Background()
 	item := model.Item{ID: "test-id", Title: "Test Movie", Year: 2023, Type: "movie"}
@@ -84,14 +85,14 @@ func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_NoResults() {
 	s.mockSearchService.On("GetResults", ctx, item).Return([]model.SearchResult{}, nil)
 
 	// Act
-	rating, err := s.service.GetRating(ctx, item)
+	ratings, err := s.service.GetRatings(ctx, item)
 
 	// Assert
 	assert.NoError(s.T(), err)
-	assert.Empty(s.T(), rating)
+	assert.Empty(s.T(), ratings)
 }
 
-func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_FirstResultNoVote() {
+func (s *TMDBRatingPlatformServiceTestSuite) TestGetRatings_FirstResultNoVote() {
 	// Arrange
 	ctx := context.Background()
 	item := model.Item{ID: "test-id", Title: "Test Movie", Year: 2023, Type: "movie"}
@@ -102,14 +103,14 @@ func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_FirstResultNoVote() {
 	s.mockSearchService.On("GetResults", ctx, item).Return(searchResults, nil)
 
 	// Act
-	rating, err := s.service.GetRating(ctx, item)
+	ratings, err := s.service.GetRatings(ctx, item)
 
 	// Assert
 	assert.NoError(s.T(), err)
-	assert.Empty(s.T(), rating)
+	assert.Empty(s.T(), ratings)
 }
 
-func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_MultipleResults_PicksFirst() {
+func (s *TMDBRatingPlatformServiceTestSuite) TestGetRatings_MultipleResults_PicksFirst() {
 	// Arrange
 	ctx := context.Background()
 	item := model.Item{ID: "test-id", Title: "Test Movie", Year: 2023, Type: "movie"}
@@ -126,14 +127,14 @@ func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_MultipleResults_Picks
 	s.mockSearchService.On("GetResults", ctx, item).Return(searchResults, nil)
 
 	// Act
-	rating, err := s.service.GetRating(ctx, item)
+	ratings, err := s.service.GetRatings(ctx, item)
 
 	// Assert
 	assert.NoError(s.T(), err)
-	assert.Equal(s.T(), expectedRating, rating)
+	assert.Equal(s.T(), []model.Rating{expectedRating}, ratings)
 }
 
-func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_FirstResultNegativeVote() {
+func (s *TMDBRatingPlatformServiceTestSuite) TestGetRatings_FirstResultNegativeVote() {
 	// Arrange
 	ctx := context.Background()
 	item := model.Item{ID: "test-id", Title: "Test Movie", Year: 2023, Type: "movie"}
@@ -144,9 +145,9 @@ func (s *TMDBRatingPlatformServiceTestSuite) TestGetRating_FirstResultNegativeVo
 	s.mockSearchService.On("GetResults", ctx, item).Return(searchResults, nil)
 
 	// Act
-	rating, err := s.service.GetRating(ctx, item)
+	ratings, err := s.service.GetRatings(ctx, item)
 
 	// Assert
 	assert.NoError(s.T(), err)
-	assert.Empty(s.T(), rating)
+	assert.Empty(s.T(), ratings)
 }
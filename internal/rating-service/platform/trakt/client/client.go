@@ -0,0 +1,141 @@
+package trakt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	trakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/model"
+)
+
+// apiVersion is the version of the Trakt API the client is written for
+const apiVersion = "2"
+
+// TraktClient implements the RatingClient interface
+type TraktClient struct {
+	httpClient common.ServiceHTTPClient
+	clientID   string
+	baseUrl    url.URL
+	logger     *zap.Logger
+}
+
+// NewTraktClient creates a new Trakt client
+func NewTraktClient(clientConfig *config.Trakt, httpClientConfig *config.HTTPClient, logFilePath string, logger *zap.Logger) (*TraktClient, error) {
+	if clientConfig.ClientID == "" {
+		logger.Error("trakt.client_id is required")
+		return nil, errors.New("trakt.client_id is required")
+	}
+
+	baseUrl := url.URL{
+		Scheme: "https",
+		Host:   "api.trakt.tv",
+	}
+
+	httpClient := NewTraktHTTPClient(httpClientConfig.Timeout, httpClientConfig.MaxRetries)
+
+	if err := common.SetupLogging(httpClient.client, logFilePath); err != nil {
+		return nil, err
+	}
+
+	return &TraktClient{
+		httpClient: httpClient,
+		clientID:   clientConfig.ClientID,
+		baseUrl:    baseUrl,
+		logger:     logger,
+	}, nil
+}
+
+func (c *TraktClient) DoWithResponse(request *http.Request) (*http.Response, error) {
+	c.setupRequest(request)
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("unable to perform request to Trakt API",
+			zap.String("url", request.URL.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DoWithRatingResponse performs a request and returns a parsed Trakt response
+func (c *TraktClient) DoWithRatingResponse(request *http.Request) (rating.RatingResponse, error) {
+	resp, err := c.DoWithResponse(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parseTraktResponse(resp)
+}
+
+// setupRequest configures the request with common headers and authentication
+func (c *TraktClient) setupRequest(request *http.Request) {
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+
+	// Trakt identifies the application by its client ID on every request
+	request.Header.Set("trakt-api-version", apiVersion)
+	request.Header.Set("trakt-api-key", c.clientID)
+}
+
+// parseTraktResponse handles the Trakt API response parsing
+func (c *TraktClient) parseTraktResponse(resp *http.Response) (*trakt.Response, error) {
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.logger.Error("error closing response body", zap.Error(err))
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		err := errors.New(model.NotAuthorized)
+		c.logger.Error(
+			"your Trakt client ID is invalid, please use the client ID of a Trakt API application",
+			zap.Error(err),
+		)
+		return nil, err
+	case http.StatusNotFound:
+		return nil, errors.New(model.NotFound)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected Trakt API status code %d", resp.StatusCode)
+	}
+
+	var traktResponse trakt.Response
+	err := json.NewDecoder(resp.Body).Decode(&traktResponse)
+	if err != nil {
+		c.logger.Error("unable to decode Trakt API response",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &traktResponse, nil
+}
+
+func (c *TraktClient) GetBaseUrl() *url.URL {
+	return &c.baseUrl
+}
+
+// SetHttpClient replaces the internal HttpClient with the provided one
+// Method used primarily for testing
+func (c *TraktClient) SetHttpClient(client common.ServiceHTTPClient) {
+	c.httpClient = client
+}
+
+// SetBaseUrl replaces the Trakt API base URL with the provided one
+// Method used primarily for testing, against a local server
+func (c *TraktClient) SetBaseUrl(baseUrl url.URL) {
+	c.baseUrl = baseUrl
+}
@@ -0,0 +1,157 @@
+package trakt_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	traktClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/client"
+	traktModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/model"
+)
+
+type TraktClientTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	handler http.HandlerFunc
+	client  *traktClient.TraktClient
+}
+
+func (s *TraktClientTestSuite) SetupTest() {
+	s.handler = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+
+	client, err := traktClient.NewTraktClient(
+		&config.Trakt{Enabled: true, ClientID: "test-client-id"},
+		&config.HTTPClient{Timeout: 5 * time.Second, MaxRetries: 0},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+	s.client = client
+}
+
+func (s *TraktClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestTraktClientTestSuite(t *testing.T) {
+	suite.Run(t, new(TraktClientTestSuite))
+}
+
+func (s *TraktClientTestSuite) newRequest(path string) *http.Request {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, s.client.GetBaseUrl().String()+path, nil)
+	s.Require().NoError(err)
+	return req
+}
+
+func (s *TraktClientTestSuite) TestNewTraktClient_MissingClientID() {
+	// Act
+	client, err := traktClient.NewTraktClient(&config.Trakt{Enabled: true}, &config.HTTPClient{}, os.DevNull, zap.NewNop())
+
+	// Assert
+	s.EqualError(err, "trakt.client_id is required")
+	s.Nil(client)
+}
+
+func (s *TraktClientTestSuite) TestDoWithRatingResponse_Ratings() {
+	// Arrange
+	var capturedRequest *http.Request
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		capturedRequest = r
+		_, _ = w.Write([]byte(`{"rating": 8.52, "votes": 52187, "distribution": {"10": 16000}}`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest("/movies/tt0133093/ratings"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&traktModel.Response{Rating: 8.52, Votes: 52187}, response)
+	s.Equal("/movies/tt0133093/ratings", capturedRequest.URL.Path)
+	s.Equal("2", capturedRequest.Header.Get("trakt-api-version"))
+	s.Equal("test-client-id", capturedRequest.Header.Get("trakt-api-key"))
+	s.Equal("application/json", capturedRequest.Header.Get("Content-Type"))
+}
+
+func (s *TraktClientTestSuite) TestDoWithRatingResponse_SearchResults() {
+	// Arrange
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"type": "movie", "score": 1000, "movie": {"title": "The Matrix", "year": 1999, "ids": {"trakt": 481, "slug": "the-matrix-1999", "imdb": "tt0133093", "tmdb": 603}}}]`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest("/search/tmdb/603?type=movie"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&traktModel.Response{
+		Results: []traktModel.SearchResult{
+			{
+				Type: "movie",
+				Movie: &traktModel.Entry{
+					Title: "The Matrix",
+					Year:  1999,
+					IDs:   traktModel.IDs{Trakt: 481, Slug: "the-matrix-1999", IMDB: "tt0133093", TMDB: 603},
+				},
+			},
+		},
+	}, response)
+}
+
+func (s *TraktClientTestSuite) TestDoWithRatingResponse_Errors() {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		expectedError string
+	}{
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, expectedError: model.NotAuthorized},
+		{name: "forbidden", statusCode: http.StatusForbidden, expectedError: model.NotAuthorized},
+		{name: "not found", statusCode: http.StatusNotFound, expectedError: model.NotFound},
+		{name: "unexpected status", statusCode: http.StatusTeapot, expectedError: "unexpected Trakt API status code 418"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+			}
+
+			// Act
+			response, err := s.client.DoWithRatingResponse(s.newRequest("/movies/tt0133093/ratings"))
+
+			// Assert
+			s.EqualError(err, tc.expectedError)
+			s.Nil(response)
+		})
+	}
+}
+
+func (s *TraktClientTestSuite) TestDoWithRatingResponse_InvalidBody() {
+	// Arrange
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	}
+
+	// Act
+	response, err := s.client.DoWithRatingResponse(s.newRequest("/movies/tt0133093/ratings"))
+
+	// Assert
+	s.Error(err)
+	s.Nil(response)
+}
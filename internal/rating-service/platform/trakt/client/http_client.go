@@ -0,0 +1,25 @@
+package trakt
+
+import (
+	"net/http"
+	"time"
+
+	common "github.com/zepollabot/media-rating-overlay/internal/httpclient"
+)
+
+// TraktHTTPClient implements the HTTPClient interface for Trakt
+type TraktHTTPClient struct {
+	client common.HTTPClient
+}
+
+// NewTraktHTTPClient creates a new Trakt HTTP client
+func NewTraktHTTPClient(timeout time.Duration, maxRetries int) *TraktHTTPClient {
+	return &TraktHTTPClient{
+		client: common.NewRetryClient(timeout, maxRetries),
+	}
+}
+
+// Do implements the HTTPClient interface
+func (c *TraktHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req)
+}
@@ -0,0 +1,69 @@
+package trakt
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+)
+
+type LogoCreator interface {
+	CreateLogo(imagePath string, text string, dimensions model.LogoDimensions) (*model.Logo, error)
+}
+
+// TraktLogoService implements the LogoService interface for Trakt
+type TraktLogoService struct {
+	logger      *zap.Logger
+	config      *model.PosterConfig
+	logoCreator LogoCreator
+}
+
+// NewTraktLogoService creates a new Trakt logo service
+func NewTraktLogoService(
+	logger *zap.Logger,
+	config *model.PosterConfig,
+	logoCreator LogoCreator,
+) *TraktLogoService {
+	return &TraktLogoService{
+		logger:      logger,
+		config:      config,
+		logoCreator: logoCreator,
+	}
+}
+
+// GetLogos gets logos for a Trakt item
+func (s *TraktLogoService) GetLogos(
+	ctx context.Context,
+	ratings []model.Rating,
+	itemID string,
+	dimensions model.LogoDimensions,
+) ([]*model.Logo, error) {
+	logos := make([]*model.Logo, 0)
+
+	s.logger.Debug("Build Trakt logos..",
+		zap.String("Item ID", itemID),
+	)
+
+	for _, rating := range ratings {
+		if rating.Rating > 0.0 {
+			rating := decimal.NewFromFloat32(rating.Rating).Round(1).StringFixedBank(1)
+
+			logo, err := s.logoCreator.CreateLogo(
+				s.config.ImagePaths.Trakt.Audience.Normal,
+				rating,
+				dimensions,
+			)
+
+			if err != nil {
+				s.logger.Debug("Error creating Trakt logo", zap.Error(err))
+				return nil, err
+			}
+
+			logos = append(logos, logo)
+		}
+	}
+
+	return logos, nil
+}
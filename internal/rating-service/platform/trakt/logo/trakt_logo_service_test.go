@@ -0,0 +1,83 @@
+package trakt
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/logo/mocks"
+)
+
+type TraktLogoServiceTestSuite struct {
+	suite.Suite
+	mockLogoCreator *mocks.LogoCreator
+	config          *model.PosterConfig
+	service         *TraktLogoService
+}
+
+func (s *TraktLogoServiceTestSuite) SetupTest() {
+	s.mockLogoCreator = new(mocks.LogoCreator)
+
+	s.config = &model.PosterConfig{}
+	s.config.ImagePaths.Trakt.Audience.Normal = "path/to/trakt.png"
+
+	s.service = NewTraktLogoService(zap.NewNop(), s.config, s.mockLogoCreator)
+}
+
+func (s *TraktLogoServiceTestSuite) TearDownTest() {
+	s.mockLogoCreator.AssertExpectations(s.T())
+}
+
+func TestTraktLogoServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TraktLogoServiceTestSuite))
+}
+
+func (s *TraktLogoServiceTestSuite) TestGetLogos() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 8.52}}
+	dimensions := model.LogoDimensions{}
+	expectedLogo := &model.Logo{}
+
+	s.mockLogoCreator.On("CreateLogo", "path/to/trakt.png", "8.5", dimensions).Return(expectedLogo, nil).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.NoError(err)
+	s.Require().Len(logos, 1)
+	s.Equal(expectedLogo, logos[0])
+}
+
+func (s *TraktLogoServiceTestSuite) TestGetLogos_RatingIsZero() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 0.0}}
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", model.LogoDimensions{})
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(logos)
+	s.Len(logos, 0)
+}
+
+func (s *TraktLogoServiceTestSuite) TestGetLogos_CreateLogoError() {
+	// Arrange
+	ratings := []model.Rating{{Type: model.RatingServiceTypeAudience, Rating: 7.0}}
+	dimensions := model.LogoDimensions{}
+	expectedError := errors.New("logo creation failed")
+
+	s.mockLogoCreator.On("CreateLogo", "path/to/trakt.png", "7.0", dimensions).Return(nil, expectedError).Once()
+
+	// Act
+	logos, err := s.service.GetLogos(context.Background(), ratings, "tt123", dimensions)
+
+	// Assert
+	s.Equal(expectedError, err)
+	s.Nil(logos)
+}
@@ -0,0 +1,43 @@
+package trakt
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Response is a Trakt API response: the /movies/{id}/ratings and /shows/{id}/ratings endpoints
+// fill Rating and Votes, the /search/{id_type}/{id} endpoint, answering a list, fills Results
+type Response struct {
+	Rating  float64        `json:"rating"`
+	Votes   int            `json:"votes"`
+	Results []SearchResult `json:"-"`
+}
+
+type SearchResult struct {
+	Type  string `json:"type"`
+	Movie *Entry `json:"movie"`
+	Show  *Entry `json:"show"`
+}
+
+type Entry struct {
+	Title string `json:"title"`
+	Year  int    `json:"year"`
+	IDs   IDs    `json:"ids"`
+}
+
+type IDs struct {
+	Trakt int    `json:"trakt"`
+	Slug  string `json:"slug"`
+	IMDB  string `json:"imdb"`
+	TMDB  int    `json:"tmdb"`
+}
+
+// UnmarshalJSON decodes either a ratings object or a list of search results
+func (r *Response) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		return json.Unmarshal(trimmed, &r.Results)
+	}
+
+	type response Response
+	return json.Unmarshal(data, (*response)(r))
+}
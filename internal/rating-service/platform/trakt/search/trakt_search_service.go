@@ -0,0 +1,131 @@
+package trakt
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	rating "github.com/zepollabot/media-rating-overlay/internal/rating-service"
+	trakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/model"
+)
+
+// TraktSearchService looks the ratings of the items up on Trakt
+type TraktSearchService struct {
+	client rating.RatingClient
+	logger *zap.Logger
+}
+
+func NewTraktSearchService(client rating.RatingClient, logger *zap.Logger) *TraktSearchService {
+	return &TraktSearchService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// GetRatings returns the Trakt ratings of the item. Trakt accepts IMDb IDs in place of its own
+// IDs, so the ratings are requested directly when the IMDb ID is known; otherwise the Trakt ID
+// is first searched by TMDB ID. It returns nil when the item is not on Trakt
+func (s *TraktSearchService) GetRatings(ctx context.Context, item model.Item) (*trakt.Response, error) {
+	var searchType, ratingsPath string
+	switch item.Type {
+	case constant.MediaTypeMovie:
+		searchType, ratingsPath = "movie", "movies"
+	case constant.MediaTypeShow, constant.MediaTypeSeason:
+		searchType, ratingsPath = "show", "shows"
+	default:
+		s.logger.Debug("media type not supported by Trakt",
+			zap.String("Item ID", item.ID),
+			zap.String("Type", item.Type),
+		)
+		return nil, nil
+	}
+
+	id := item.ExternalIDs.IMDB
+	if id == "" && item.ExternalIDs.TMDB != "" {
+		var err error
+		id, err = s.findTraktID(ctx, searchType, item.ExternalIDs.TMDB)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if id == "" {
+		s.logger.Debug("item not found on Trakt",
+			zap.String("Item ID", item.ID),
+		)
+		return nil, nil
+	}
+
+	return s.getResponse(ctx, "/"+ratingsPath+"/"+url.PathEscape(id)+"/ratings", nil)
+}
+
+// findTraktID returns the Trakt ID of the item of type searchType having the TMDB ID tmdbID,
+// or an empty string when there is none
+func (s *TraktSearchService) findTraktID(ctx context.Context, searchType string, tmdbID string) (string, error) {
+	response, err := s.getResponse(ctx, "/search/tmdb/"+url.PathEscape(tmdbID), map[string]string{"type": searchType})
+	if err != nil || response == nil {
+		return "", err
+	}
+
+	for _, result := range response.Results {
+		entry := result.Movie
+		if result.Type == "show" {
+			entry = result.Show
+		}
+
+		if result.Type == searchType && entry != nil && entry.IDs.Trakt > 0 {
+			return strconv.Itoa(entry.IDs.Trakt), nil
+		}
+	}
+
+	return "", nil
+}
+
+// getResponse requests path, returning nil when Trakt does not know the resource
+func (s *TraktSearchService) getResponse(ctx context.Context, path string, query map[string]string) (*trakt.Response, error) {
+	endpoint := *s.client.GetBaseUrl()
+	endpoint.Path += path
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		s.logger.Error("unable to build request",
+			zap.String("method", "GetRatings"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	for name, value := range query {
+		q.Set(name, value)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	response, err := s.client.DoWithRatingResponse(req)
+	if err != nil {
+		if err.Error() == model.NotFound {
+			return nil, nil
+		}
+		s.logger.Error("unable to perform request to Trakt Client",
+			zap.String("method", "GetRatings"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result, ok := response.(*trakt.Response)
+	if !ok {
+		s.logger.Error("unable to cast response to Trakt Response",
+			zap.String("method", "GetRatings"),
+		)
+		return nil, fmt.Errorf("invalid response type")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package trakt
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	config "github.com/zepollabot/media-rating-overlay/internal/config/model"
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	traktClient "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/client"
+	traktModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/model"
+)
+
+// TraktSearchServiceTestSuite runs the search service and the Trakt client against a local server
+type TraktSearchServiceTestSuite struct {
+	suite.Suite
+	mux      *http.ServeMux
+	server   *httptest.Server
+	requests []*http.Request
+	service  *TraktSearchService
+	ctx      context.Context
+}
+
+func TestTraktSearchServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TraktSearchServiceTestSuite))
+}
+
+func (s *TraktSearchServiceTestSuite) SetupTest() {
+	s.mux = http.NewServeMux()
+	s.requests = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests = append(s.requests, r)
+		s.mux.ServeHTTP(w, r)
+	}))
+
+	client, err := traktClient.NewTraktClient(
+		&config.Trakt{Enabled: true, ClientID: "test-client-id"},
+		&config.HTTPClient{Timeout: 5 * time.Second},
+		os.DevNull,
+		zap.NewNop(),
+	)
+	s.Require().NoError(err)
+
+	serverUrl, err := url.Parse(s.server.URL)
+	s.Require().NoError(err)
+	client.SetBaseUrl(*serverUrl)
+
+	s.service = NewTraktSearchService(client, zap.NewNop())
+	s.ctx = context.Background()
+}
+
+func (s *TraktSearchServiceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *TraktSearchServiceTestSuite) handle(pattern string, body string) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+func (s *TraktSearchServiceTestSuite) TestGetRatings_ByIMDbID() {
+	// Arrange
+	item := model.Item{ID: "1234", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093", TMDB: "603"}}
+	s.handle("GET /movies/tt0133093/ratings", `{"rating": 8.5, "votes": 52187}`)
+
+	// Act
+	response, err := s.service.GetRatings(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&traktModel.Response{Rating: 8.5, Votes: 52187}, response)
+	s.Len(s.requests, 1, "the IMDb ID should be used without searching")
+}
+
+func (s *TraktSearchServiceTestSuite) TestGetRatings_ShowByTMDBID() {
+	// Arrange
+	item := model.Item{ID: "5678", Type: constant.MediaTypeSeason, ExternalIDs: model.ExternalIDs{TMDB: "1399"}}
+	s.handle("GET /search/tmdb/1399", `[
+		{"type": "movie", "movie": {"title": "Other", "ids": {"trakt": 1, "tmdb": 1399}}},
+		{"type": "show", "show": {"title": "Game of Thrones", "year": 2011, "ids": {"trakt": 1390, "tmdb": 1399}}}
+	]`)
+	s.handle("GET /shows/1390/ratings", `{"rating": 9.1, "votes": 90000}`)
+
+	// Act
+	response, err := s.service.GetRatings(s.ctx, item)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(&traktModel.Response{Rating: 9.1, Votes: 90000}, response)
+	s.Require().Len(s.requests, 2)
+	s.Equal("show", s.requests[0].URL.Query().Get("type"))
+}
+
+func (s *TraktSearchServiceTestSuite) TestGetRatings_NotFound() {
+	testCases := []struct {
+		name string
+		item model.Item
+	}{
+		{
+			name: "unknown IMDb ID",
+			item: model.Item{ID: "1", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0000000"}},
+		},
+		{
+			name: "no search result",
+			item: model.Item{ID: "2", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{TMDB: "42"}},
+		},
+		{
+			name: "no external ID",
+			item: model.Item{ID: "3", Type: constant.MediaTypeMovie},
+		},
+		{
+			name: "episode",
+			item: model.Item{ID: "4", Type: constant.MediaTypeEpisode, ExternalIDs: model.ExternalIDs{IMDB: "tt0944947"}},
+		},
+	}
+
+	s.handle("GET /search/tmdb/42", `[]`)
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Act
+			response, err := s.service.GetRatings(s.ctx, tc.item)
+
+			// Assert
+			s.NoError(err)
+			s.Nil(response)
+		})
+	}
+}
+
+func (s *TraktSearchServiceTestSuite) TestGetRatings_Error() {
+	// Arrange
+	item := model.Item{ID: "1234", Type: constant.MediaTypeMovie, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+	s.mux.HandleFunc("GET /movies/tt0133093/ratings", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	// Act
+	response, err := s.service.GetRatings(s.ctx, item)
+
+	// Assert
+	s.EqualError(err, model.NotAuthorized)
+	s.Nil(response)
+}
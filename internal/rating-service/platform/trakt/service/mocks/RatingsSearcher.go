@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package trakt_mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/zepollabot/media-rating-overlay/internal/model"
+
+	trakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/model"
+)
+
+// RatingsSearcher is an autogenerated mock type for the RatingsSearcher type
+type RatingsSearcher struct {
+	mock.Mock
+}
+
+type RatingsSearcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RatingsSearcher) EXPECT() *RatingsSearcher_Expecter {
+	return &RatingsSearcher_Expecter{mock: &_m.Mock}
+}
+
+// GetRatings provides a mock function with given fields: ctx, item
+func (_m *RatingsSearcher) GetRatings(ctx context.Context, item model.Item) (*trakt.Response, error) {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRatings")
+	}
+
+	var r0 *trakt.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) (*trakt.Response, error)); ok {
+		return rf(ctx, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Item) *trakt.Response); ok {
+		r0 = rf(ctx, item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*trakt.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Item) error); ok {
+		r1 = rf(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RatingsSearcher_GetRatings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatings'
+type RatingsSearcher_GetRatings_Call struct {
+	*mock.Call
+}
+
+// GetRatings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.Item
+func (_e *RatingsSearcher_Expecter) GetRatings(ctx interface{}, item interface{}) *RatingsSearcher_GetRatings_Call {
+	return &RatingsSearcher_GetRatings_Call{Call: _e.mock.On("GetRatings", ctx, item)}
+}
+
+func (_c *RatingsSearcher_GetRatings_Call) Run(run func(ctx context.Context, item model.Item)) *RatingsSearcher_GetRatings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Item))
+	})
+	return _c
+}
+
+func (_c *RatingsSearcher_GetRatings_Call) Return(_a0 *trakt.Response, _a1 error) *RatingsSearcher_GetRatings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RatingsSearcher_GetRatings_Call) RunAndReturn(run func(context.Context, model.Item) (*trakt.Response, error)) *RatingsSearcher_GetRatings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRatingsSearcher creates a new instance of RatingsSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRatingsSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RatingsSearcher {
+	mock := &RatingsSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
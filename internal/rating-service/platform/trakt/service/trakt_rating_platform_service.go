@@ -0,0 +1,66 @@
+package trakt
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	trakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/model"
+)
+
+// RatingsSearcher looks the ratings of the items up on Trakt
+type RatingsSearcher interface {
+	GetRatings(ctx context.Context, item model.Item) (*trakt.Response, error)
+}
+
+// TraktRatingPlatformService provides the Trakt community rating, already on a 0-10 scale
+type TraktRatingPlatformService struct {
+	logger          *zap.Logger
+	ratingsSearcher RatingsSearcher
+}
+
+func NewTraktRatingPlatformService(logger *zap.Logger, ratingsSearcher RatingsSearcher) *TraktRatingPlatformService {
+	return &TraktRatingPlatformService{
+		logger:          logger,
+		ratingsSearcher: ratingsSearcher,
+	}
+}
+
+func (s *TraktRatingPlatformService) GetRatings(ctx context.Context, item model.Item) ([]model.Rating, error) {
+	s.logger.Debug("Retrieving Trakt rating..",
+		zap.String("Item ID", item.ID),
+	)
+
+	response, err := s.ratingsSearcher.GetRatings(ctx, item)
+	if err != nil {
+		s.logger.Error("unable to get Trakt ratings",
+			zap.String("Item ID", item.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if response == nil || response.Rating <= 0 {
+		s.logger.Debug("no Trakt rating found",
+			zap.String("Item ID", item.ID),
+		)
+		return nil, nil
+	}
+
+	s.logger.Debug("Trakt rating found",
+		zap.String("Item ID", item.ID),
+		zap.Float64("Value", response.Rating),
+		zap.Int("Votes", response.Votes),
+	)
+
+	return []model.Rating{
+		{
+			Name:   constant.RatingServiceTrakt,
+			Rating: float32(response.Rating),
+			Type:   model.RatingServiceTypeAudience,
+			Votes:  response.Votes,
+		},
+	}, nil
+}
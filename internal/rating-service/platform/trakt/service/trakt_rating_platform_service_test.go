@@ -0,0 +1,84 @@
+package trakt_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/zepollabot/media-rating-overlay/internal/constant"
+	"github.com/zepollabot/media-rating-overlay/internal/model"
+	traktModel "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/model"
+	trakt "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/service"
+	trakt_mocks "github.com/zepollabot/media-rating-overlay/internal/rating-service/platform/trakt/service/mocks"
+)
+
+type TraktRatingPlatformServiceTestSuite struct {
+	suite.Suite
+	mockRatingsSearcher *trakt_mocks.RatingsSearcher
+	service             *trakt.TraktRatingPlatformService
+	ctx                 context.Context
+	item                model.Item
+}
+
+func (s *TraktRatingPlatformServiceTestSuite) SetupTest() {
+	s.mockRatingsSearcher = trakt_mocks.NewRatingsSearcher(s.T())
+	s.service = trakt.NewTraktRatingPlatformService(zap.NewNop(), s.mockRatingsSearcher)
+	s.ctx = context.Background()
+	s.item = model.Item{ID: "1234", Title: "The Matrix", Year: 1999, ExternalIDs: model.ExternalIDs{IMDB: "tt0133093"}}
+}
+
+func TestTraktRatingPlatformServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TraktRatingPlatformServiceTestSuite))
+}
+
+func (s *TraktRatingPlatformServiceTestSuite) TestGetRatings() {
+	// Arrange
+	s.mockRatingsSearcher.On("GetRatings", s.ctx, s.item).Return(&traktModel.Response{Rating: 8.52, Votes: 52187}, nil).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]model.Rating{model.Rating{Name: constant.RatingServiceTrakt, Rating: 8.52, Type: model.RatingServiceTypeAudience, Votes: 52187}}, ratings)
+}
+
+func (s *TraktRatingPlatformServiceTestSuite) TestGetRatings_NoRating() {
+	testCases := []struct {
+		name     string
+		response *traktModel.Response
+	}{
+		{name: "not on Trakt", response: nil},
+		{name: "not rated", response: &traktModel.Response{Rating: 0, Votes: 0}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Arrange
+			s.mockRatingsSearcher.On("GetRatings", s.ctx, s.item).Return(tc.response, nil).Once()
+
+			// Act
+			ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+			// Assert
+			s.NoError(err)
+			s.Empty(ratings)
+		})
+	}
+}
+
+func (s *TraktRatingPlatformServiceTestSuite) TestGetRatings_Error() {
+	// Arrange
+	expectedErr := errors.New("trakt unavailable")
+	s.mockRatingsSearcher.On("GetRatings", s.ctx, s.item).Return(nil, expectedErr).Once()
+
+	// Act
+	ratings, err := s.service.GetRatings(s.ctx, s.item)
+
+	// Assert
+	s.Equal(expectedErr, err)
+	s.Empty(ratings)
+}
@@ -0,0 +1,17 @@
+package rating
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeTitle keeps the letters and digits of a title, lowercased, to compare titles
+// regardless of punctuation, spacing and case
+func NormalizeTitle(title string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, title)
+}
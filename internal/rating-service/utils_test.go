@@ -0,0 +1,41 @@
+package rating
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type UtilsTestSuite struct {
+	suite.Suite
+}
+
+func TestUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(UtilsTestSuite))
+}
+
+func (s *UtilsTestSuite) TestNormalizeTitle() {
+	testCases := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{"case", "Inception", "inception"},
+		{"punctuation and spacing", "Spider-Man: Into the Spider-Verse", "spidermanintothespiderverse"},
+		{"digits", "Se7en", "se7en"},
+		{"accented letters", "Amélie", "amélie"},
+		{"non latin letters", "進撃の巨人", "進撃の巨人"},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// Act
+			normalized := NormalizeTitle(tc.title)
+
+			// Assert
+			assert.Equal(t, tc.expected, normalized)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// descriptors are the shorthands accepted in place of the five fields
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// dayOfMonthAny and dayOfWeekAny tell whether the day fields are "*": when both
+	// are restricted, a day matching either of them matches, as in cron
+	dayOfMonthAny, dayOfWeekAny bool
+}
+
+// Parse parses a standard five fields cron expression (minute, hour, day of month,
+// month, day of week) or one of the @hourly, @daily, @weekly, @monthly and @yearly shorthands.
+// Fields accept "*", values, ranges ("1-5"), steps ("*/15", "1-10/2") and lists ("1,15")
+func Parse(expression string) (*Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if descriptor, ok := descriptors[expression]; ok {
+		expression = descriptor
+	}
+
+	parts := strings.Fields(expression)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expected %d fields, got %d", expression, len(fields), len(parts))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, part := range parts {
+		value, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expression, err)
+		}
+		bits[i] = value
+	}
+
+	// Sunday is either 0 or 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	schedule := &Schedule{
+		minute:        bits[0],
+		hour:          bits[1],
+		dayOfMonth:    bits[2],
+		month:         bits[3],
+		dayOfWeek:     bits[4],
+		dayOfMonthAny: parts[2] == "*",
+		dayOfWeekAny:  parts[4] == "*",
+	}
+
+	// Valid fields can still never match together, e.g. on February 31st
+	if !schedule.canMatch() {
+		return nil, fmt.Errorf("invalid cron expression %q: it never matches", expression)
+	}
+
+	return schedule, nil
+}
+
+// monthDays are the maximum number of days of each month, February having 29 on leap years
+var monthDays = [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// canMatch reports whether some day of the months matches the schedule. Only a day of month
+// restricted alone can miss every month: every day of week happens in every month
+func (s *Schedule) canMatch() bool {
+	if s.dayOfMonthAny || !s.dayOfWeekAny {
+		return true
+	}
+
+	for month := 1; month <= 12; month++ {
+		if s.month&(1<<uint(month)) == 0 {
+			continue
+		}
+		for day := 1; day <= monthDays[month]; day++ {
+			if s.dayOfMonth&(1<<uint(day)) != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func parseField(expression string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(expression, ",") {
+		rangeExpression, stepExpression, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepExpression)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s", stepExpression, f.name)
+			}
+		}
+
+		start, end := f.min, f.max
+		if rangeExpression != "*" {
+			startExpression, endExpression, isRange := strings.Cut(rangeExpression, "-")
+
+			var err error
+			start, err = parseValue(startExpression, f)
+			if err != nil {
+				return 0, err
+			}
+
+			end = start
+			if isRange {
+				end, err = parseValue(endExpression, f)
+				if err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				end = f.max
+			}
+
+			if start > end {
+				return 0, fmt.Errorf("invalid range %q in %s", rangeExpression, f.name)
+			}
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << value
+		}
+	}
+
+	return bits, nil
+}
+
+func parseValue(expression string, f field) (int, error) {
+	value, err := strconv.Atoi(expression)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s, expected %d-%d", expression, f.name, f.min, f.max)
+	}
+
+	return value, nil
+}
+
+// Next returns the first time after t matching the schedule, with a minute precision.
+// It returns the zero time when the schedule matches no time in the next five years
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// A matching time is at most a few years away, e.g. on February 29th
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if s.dayOfMonthAny || s.dayOfWeekAny {
+		return dayOfMonth && dayOfWeek
+	}
+
+	return dayOfMonth || dayOfWeek
+}
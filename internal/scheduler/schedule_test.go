@@ -0,0 +1,106 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleTestSuite struct {
+	suite.Suite
+}
+
+func TestScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleTestSuite))
+}
+
+func (s *ScheduleTestSuite) TestNext() {
+	// Wednesday
+	now := time.Date(2025, 1, 15, 10, 30, 45, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		expression string
+		expected   time.Time
+	}{
+		{"every minute", "* * * * *", time.Date(2025, 1, 15, 10, 31, 0, 0, time.UTC)},
+		{"every 15 minutes", "*/15 * * * *", time.Date(2025, 1, 15, 10, 45, 0, 0, time.UTC)},
+		{"daily at 3", "0 3 * * *", time.Date(2025, 1, 16, 3, 0, 0, 0, time.UTC)},
+		{"later today", "0 18 * * *", time.Date(2025, 1, 15, 18, 0, 0, 0, time.UTC)},
+		{"list of hours", "0 6,12 * * *", time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)},
+		{"week days range", "0 9 * * 1-5", time.Date(2025, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{"sunday as 7", "0 0 * * 7", time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{"first of the month", "@monthly", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"hourly", "@hourly", time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"day of month or day of week", "0 0 20 * 5", time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// Arrange
+			schedule, err := Parse(tc.expression)
+			assert.NoError(t, err)
+
+			// Act
+			next := schedule.Next(now)
+
+			// Assert
+			assert.Equal(t, tc.expected, next)
+		})
+	}
+}
+
+func (s *ScheduleTestSuite) TestParse_RarelyMatching() {
+	testCases := []struct {
+		name       string
+		expression string
+	}{
+		{"leap day", "0 0 29 2 *"},
+		{"31st of one of the months", "0 0 31 2,3 *"},
+		{"day of month or day of week", "0 0 31 2 1"},
+		{"any day of month on a day of week", "0 0 * 2 1"},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// Act
+			schedule, err := Parse(tc.expression)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NotNil(t, schedule)
+		})
+	}
+}
+
+func (s *ScheduleTestSuite) TestParse_Invalid() {
+	testCases := []struct {
+		name       string
+		expression string
+	}{
+		{"empty", ""},
+		{"too few fields", "0 3 * *"},
+		{"out of range", "60 * * * *"},
+		{"invalid step", "*/0 * * * *"},
+		{"reversed range", "0 5-1 * * *"},
+		{"not a number", "0 three * * *"},
+		{"unknown descriptor", "@often"},
+		{"never matching", "0 0 31 2 *"},
+		{"never matching in any of the months", "0 0 31 4,6,9,11 *"},
+		{"never matching in february", "0 0 30-31 2 *"},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// Act
+			schedule, err := Parse(tc.expression)
+
+			// Assert
+			assert.Error(t, err)
+			assert.Nil(t, schedule)
+		})
+	}
+}
@@ -18,11 +18,9 @@
 package main
 
 import (
-	"log"
+	"os"
 
-	"go.uber.org/zap"
-
-	core "github.com/zepollabot/media-rating-overlay/internal/app"
+	"github.com/zepollabot/media-rating-overlay/internal/cli"
 	env "github.com/zepollabot/media-rating-overlay/internal/environment"
 )
 
@@ -30,19 +28,5 @@ func main() {
 	// Load environment variables
 	env.Load()
 
-	// Create and initialize the application
-	app, err := core.NewApp()
-	if err != nil {
-		log.Fatalf("Error initializing application: %v", err)
-	}
-
-	defer app.Logger.Sync()
-
-	// Run the application
-	if err := app.Run(); err != nil {
-		app.Logger.Fatal("Error during execution", zap.Error(err))
-	}
-
-	// Shutdown gracefully
-	app.Shutdown()
+	os.Exit(cli.Run(os.Args[1:], os.Stdout, os.Stderr))
 }